@@ -0,0 +1,184 @@
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bitlist"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+type testCommittee struct {
+	t    *testing.T
+	keys []*bls.Key
+	msg  [32]byte
+}
+
+func newTestCommittee(t *testing.T, size int, msg [32]byte) *testCommittee {
+	keys := make([]*bls.Key, size)
+	for i := range keys {
+		keys[i] = bls.NewRandomKey()
+	}
+	return &testCommittee{t: t, keys: keys, msg: msg}
+}
+
+// sign returns the aggregated signature of the members
+func (c *testCommittee) sign(members ...uint64) consensus.Signature {
+	sigs := []*bls.Signature{}
+	for _, indx := range members {
+		sig, err := c.keys[indx].Prv.Sign(c.msg[:])
+		require.NoError(c.t, err)
+		sigs = append(sigs, sig)
+	}
+	return bls.AggregateSignatures(sigs).Serialize()
+}
+
+// verify verifies the aggregated signature of the members
+func (c *testCommittee) verify(sig consensus.Signature, members []uint64) {
+	pubs := []*bls.PublicKey{}
+	for _, indx := range members {
+		pubs = append(pubs, c.keys[indx].Pub)
+	}
+	signature := &bls.Signature{}
+	require.NoError(c.t, signature.Deserialize(sig[:]))
+
+	ok, err := signature.FastAggregateVerify(pubs, c.msg[:])
+	require.NoError(c.t, err)
+	require.True(c.t, ok)
+}
+
+func TestAggregate_Attestations(t *testing.T) {
+	data := &consensus.AttestationData{
+		Slot:   1,
+		Source: &consensus.Checkpoint{},
+		Target: &consensus.Checkpoint{},
+	}
+	root, err := data.HashTreeRoot()
+	require.NoError(t, err)
+
+	committee := newTestCommittee(t, 8, root)
+
+	attestation := func(members ...uint64) *consensus.Attestation {
+		bits := bitlist.NewBitlist(8)
+		for _, indx := range members {
+			bits.SetBitAt(indx, true)
+		}
+		return &consensus.Attestation{
+			AggregationBits: bits,
+			Data:            data,
+			Signature:       committee.sign(members...),
+		}
+	}
+
+	cases := []struct {
+		atts     []*consensus.Attestation
+		expected [][]uint64
+	}{
+		{
+			// disjoint attestations are merged
+			[]*consensus.Attestation{attestation(0), attestation(1), attestation(5)},
+			[][]uint64{{0, 1, 5}},
+		},
+		{
+			// the attestations included in the aggregate are discarded
+			[]*consensus.Attestation{attestation(0), attestation(0, 1), attestation(2), attestation(0, 2)},
+			[][]uint64{{0, 1, 2}},
+		},
+		{
+			// overlapping attestations that cannot be merged
+			[]*consensus.Attestation{attestation(0, 1), attestation(1, 2), attestation(3)},
+			[][]uint64{{0, 1, 3}, {1, 2}},
+		},
+	}
+
+	for _, c := range cases {
+		aggs, err := Attestations(c.atts)
+		require.NoError(t, err)
+		require.Len(t, aggs, len(c.expected))
+
+		for indx, agg := range aggs {
+			members := bitlist.BitList(agg.AggregationBits).BitIndices()
+			require.Equal(t, c.expected[indx], members)
+			require.Equal(t, uint64(8), bitlist.BitList(agg.AggregationBits).Len())
+			committee.verify(agg.Signature, members)
+		}
+	}
+}
+
+func TestAggregate_AttestationsByData(t *testing.T) {
+	att := func(slot uint64) *consensus.Attestation {
+		data := &consensus.AttestationData{
+			Slot:   slot,
+			Source: &consensus.Checkpoint{},
+			Target: &consensus.Checkpoint{},
+		}
+		root, err := data.HashTreeRoot()
+		require.NoError(t, err)
+
+		sig, err := bls.NewRandomKey().Sign(root)
+		require.NoError(t, err)
+
+		bits := bitlist.NewBitlist(4)
+		bits.SetBitAt(slot, true)
+		return &consensus.Attestation{AggregationBits: bits, Data: data, Signature: sig}
+	}
+
+	aggs, err := Attestations([]*consensus.Attestation{att(2), att(1), att(2)})
+	require.NoError(t, err)
+
+	// the same bit for the same data is only included once
+	require.Len(t, aggs, 2)
+	require.Equal(t, uint64(2), aggs[0].Data.Slot)
+	require.Equal(t, uint64(1), aggs[1].Data.Slot)
+}
+
+func TestAggregate_SyncContributions(t *testing.T) {
+	blockRoot := consensus.Root{0x1}
+	committee := newTestCommittee(t, 512, blockRoot)
+
+	contribution := func(subcommittee uint64, members ...uint64) *consensus.SyncCommitteeContribution {
+		bits := bitlist.NewBitvector(128)
+		signers := []uint64{}
+		for _, indx := range members {
+			bits.SetBitAt(indx, true)
+			signers = append(signers, subcommittee*128+indx)
+		}
+		return &consensus.SyncCommitteeContribution{
+			Slot:              1,
+			BeaconBlockRoot:   blockRoot,
+			SubcommitteeIndex: subcommittee,
+			AggregationBits:   bits.Bytes(),
+			Signature:         committee.sign(signers...),
+		}
+	}
+
+	aggregate, err := SyncContributions([]*consensus.SyncCommitteeContribution{
+		contribution(0, 1, 2),
+		contribution(0, 3),
+		contribution(0, 2, 5),
+		contribution(2, 127),
+		contribution(3, 0, 1),
+		contribution(3, 1, 2, 3),
+	})
+	require.NoError(t, err)
+
+	bits, err := bitlist.BitvectorFromBytes(aggregate.SyncCommiteeBits[:], 512)
+	require.NoError(t, err)
+
+	members := bits.BitIndices()
+	require.Equal(t, []uint64{1, 2, 3, 383, 385, 386, 387}, members)
+	committee.verify(aggregate.SyncCommiteeSignature, members)
+
+	// empty aggregate
+	aggregate, err = SyncContributions(nil)
+	require.NoError(t, err)
+	require.Equal(t, [64]byte{}, aggregate.SyncCommiteeBits)
+	require.Equal(t, infinitySignature, aggregate.SyncCommiteeSignature)
+
+	// contributions for different blocks
+	other := contribution(1, 1)
+	other.BeaconBlockRoot = consensus.Root{0x2}
+	_, err = SyncContributions([]*consensus.SyncCommitteeContribution{contribution(0, 1), other})
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package aggregation
+
+import (
+	"fmt"
+	"sort"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bitlist"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+// Attestations aggregates the attestations with the same attestation data. The signatures
+// cannot be subtracted, thus, only the attestations without any bit in common can be merged.
+// If the bits overlap, it uses a greedy set cover that starts with the attestations with more
+// bits set and returns more than one aggregate for the same data. The attestations whose
+// bits are included in a returned aggregate are discarded.
+func Attestations(atts []*consensus.Attestation) ([]*consensus.Attestation, error) {
+	// group the attestations by data and keep the order of the first appearance
+	groups := map[[32]byte][]*consensus.Attestation{}
+	order := [][32]byte{}
+
+	for _, att := range atts {
+		if att.Data == nil {
+			return nil, fmt.Errorf("attestation without data")
+		}
+		root, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := groups[root]; !ok {
+			order = append(order, root)
+		}
+		groups[root] = append(groups[root], att)
+	}
+
+	res := []*consensus.Attestation{}
+	for _, root := range order {
+		aggs, err := aggregateAttestations(groups[root])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, aggs...)
+	}
+	return res, nil
+}
+
+type attestationItem struct {
+	bits bitlist.BitList
+	sig  *bls.Signature
+}
+
+func aggregateAttestations(atts []*consensus.Attestation) ([]*consensus.Attestation, error) {
+	items := make([]*attestationItem, len(atts))
+	for indx, att := range atts {
+		sig := &bls.Signature{}
+		if err := sig.Deserialize(att.Signature[:]); err != nil {
+			return nil, fmt.Errorf("invalid attestation signature: %v", err)
+		}
+		items[indx] = &attestationItem{
+			bits: bitlist.BitList(att.AggregationBits),
+			sig:  sig,
+		}
+	}
+
+	bitsList := make([]bitlist.BitList, len(items))
+	for indx, item := range items {
+		bitsList[indx] = item.bits
+	}
+	covers, err := setCover(bitsList)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*consensus.Attestation, 0, len(covers))
+	for _, cover := range covers {
+		bits := items[cover[0]].bits.Copy()
+		sigs := []*bls.Signature{items[cover[0]].sig}
+
+		for _, indx := range cover[1:] {
+			if bits, err = bits.Or(items[indx].bits); err != nil {
+				return nil, err
+			}
+			sigs = append(sigs, items[indx].sig)
+		}
+		res = append(res, &consensus.Attestation{
+			AggregationBits: bits,
+			Data:            atts[cover[0]].Data.Copy(),
+			Signature:       bls.AggregateSignatures(sigs).Serialize(),
+		})
+	}
+	return res, nil
+}
+
+// bitSet is a bitlist or a bitvector
+type bitSet[T any] interface {
+	Count() uint64
+	Or(T) (T, error)
+	Overlaps(T) (bool, error)
+	Contains(T) (bool, error)
+}
+
+// setCover groups the bit sets in sets of bit sets without any bit in
+// common and returns the indices of the bit sets of each group.
+func setCover[T bitSet[T]](items []T) ([][]int, error) {
+	pending := make([]int, len(items))
+	for indx := range items {
+		pending[indx] = indx
+	}
+	// the bit sets with more bits set go first
+	sort.SliceStable(pending, func(i, j int) bool {
+		return items[pending[i]].Count() > items[pending[j]].Count()
+	})
+
+	covers := [][]int{}
+	for len(pending) != 0 {
+		cover := []int{pending[0]}
+		covered := items[pending[0]]
+
+		rest := []int{}
+		for _, indx := range pending[1:] {
+			overlaps, err := covered.Overlaps(items[indx])
+			if err != nil {
+				return nil, err
+			}
+			if overlaps {
+				rest = append(rest, indx)
+				continue
+			}
+			if covered, err = covered.Or(items[indx]); err != nil {
+				return nil, err
+			}
+			cover = append(cover, indx)
+		}
+		covers = append(covers, cover)
+
+		// discard the bit sets already included in any of the groups
+		pending = pending[:0]
+		for _, indx := range rest {
+			contained, err := covered.Contains(items[indx])
+			if err != nil {
+				return nil, err
+			}
+			if !contained {
+				pending = append(pending, indx)
+			}
+		}
+	}
+	return covers, nil
+}
@@ -0,0 +1,131 @@
+package aggregation
+
+import (
+	"fmt"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bitlist"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+const (
+	// syncCommitteeSize is the size of the sync committee (SYNC_COMMITTEE_SIZE)
+	syncCommitteeSize = 512
+
+	// syncSubcommitteeSize is the size of each sync subcommittee
+	// (SYNC_COMMITTEE_SIZE / SYNC_COMMITTEE_SUBNET_COUNT)
+	syncSubcommitteeSize = 128
+)
+
+// infinitySignature is the signature of an empty sync aggregate
+var infinitySignature = consensus.Signature{0xc0}
+
+// SyncContributions aggregates the sync committee contributions for the same slot and
+// block root into the sync aggregate of a block. For each subcommittee, it merges the
+// contributions without bits in common, starting with the ones with more bits set, and
+// it uses the aggregate that covers most of the members of the subcommittee.
+func SyncContributions(contributions []*consensus.SyncCommitteeContribution) (*consensus.SyncAggregate, error) {
+	aggregate := &consensus.SyncAggregate{
+		SyncCommiteeSignature: infinitySignature,
+	}
+	if len(contributions) == 0 {
+		return aggregate, nil
+	}
+
+	first := contributions[0]
+	subcommittees := make([][]*consensus.SyncCommitteeContribution, syncCommitteeSize/syncSubcommitteeSize)
+
+	for _, contribution := range contributions {
+		if contribution.Slot != first.Slot || contribution.BeaconBlockRoot != first.BeaconBlockRoot {
+			return nil, fmt.Errorf("contributions for different slots or block roots")
+		}
+		if contribution.SubcommitteeIndex >= uint64(len(subcommittees)) {
+			return nil, fmt.Errorf("subcommittee index %d out of range", contribution.SubcommitteeIndex)
+		}
+		subcommittees[contribution.SubcommitteeIndex] = append(subcommittees[contribution.SubcommitteeIndex], contribution)
+	}
+
+	aggBits, err := bitlist.BitvectorFromBytes(aggregate.SyncCommiteeBits[:], syncCommitteeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := []*bls.Signature{}
+	for subcommitteeIndex, group := range subcommittees {
+		if len(group) == 0 {
+			continue
+		}
+		bits, sig, err := aggregateContributions(group)
+		if err != nil {
+			return nil, err
+		}
+		if sig == nil {
+			continue
+		}
+
+		offset := uint64(subcommitteeIndex) * syncSubcommitteeSize
+		for _, indx := range bits.BitIndices() {
+			aggBits.SetBitAt(offset+indx, true)
+		}
+		sigs = append(sigs, sig)
+	}
+
+	if len(sigs) != 0 {
+		aggregate.SyncCommiteeSignature = bls.AggregateSignatures(sigs).Serialize()
+	}
+	return aggregate, nil
+}
+
+// aggregateContributions returns the best aggregate of the contributions
+// of a subcommittee. The signature is nil if no bit is set.
+func aggregateContributions(contributions []*consensus.SyncCommitteeContribution) (bitlist.Bitvector, *bls.Signature, error) {
+	bitsList := make([]bitlist.Bitvector, 0, len(contributions))
+	sigs := make([]*bls.Signature, 0, len(contributions))
+
+	for _, contribution := range contributions {
+		bits, err := bitlist.BitvectorFromBytes(contribution.AggregationBits, syncSubcommitteeSize)
+		if err != nil {
+			return bitlist.Bitvector{}, nil, err
+		}
+		if bits.Count() == 0 {
+			// the signature of an empty contribution is the infinity point
+			continue
+		}
+		sig := &bls.Signature{}
+		if err := sig.Deserialize(contribution.Signature[:]); err != nil {
+			return bitlist.Bitvector{}, nil, fmt.Errorf("invalid contribution signature: %v", err)
+		}
+		bitsList = append(bitsList, bits)
+		sigs = append(sigs, sig)
+	}
+	if len(bitsList) == 0 {
+		return bitlist.Bitvector{}, nil, nil
+	}
+
+	covers, err := setCover(bitsList)
+	if err != nil {
+		return bitlist.Bitvector{}, nil, err
+	}
+
+	// the first set includes the contribution with more bits set, but
+	// any other set might cover more members of the subcommittee
+	var (
+		best    bitlist.Bitvector
+		bestSig *bls.Signature
+	)
+	for _, cover := range covers {
+		bits := bitsList[cover[0]]
+		coverSigs := []*bls.Signature{sigs[cover[0]]}
+
+		for _, indx := range cover[1:] {
+			if bits, err = bits.Or(bitsList[indx]); err != nil {
+				return bitlist.Bitvector{}, nil, err
+			}
+			coverSigs = append(coverSigs, sigs[indx])
+		}
+		if bestSig == nil || bits.Count() > best.Count() {
+			best, bestSig = bits, bls.AggregateSignatures(coverSigs)
+		}
+	}
+	return best, bestSig, nil
+}
@@ -2,9 +2,15 @@ package bitlist
 
 import (
 	"bytes"
+	"fmt"
 	"math/bits"
+
+	ssz "github.com/ferranbt/fastssz"
 )
 
+// ErrLengthMismatch is returned when operating two bit sets of different length
+var ErrLengthMismatch = fmt.Errorf("bit sets of different length")
+
 // BitList is a bitlist
 type BitList []byte
 
@@ -65,3 +71,112 @@ func (b BitList) Copy() BitList {
 func (b BitList) Equal(bb BitList) bool {
 	return bytes.Equal(b, bb)
 }
+
+// Or returns the union of two bitlists of the same length
+func (b BitList) Or(c BitList) (BitList, error) {
+	return b.op(c, or)
+}
+
+// And returns the intersection of two bitlists of the same length
+func (b BitList) And(c BitList) (BitList, error) {
+	return b.op(c, and)
+}
+
+// Xor returns the symmetric difference of two bitlists of the same length
+func (b BitList) Xor(c BitList) (BitList, error) {
+	return b.op(c, xor)
+}
+
+func (b BitList) op(c BitList, op func(x, y uint64) uint64) (BitList, error) {
+	if err := b.checkLen(c); err != nil {
+		return nil, err
+	}
+	res := make(BitList, len(b))
+	wordOp(res, b, c, op)
+	res.setLenBit(b.Len())
+
+	return res, nil
+}
+
+// Overlaps checks whether the two bitlists have any bit set in common
+func (b BitList) Overlaps(c BitList) (bool, error) {
+	if err := b.checkLen(c); err != nil {
+		return false, err
+	}
+	if len(b) == 0 {
+		return false, nil
+	}
+	// the last byte includes the length bit that is set in both bitlists
+	last := len(b) - 1
+	if wordAny(b[:last], c[:last], overlaps) {
+		return true, nil
+	}
+	lenBit := uint8(1 << (b.Len() % 8))
+	return (b[last]&c[last])&^lenBit != 0, nil
+}
+
+// Contains checks whether all the bits set in c are also set in the bitlist
+func (b BitList) Contains(c BitList) (bool, error) {
+	if err := b.checkLen(c); err != nil {
+		return false, err
+	}
+	return !wordAny(b, c, notContains), nil
+}
+
+// Count returns the number of bits set
+func (b BitList) Count() uint64 {
+	if b.Len() == 0 {
+		return 0
+	}
+	// do not count the length bit
+	return wordCount(b) - 1
+}
+
+// BitIndices returns the indices of the bits set
+func (b BitList) BitIndices() []uint64 {
+	return wordIndices(b, b.Len())
+}
+
+// Not returns the bitlist with all the bits flipped
+func (b BitList) Not() BitList {
+	res := make(BitList, len(b))
+	for i := range b {
+		res[i] = ^b[i]
+	}
+	res.setLenBit(b.Len())
+
+	return res
+}
+
+// HashTreeRoot returns the ssz hash tree root of the bitlist with a maximum length of limit
+func (b BitList) HashTreeRoot(limit uint64) ([32]byte, error) {
+	if len(b) == 0 {
+		return [32]byte{}, fmt.Errorf("bitlist is empty")
+	}
+	if size := b.Len(); size > limit {
+		return [32]byte{}, fmt.Errorf("bitlist length %d is over the limit %d", size, limit)
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	hh.PutBitlist(b, limit)
+	return hh.HashRoot()
+}
+
+func (b BitList) checkLen(c BitList) error {
+	if len(b) != len(c) || b.Len() != c.Len() {
+		return ErrLengthMismatch
+	}
+	return nil
+}
+
+// setLenBit clears the bits over the length in the last byte and sets the length bit
+func (b BitList) setLenBit(size uint64) {
+	if len(b) == 0 {
+		return
+	}
+	lenBit := uint8(1 << (size % 8))
+	last := len(b) - 1
+	b[last] = (b[last] & (lenBit - 1)) | lenBit
+}
@@ -1,6 +1,7 @@
 package bitlist
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -49,3 +50,82 @@ func TestBitmap_SetIndx(t *testing.T) {
 		}
 	}
 }
+
+func bitlistWith(size uint64, indices ...uint64) BitList {
+	b := NewBitlist(size)
+	for _, indx := range indices {
+		b.SetBitAt(indx, true)
+	}
+	return b
+}
+
+func TestBitlist_SetOperations(t *testing.T) {
+	// sizes below and over a 64 bits word
+	for _, size := range []uint64{10, 64, 100} {
+		a := bitlistWith(size, 0, 3, size-1)
+		b := bitlistWith(size, 3, 5)
+
+		or, err := a.Or(b)
+		require.NoError(t, err)
+		require.Equal(t, size, or.Len())
+		require.Equal(t, []uint64{0, 3, 5, size - 1}, or.BitIndices())
+
+		and, err := a.And(b)
+		require.NoError(t, err)
+		require.Equal(t, size, and.Len())
+		require.Equal(t, []uint64{3}, and.BitIndices())
+
+		xor, err := a.Xor(b)
+		require.NoError(t, err)
+		require.Equal(t, size, xor.Len())
+		require.Equal(t, []uint64{0, 5, size - 1}, xor.BitIndices())
+
+		overlaps, err := a.Overlaps(b)
+		require.NoError(t, err)
+		require.True(t, overlaps)
+
+		overlaps, err = a.Overlaps(bitlistWith(size, 1, 2))
+		require.NoError(t, err)
+		require.False(t, overlaps)
+
+		contains, err := or.Contains(a)
+		require.NoError(t, err)
+		require.True(t, contains)
+
+		contains, err = a.Contains(or)
+		require.NoError(t, err)
+		require.False(t, contains)
+
+		require.Equal(t, uint64(3), a.Count())
+		require.Equal(t, uint64(0), NewBitlist(size).Count())
+
+		not := a.Not()
+		require.Equal(t, size, not.Len())
+		require.Equal(t, size-3, not.Count())
+		require.True(t, not.Not().Equal(a))
+
+		_, err = a.Or(NewBitlist(size + 1))
+		require.ErrorIs(t, err, ErrLengthMismatch)
+	}
+}
+
+func TestBitlist_HashTreeRoot(t *testing.T) {
+	// empty bitlist with a limit of 256 bits (one chunk)
+	root, err := NewBitlist(0).HashTreeRoot(256)
+	require.NoError(t, err)
+
+	var input [64]byte
+	require.Equal(t, [32]byte(sha256.Sum256(input[:])), root)
+
+	// bitlist with a single chunk of content
+	b := bitlistWith(3, 0, 2)
+	root, err = b.HashTreeRoot(256)
+	require.NoError(t, err)
+
+	input[0] = 0x5
+	input[32] = 3
+	require.Equal(t, [32]byte(sha256.Sum256(input[:])), root)
+
+	_, err = b.HashTreeRoot(2)
+	require.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package bitlist
+
+import (
+	"bytes"
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Bitvector is a bitvector of fixed length
+type Bitvector struct {
+	buf  []byte
+	size uint64
+}
+
+// NewBitvector creates an empty bitvector of n bits
+func NewBitvector(n uint64) Bitvector {
+	return Bitvector{
+		buf:  make([]byte, (n+7)/8),
+		size: n,
+	}
+}
+
+// BitvectorFromBytes creates a bitvector of n bits on top of the given bytes
+// (i.e. the bits of a ssz field). The bytes are not copied.
+func BitvectorFromBytes(buf []byte, n uint64) (Bitvector, error) {
+	if uint64(len(buf)) != (n+7)/8 {
+		return Bitvector{}, fmt.Errorf("expected %d bytes for a bitvector of %d bits but found %d", (n+7)/8, n, len(buf))
+	}
+	v := Bitvector{buf: buf, size: n}
+	if n%8 != 0 && buf[len(buf)-1]&^v.lastMask() != 0 {
+		return Bitvector{}, fmt.Errorf("bitvector has bits set over the length %d", n)
+	}
+	return v, nil
+}
+
+// Len returns the length of the bitvector
+func (v Bitvector) Len() uint64 {
+	return v.size
+}
+
+// Bytes returns the bytes of the bitvector
+func (v Bitvector) Bytes() []byte {
+	return v.buf
+}
+
+// SetBitAt sets the bit at a given position.
+func (v Bitvector) SetBitAt(indx uint64, val bool) {
+	if indx >= v.size {
+		return
+	}
+
+	bit := uint8(1 << (indx % 8))
+	if val {
+		v.buf[indx/8] |= bit
+	} else {
+		v.buf[indx/8] &^= bit
+	}
+}
+
+// BitAt returns the bit at a given position
+func (v Bitvector) BitAt(indx uint64) bool {
+	if indx >= v.size {
+		return false
+	}
+
+	bit := uint8(1 << (indx % 8))
+	return v.buf[indx/8]&bit == bit
+}
+
+// Copy copies the bitvector
+func (v Bitvector) Copy() Bitvector {
+	buf := make([]byte, len(v.buf))
+	copy(buf, v.buf)
+
+	return Bitvector{buf: buf, size: v.size}
+}
+
+// Equal checks whether two bitvectors are equal
+func (v Bitvector) Equal(c Bitvector) bool {
+	return v.size == c.size && bytes.Equal(v.buf, c.buf)
+}
+
+// Or returns the union of two bitvectors of the same length
+func (v Bitvector) Or(c Bitvector) (Bitvector, error) {
+	return v.op(c, or)
+}
+
+// And returns the intersection of two bitvectors of the same length
+func (v Bitvector) And(c Bitvector) (Bitvector, error) {
+	return v.op(c, and)
+}
+
+// Xor returns the symmetric difference of two bitvectors of the same length
+func (v Bitvector) Xor(c Bitvector) (Bitvector, error) {
+	return v.op(c, xor)
+}
+
+func (v Bitvector) op(c Bitvector, op func(x, y uint64) uint64) (Bitvector, error) {
+	if v.size != c.size {
+		return Bitvector{}, ErrLengthMismatch
+	}
+	res := NewBitvector(v.size)
+	wordOp(res.buf, v.buf, c.buf, op)
+
+	return res, nil
+}
+
+// Overlaps checks whether the two bitvectors have any bit set in common
+func (v Bitvector) Overlaps(c Bitvector) (bool, error) {
+	if v.size != c.size {
+		return false, ErrLengthMismatch
+	}
+	return wordAny(v.buf, c.buf, overlaps), nil
+}
+
+// Contains checks whether all the bits set in c are also set in the bitvector
+func (v Bitvector) Contains(c Bitvector) (bool, error) {
+	if v.size != c.size {
+		return false, ErrLengthMismatch
+	}
+	return !wordAny(v.buf, c.buf, notContains), nil
+}
+
+// Count returns the number of bits set
+func (v Bitvector) Count() uint64 {
+	return wordCount(v.buf)
+}
+
+// BitIndices returns the indices of the bits set
+func (v Bitvector) BitIndices() []uint64 {
+	return wordIndices(v.buf, v.size)
+}
+
+// Not returns the bitvector with all the bits flipped
+func (v Bitvector) Not() Bitvector {
+	res := NewBitvector(v.size)
+	for i := range v.buf {
+		res.buf[i] = ^v.buf[i]
+	}
+	if len(res.buf) != 0 {
+		res.buf[len(res.buf)-1] &= v.lastMask()
+	}
+	return res
+}
+
+// HashTreeRoot returns the ssz hash tree root of the bitvector. The limit
+// of the chunks is the length of the bitvector.
+func (v Bitvector) HashTreeRoot() ([32]byte, error) {
+	if v.size == 0 {
+		return [32]byte{}, fmt.Errorf("bitvector is empty")
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	hh.PutBytes(v.buf)
+	return hh.HashRoot()
+}
+
+// lastMask returns the mask of the bits of the last byte within the length
+func (v Bitvector) lastMask() uint8 {
+	if v.size%8 == 0 {
+		return 0xff
+	}
+	return uint8(1<<(v.size%8)) - 1
+}
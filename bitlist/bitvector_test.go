@@ -0,0 +1,104 @@
+package bitlist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func bitvectorWith(size uint64, indices ...uint64) Bitvector {
+	v := NewBitvector(size)
+	for _, indx := range indices {
+		v.SetBitAt(indx, true)
+	}
+	return v
+}
+
+func TestBitvector_SetIndx(t *testing.T) {
+	v := NewBitvector(4)
+	require.Len(t, v.Bytes(), 1)
+
+	v.SetBitAt(1, true)
+	v.SetBitAt(4, true) // out of bounds
+	require.True(t, v.BitAt(1))
+	require.False(t, v.BitAt(4))
+	require.Equal(t, []byte{0x2}, v.Bytes())
+
+	v.SetBitAt(1, false)
+	require.Equal(t, []byte{0x0}, v.Bytes())
+
+	// the bits over the length are not flipped
+	require.Equal(t, []byte{0xf}, v.Not().Bytes())
+}
+
+func TestBitvector_FromBytes(t *testing.T) {
+	buf := make([]byte, 16)
+	v, err := BitvectorFromBytes(buf, 128)
+	require.NoError(t, err)
+
+	// the bytes are shared
+	v.SetBitAt(9, true)
+	require.Equal(t, byte(0x2), buf[1])
+
+	// incorrect number of bytes
+	_, err = BitvectorFromBytes(buf, 64)
+	require.Error(t, err)
+
+	// bits set over the length
+	_, err = BitvectorFromBytes([]byte{0x10}, 4)
+	require.Error(t, err)
+}
+
+func TestBitvector_SetOperations(t *testing.T) {
+	for _, size := range []uint64{8, 64, 100, 512} {
+		a := bitvectorWith(size, 0, 3, size-1)
+		b := bitvectorWith(size, 2, 3)
+
+		or, err := a.Or(b)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0, 2, 3, size - 1}, or.BitIndices())
+
+		and, err := a.And(b)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{3}, and.BitIndices())
+
+		xor, err := a.Xor(b)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0, 2, size - 1}, xor.BitIndices())
+
+		overlaps, err := a.Overlaps(b)
+		require.NoError(t, err)
+		require.True(t, overlaps)
+
+		overlaps, err = a.Overlaps(bitvectorWith(size, 1))
+		require.NoError(t, err)
+		require.False(t, overlaps)
+
+		contains, err := or.Contains(b)
+		require.NoError(t, err)
+		require.True(t, contains)
+
+		contains, err = b.Contains(or)
+		require.NoError(t, err)
+		require.False(t, contains)
+
+		require.Equal(t, uint64(3), a.Count())
+
+		not := a.Not()
+		require.Equal(t, size-3, not.Count())
+		require.True(t, not.Not().Equal(a))
+
+		_, err = a.And(NewBitvector(size + 1))
+		require.ErrorIs(t, err, ErrLengthMismatch)
+	}
+}
+
+func TestBitvector_HashTreeRoot(t *testing.T) {
+	// a single chunk is the root
+	root, err := bitvectorWith(64, 0, 9).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{0x1, 0x2}, root)
+
+	_, err = NewBitvector(0).HashTreeRoot()
+	require.Error(t, err)
+}
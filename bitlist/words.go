@@ -0,0 +1,99 @@
+package bitlist
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// The operations over the bits are done in words of 64 bits and
+// the remaining bytes (if any) are handled one at a time.
+
+func wordOp(dst, a, b []byte, op func(x, y uint64) uint64) {
+	i := 0
+	for ; i+8 <= len(dst); i += 8 {
+		x := binary.LittleEndian.Uint64(a[i:])
+		y := binary.LittleEndian.Uint64(b[i:])
+		binary.LittleEndian.PutUint64(dst[i:], op(x, y))
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = byte(op(uint64(a[i]), uint64(b[i])))
+	}
+}
+
+// wordAny returns true if the check is true for any of the words of a and b
+func wordAny(a, b []byte, check func(x, y uint64) bool) bool {
+	i := 0
+	for ; i+8 <= len(a); i += 8 {
+		if check(binary.LittleEndian.Uint64(a[i:]), binary.LittleEndian.Uint64(b[i:])) {
+			return true
+		}
+	}
+	for ; i < len(a); i++ {
+		if check(uint64(a[i]), uint64(b[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+func wordCount(a []byte) uint64 {
+	count := 0
+	i := 0
+	for ; i+8 <= len(a); i += 8 {
+		count += bits.OnesCount64(binary.LittleEndian.Uint64(a[i:]))
+	}
+	for ; i < len(a); i++ {
+		count += bits.OnesCount8(a[i])
+	}
+	return uint64(count)
+}
+
+// wordIndices returns the indices of the bits set in a that are lower than size
+func wordIndices(a []byte, size uint64) []uint64 {
+	indices := []uint64{}
+
+	i := 0
+	for ; i+8 <= len(a); i += 8 {
+		word := binary.LittleEndian.Uint64(a[i:])
+		for word != 0 {
+			indx := uint64(i*8 + bits.TrailingZeros64(word))
+			if indx >= size {
+				return indices
+			}
+			indices = append(indices, indx)
+			word &= word - 1
+		}
+	}
+	for ; i < len(a); i++ {
+		word := a[i]
+		for word != 0 {
+			indx := uint64(i*8 + bits.TrailingZeros8(word))
+			if indx >= size {
+				return indices
+			}
+			indices = append(indices, indx)
+			word &= word - 1
+		}
+	}
+	return indices
+}
+
+func or(x, y uint64) uint64 {
+	return x | y
+}
+
+func and(x, y uint64) uint64 {
+	return x & y
+}
+
+func xor(x, y uint64) uint64 {
+	return x ^ y
+}
+
+func overlaps(x, y uint64) bool {
+	return x&y != 0
+}
+
+func notContains(x, y uint64) bool {
+	return x&y != y
+}
@@ -0,0 +1,410 @@
+package consensus
+
+import (
+	"fmt"
+)
+
+// PayloadMismatchError is returned when the hash tree root of an execution
+// payload does not match the payload header of the blinded block
+type PayloadMismatchError struct {
+	Expected [32]byte
+	Found    [32]byte
+}
+
+func (e *PayloadMismatchError) Error() string {
+	return fmt.Sprintf("payload root mismatch, expected %x but found %x", e.Expected, e.Found)
+}
+
+// Blind returns the blinded block of a beacon block, which replaces
+// the execution payload with its header
+func Blind(block BeaconBlock) (BlindedBlock, error) {
+	switch obj := block.(type) {
+	case *BeaconBlockBellatrix:
+		if obj.Body == nil || obj.Body.ExecutionPayload == nil {
+			return nil, fmt.Errorf("block without execution payload")
+		}
+		header, err := obj.Body.ExecutionPayload.Header()
+		if err != nil {
+			return nil, err
+		}
+		body := obj.Body
+		blinded := &BlindedBeaconBlock{
+			Slot:          obj.Slot,
+			ProposerIndex: obj.ProposerIndex,
+			ParentRoot:    obj.ParentRoot,
+			StateRoot:     obj.StateRoot,
+			Body: &BlindedBeaconBlockBody{
+				RandaoReveal:           body.RandaoReveal,
+				Eth1Data:               body.Eth1Data,
+				Graffiti:               body.Graffiti,
+				ProposerSlashings:      body.ProposerSlashings,
+				AttesterSlashings:      body.AttesterSlashings,
+				Attestations:           body.Attestations,
+				Deposits:               body.Deposits,
+				VoluntaryExits:         body.VoluntaryExits,
+				SyncAggregate:          body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+			},
+		}
+		return blinded, nil
+
+	case *BeaconBlockCapella:
+		if obj.Body == nil || obj.Body.ExecutionPayload == nil {
+			return nil, fmt.Errorf("block without execution payload")
+		}
+		header, err := obj.Body.ExecutionPayload.Header()
+		if err != nil {
+			return nil, err
+		}
+		body := obj.Body
+		blinded := &BlindedBeaconBlockCapella{
+			Slot:          obj.Slot,
+			ProposerIndex: obj.ProposerIndex,
+			ParentRoot:    obj.ParentRoot,
+			StateRoot:     obj.StateRoot,
+			Body: &BlindedBeaconBlockBodyCapella{
+				RandaoReveal:           body.RandaoReveal,
+				Eth1Data:               body.Eth1Data,
+				Graffiti:               body.Graffiti,
+				ProposerSlashings:      body.ProposerSlashings,
+				AttesterSlashings:      body.AttesterSlashings,
+				Attestations:           body.Attestations,
+				Deposits:               body.Deposits,
+				VoluntaryExits:         body.VoluntaryExits,
+				SyncAggregate:          body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+				BlsToExecutionChanges:  body.BlsToExecutionChanges,
+			},
+		}
+		return blinded, nil
+
+	case *BeaconBlockDeneb:
+		if obj.Body == nil || obj.Body.ExecutionPayload == nil {
+			return nil, fmt.Errorf("block without execution payload")
+		}
+		header, err := obj.Body.ExecutionPayload.Header()
+		if err != nil {
+			return nil, err
+		}
+		body := obj.Body
+		blinded := &BlindedBeaconBlockDeneb{
+			Slot:          obj.Slot,
+			ProposerIndex: obj.ProposerIndex,
+			ParentRoot:    obj.ParentRoot,
+			StateRoot:     obj.StateRoot,
+			Body: &BlindedBeaconBlockBodyDeneb{
+				RandaoReveal:           body.RandaoReveal,
+				Eth1Data:               body.Eth1Data,
+				Graffiti:               body.Graffiti,
+				ProposerSlashings:      body.ProposerSlashings,
+				AttesterSlashings:      body.AttesterSlashings,
+				Attestations:           body.Attestations,
+				Deposits:               body.Deposits,
+				VoluntaryExits:         body.VoluntaryExits,
+				SyncAggregate:          body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+				BlsToExecutionChanges:  body.BlsToExecutionChanges,
+				BlobKzgCommitments:     body.BlobKzgCommitments,
+			},
+		}
+		return blinded, nil
+
+	case *BeaconBlockElectra:
+		if obj.Body == nil || obj.Body.ExecutionPayload == nil {
+			return nil, fmt.Errorf("block without execution payload")
+		}
+		header, err := obj.Body.ExecutionPayload.Header()
+		if err != nil {
+			return nil, err
+		}
+		body := obj.Body
+		blinded := &BlindedBeaconBlockElectra{
+			Slot:          obj.Slot,
+			ProposerIndex: obj.ProposerIndex,
+			ParentRoot:    obj.ParentRoot,
+			StateRoot:     obj.StateRoot,
+			Body: &BlindedBeaconBlockBodyElectra{
+				RandaoReveal:           body.RandaoReveal,
+				Eth1Data:               body.Eth1Data,
+				Graffiti:               body.Graffiti,
+				ProposerSlashings:      body.ProposerSlashings,
+				AttesterSlashings:      body.AttesterSlashings,
+				Attestations:           body.Attestations,
+				Deposits:               body.Deposits,
+				VoluntaryExits:         body.VoluntaryExits,
+				SyncAggregate:          body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+				BlsToExecutionChanges:  body.BlsToExecutionChanges,
+				BlobKzgCommitments:     body.BlobKzgCommitments,
+				ExecutionRequests:      body.ExecutionRequests,
+			},
+		}
+		return blinded, nil
+
+	default:
+		return nil, fmt.Errorf("block %T does not have a blinded version", block)
+	}
+}
+
+// Unblind returns the beacon block of a blinded block with the given
+// execution payload. It fails if the hash tree root of the payload
+// does not match the payload header of the blinded block.
+func Unblind(blinded BlindedBlock, payload Payload) (BeaconBlock, error) {
+	switch obj := blinded.(type) {
+	case *BlindedBeaconBlock:
+		if obj.Body == nil {
+			return nil, fmt.Errorf("blinded block without body")
+		}
+		execPayload, ok := payload.(*ExecutionPayload)
+		if !ok {
+			return nil, fmt.Errorf("payload %T does not match blinded block %T", payload, blinded)
+		}
+		if err := checkPayloadRoot(obj.Body.ExecutionPayloadHeader, execPayload); err != nil {
+			return nil, err
+		}
+		body := obj.Body
+		block := &BeaconBlockBellatrix{
+			Slot:          obj.Slot,
+			ProposerIndex: obj.ProposerIndex,
+			ParentRoot:    obj.ParentRoot,
+			StateRoot:     obj.StateRoot,
+			Body: &BeaconBlockBodyBellatrix{
+				RandaoReveal:      body.RandaoReveal,
+				Eth1Data:          body.Eth1Data,
+				Graffiti:          body.Graffiti,
+				ProposerSlashings: body.ProposerSlashings,
+				AttesterSlashings: body.AttesterSlashings,
+				Attestations:      body.Attestations,
+				Deposits:          body.Deposits,
+				VoluntaryExits:    body.VoluntaryExits,
+				SyncAggregate:     body.SyncAggregate,
+				ExecutionPayload:  execPayload,
+			},
+		}
+		return block, nil
+
+	case *BlindedBeaconBlockCapella:
+		if obj.Body == nil {
+			return nil, fmt.Errorf("blinded block without body")
+		}
+		execPayload, ok := payload.(*ExecutionPayloadCapella)
+		if !ok {
+			return nil, fmt.Errorf("payload %T does not match blinded block %T", payload, blinded)
+		}
+		if err := checkPayloadRoot(obj.Body.ExecutionPayloadHeader, execPayload); err != nil {
+			return nil, err
+		}
+		body := obj.Body
+		block := &BeaconBlockCapella{
+			Slot:          obj.Slot,
+			ProposerIndex: obj.ProposerIndex,
+			ParentRoot:    obj.ParentRoot,
+			StateRoot:     obj.StateRoot,
+			Body: &BeaconBlockBodyCapella{
+				RandaoReveal:          body.RandaoReveal,
+				Eth1Data:              body.Eth1Data,
+				Graffiti:              body.Graffiti,
+				ProposerSlashings:     body.ProposerSlashings,
+				AttesterSlashings:     body.AttesterSlashings,
+				Attestations:          body.Attestations,
+				Deposits:              body.Deposits,
+				VoluntaryExits:        body.VoluntaryExits,
+				SyncAggregate:         body.SyncAggregate,
+				ExecutionPayload:      execPayload,
+				BlsToExecutionChanges: body.BlsToExecutionChanges,
+			},
+		}
+		return block, nil
+
+	case *BlindedBeaconBlockDeneb:
+		if obj.Body == nil {
+			return nil, fmt.Errorf("blinded block without body")
+		}
+		execPayload, ok := payload.(*ExecutionPayloadDeneb)
+		if !ok {
+			return nil, fmt.Errorf("payload %T does not match blinded block %T", payload, blinded)
+		}
+		if err := checkPayloadRoot(obj.Body.ExecutionPayloadHeader, execPayload); err != nil {
+			return nil, err
+		}
+		body := obj.Body
+		block := &BeaconBlockDeneb{
+			Slot:          obj.Slot,
+			ProposerIndex: obj.ProposerIndex,
+			ParentRoot:    obj.ParentRoot,
+			StateRoot:     obj.StateRoot,
+			Body: &BeaconBlockBodyDeneb{
+				RandaoReveal:          body.RandaoReveal,
+				Eth1Data:              body.Eth1Data,
+				Graffiti:              body.Graffiti,
+				ProposerSlashings:     body.ProposerSlashings,
+				AttesterSlashings:     body.AttesterSlashings,
+				Attestations:          body.Attestations,
+				Deposits:              body.Deposits,
+				VoluntaryExits:        body.VoluntaryExits,
+				SyncAggregate:         body.SyncAggregate,
+				ExecutionPayload:      execPayload,
+				BlsToExecutionChanges: body.BlsToExecutionChanges,
+				BlobKzgCommitments:    body.BlobKzgCommitments,
+			},
+		}
+		return block, nil
+
+	case *BlindedBeaconBlockElectra:
+		if obj.Body == nil {
+			return nil, fmt.Errorf("blinded block without body")
+		}
+		execPayload, ok := payload.(*ExecutionPayloadDeneb)
+		if !ok {
+			return nil, fmt.Errorf("payload %T does not match blinded block %T", payload, blinded)
+		}
+		if err := checkPayloadRoot(obj.Body.ExecutionPayloadHeader, execPayload); err != nil {
+			return nil, err
+		}
+		body := obj.Body
+		block := &BeaconBlockElectra{
+			Slot:          obj.Slot,
+			ProposerIndex: obj.ProposerIndex,
+			ParentRoot:    obj.ParentRoot,
+			StateRoot:     obj.StateRoot,
+			Body: &BeaconBlockBodyElectra{
+				RandaoReveal:          body.RandaoReveal,
+				Eth1Data:              body.Eth1Data,
+				Graffiti:              body.Graffiti,
+				ProposerSlashings:     body.ProposerSlashings,
+				AttesterSlashings:     body.AttesterSlashings,
+				Attestations:          body.Attestations,
+				Deposits:              body.Deposits,
+				VoluntaryExits:        body.VoluntaryExits,
+				SyncAggregate:         body.SyncAggregate,
+				ExecutionPayload:      execPayload,
+				BlsToExecutionChanges: body.BlsToExecutionChanges,
+				BlobKzgCommitments:    body.BlobKzgCommitments,
+				ExecutionRequests:     body.ExecutionRequests,
+			},
+		}
+		return block, nil
+
+	default:
+		return nil, errUnknownType(blinded)
+	}
+}
+
+// BlindSigned returns the signed blinded block of a signed beacon block.
+// Both blocks have the same hash tree root and share the signature.
+func BlindSigned(block SignedBeaconBlock) (SignedBlindedBlock, error) {
+	switch obj := block.(type) {
+	case *SignedBeaconBlockBellatrix:
+		if obj.Block == nil {
+			return nil, fmt.Errorf("signed block without block")
+		}
+		blinded, err := Blind(obj.Block)
+		if err != nil {
+			return nil, err
+		}
+		return &SignedBlindedBeaconBlock{Block: blinded.(*BlindedBeaconBlock), Signature: obj.Signature}, nil
+
+	case *SignedBeaconBlockCapella:
+		if obj.Block == nil {
+			return nil, fmt.Errorf("signed block without block")
+		}
+		blinded, err := Blind(obj.Block)
+		if err != nil {
+			return nil, err
+		}
+		return &SignedBlindedBeaconBlockCapella{Block: blinded.(*BlindedBeaconBlockCapella), Signature: obj.Signature}, nil
+
+	case *SignedBeaconBlockDeneb:
+		if obj.Block == nil {
+			return nil, fmt.Errorf("signed block without block")
+		}
+		blinded, err := Blind(obj.Block)
+		if err != nil {
+			return nil, err
+		}
+		return &SignedBlindedBeaconBlockDeneb{Block: blinded.(*BlindedBeaconBlockDeneb), Signature: obj.Signature}, nil
+
+	case *SignedBeaconBlockElectra:
+		if obj.Block == nil {
+			return nil, fmt.Errorf("signed block without block")
+		}
+		blinded, err := Blind(obj.Block)
+		if err != nil {
+			return nil, err
+		}
+		return &SignedBlindedBeaconBlockElectra{Block: blinded.(*BlindedBeaconBlockElectra), Signature: obj.Signature}, nil
+
+	default:
+		return nil, fmt.Errorf("block %T does not have a blinded version", block)
+	}
+}
+
+// UnblindSigned returns the signed beacon block of a signed blinded block
+// with the given execution payload (i.e. the payload returned by the builder)
+func UnblindSigned(blinded SignedBlindedBlock, payload Payload) (SignedBeaconBlock, error) {
+	switch obj := blinded.(type) {
+	case *SignedBlindedBeaconBlock:
+		if obj.Block == nil {
+			return nil, fmt.Errorf("signed blinded block without block")
+		}
+		block, err := Unblind(obj.Block, payload)
+		if err != nil {
+			return nil, err
+		}
+		return &SignedBeaconBlockBellatrix{Block: block.(*BeaconBlockBellatrix), Signature: obj.Signature}, nil
+
+	case *SignedBlindedBeaconBlockCapella:
+		if obj.Block == nil {
+			return nil, fmt.Errorf("signed blinded block without block")
+		}
+		block, err := Unblind(obj.Block, payload)
+		if err != nil {
+			return nil, err
+		}
+		return &SignedBeaconBlockCapella{Block: block.(*BeaconBlockCapella), Signature: obj.Signature}, nil
+
+	case *SignedBlindedBeaconBlockDeneb:
+		if obj.Block == nil {
+			return nil, fmt.Errorf("signed blinded block without block")
+		}
+		block, err := Unblind(obj.Block, payload)
+		if err != nil {
+			return nil, err
+		}
+		return &SignedBeaconBlockDeneb{Block: block.(*BeaconBlockDeneb), Signature: obj.Signature}, nil
+
+	case *SignedBlindedBeaconBlockElectra:
+		if obj.Block == nil {
+			return nil, fmt.Errorf("signed blinded block without block")
+		}
+		block, err := Unblind(obj.Block, payload)
+		if err != nil {
+			return nil, err
+		}
+		return &SignedBeaconBlockElectra{Block: block.(*BeaconBlockElectra), Signature: obj.Signature}, nil
+
+	default:
+		return nil, errUnknownType(blinded)
+	}
+}
+
+func checkPayloadRoot(header PayloadHeader, payload Payload) error {
+	if isNil(header) {
+		return fmt.Errorf("blinded block without payload header")
+	}
+	if isNil(payload) {
+		return fmt.Errorf("empty payload")
+	}
+	expected, err := header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	found, err := payload.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if expected != found {
+		return &PayloadMismatchError{Expected: expected, Found: found}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package consensus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlind_Bellatrix(t *testing.T) {
+	payload := &ExecutionPayload{
+		BlockNumber:  10,
+		ExtraData:    []byte{0x1},
+		Transactions: [][]byte{{0x1, 0x2}, {0x3}},
+	}
+	signed := &SignedBeaconBlockBellatrix{
+		Block: &BeaconBlockBellatrix{
+			Slot:          5,
+			ProposerIndex: 1,
+			Body: &BeaconBlockBodyBellatrix{
+				Graffiti:         [32]byte{0x1},
+				Attestations:     []*Attestation{{AggregationBits: []byte{0x1}}},
+				ExecutionPayload: payload,
+			},
+		},
+		Signature: Signature{0x1},
+	}
+
+	blinded, err := BlindSigned(signed)
+	require.NoError(t, err)
+
+	// the blinded and the full block have the same root
+	blockRoot, err := signed.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blindedRoot, err := blinded.(*SignedBlindedBeaconBlock).Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, blindedRoot)
+
+	// unblind with the builder payload
+	full, err := UnblindSigned(blinded, payload)
+	require.NoError(t, err)
+	assert.Equal(t, signed, full)
+
+	// the builder returns a different payload
+	_, err = UnblindSigned(blinded, &ExecutionPayload{BlockNumber: 11})
+
+	var mismatchErr *PayloadMismatchError
+	require.True(t, errors.As(err, &mismatchErr))
+
+	payloadRoot, err := payload.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, payloadRoot, mismatchErr.Expected)
+
+	// the builder returns a payload of a different fork
+	_, err = UnblindSigned(blinded, &ExecutionPayloadCapella{})
+	require.Error(t, err)
+}
+
+func TestBlind_Unsupported(t *testing.T) {
+	_, err := Blind(&BeaconBlockAltair{})
+	require.Error(t, err)
+
+	_, err = Blind(&BeaconBlockBellatrix{})
+	require.Error(t, err)
+}
+
+func TestBlind_Forks(t *testing.T) {
+	withdrawals := []*Withdrawal{{Index: 1, Amount: 2}}
+	denebPayload := &ExecutionPayloadDeneb{BlockNumber: 1, Withdrawals: withdrawals, BlobGasUsed: 10}
+
+	cases := []struct {
+		block   SignedBeaconBlock
+		payload Payload
+	}{
+		{
+			&SignedBeaconBlockCapella{
+				Block: &BeaconBlockCapella{
+					Slot: 1,
+					Body: &BeaconBlockBodyCapella{
+						ExecutionPayload:      &ExecutionPayloadCapella{BlockNumber: 1, Withdrawals: withdrawals},
+						BlsToExecutionChanges: []*SignedBLSToExecutionChange{{Message: &BLSToExecutionChange{ValidatorIndex: 1}}},
+					},
+				},
+			},
+			&ExecutionPayloadCapella{BlockNumber: 1, Withdrawals: withdrawals},
+		},
+		{
+			&SignedBeaconBlockDeneb{
+				Block: &BeaconBlockDeneb{
+					Slot: 2,
+					Body: &BeaconBlockBodyDeneb{
+						ExecutionPayload:   denebPayload,
+						BlobKzgCommitments: [][48]byte{{0x1}},
+					},
+				},
+			},
+			denebPayload,
+		},
+		{
+			&SignedBeaconBlockElectra{
+				Block: &BeaconBlockElectra{
+					Slot: 3,
+					Body: &BeaconBlockBodyElectra{
+						ExecutionPayload:   denebPayload,
+						BlobKzgCommitments: [][48]byte{{0x1}},
+						ExecutionRequests:  &ExecutionRequests{Deposits: []*DepositRequest{{Index: 1}}},
+					},
+				},
+			},
+			denebPayload,
+		},
+	}
+
+	for _, c := range cases {
+		blinded, err := BlindSigned(c.block)
+		require.NoError(t, err)
+
+		blockRoot, err := c.block.HashTreeRoot()
+		require.NoError(t, err)
+		blindedRoot, err := blinded.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, blockRoot, blindedRoot)
+
+		full, err := UnblindSigned(blinded, c.payload)
+		require.NoError(t, err)
+		assert.Equal(t, c.block, full)
+
+		_, err = UnblindSigned(blinded, &ExecutionPayload{})
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,45 @@
+package bls
+
+import "fmt"
+
+// batchRandomBits is the number of bits of the random scalars of the batch verification
+const batchRandomBits = 64
+
+// BatchVerifier verifies many signatures at once with a random linear combination
+// of the signatures, which is faster than verifying them one by one. A failed
+// batch does not tell which signature is invalid.
+type BatchVerifier struct {
+	pubKeys []*PublicKey
+	msgs    [][]byte
+	sigs    []*Signature
+}
+
+// NewBatchVerifier creates an empty batch verifier
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add adds the signature of a message by a public key to the batch
+func (b *BatchVerifier) Add(pub *PublicKey, msg []byte, sig *Signature) {
+	b.pubKeys = append(b.pubKeys, pub)
+	b.msgs = append(b.msgs, msg)
+	b.sigs = append(b.sigs, sig)
+}
+
+// Len returns the number of signatures in the batch
+func (b *BatchVerifier) Len() int {
+	return len(b.sigs)
+}
+
+// Verify verifies all the signatures of the batch. An empty batch is valid.
+func (b *BatchVerifier) Verify() (bool, error) {
+	if len(b.sigs) == 0 {
+		return true, nil
+	}
+	for indx := range b.sigs {
+		if b.pubKeys[indx] == nil || b.sigs[indx] == nil {
+			return false, fmt.Errorf("empty public key or signature at index %d", indx)
+		}
+	}
+	return verifyBatch(b.pubKeys, b.msgs, b.sigs)
+}
@@ -0,0 +1,88 @@
+package bls
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func signMessages(t *testing.T, n int) ([]*PublicKey, [][]byte, []*Signature) {
+	pubs := make([]*PublicKey, n)
+	msgs := make([][]byte, n)
+	sigs := make([]*Signature, n)
+
+	for i := 0; i < n; i++ {
+		key := NewRandomKey()
+		msg := []byte(fmt.Sprintf("message %d", i))
+
+		sig, err := key.Prv.Sign(msg)
+		require.NoError(t, err)
+
+		pubs[i], msgs[i], sigs[i] = key.Pub, msg, sig
+	}
+	return pubs, msgs, sigs
+}
+
+func TestAggregateVerify(t *testing.T) {
+	pubs, msgs, sigs := signMessages(t, 3)
+	aggSig := AggregateSignatures(sigs)
+
+	ok, err := aggSig.AggregateVerify(pubs, msgs)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// messages in a different order
+	ok, err = aggSig.AggregateVerify(pubs, [][]byte{msgs[1], msgs[0], msgs[2]})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// a signature is missing
+	ok, err = AggregateSignatures(sigs[:2]).AggregateVerify(pubs, msgs)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = aggSig.AggregateVerify(pubs, msgs[:2])
+	require.Error(t, err)
+}
+
+func TestBatchVerifier(t *testing.T) {
+	pubs, msgs, sigs := signMessages(t, 20)
+
+	batch := NewBatchVerifier()
+	for i := range pubs {
+		batch.Add(pubs[i], msgs[i], sigs[i])
+	}
+	require.Equal(t, 20, batch.Len())
+
+	ok, err := batch.Verify()
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// two swapped signatures
+	batch = NewBatchVerifier()
+	for i := range pubs {
+		sig := sigs[i]
+		if i == 3 {
+			sig = sigs[4]
+		} else if i == 4 {
+			sig = sigs[3]
+		}
+		batch.Add(pubs[i], msgs[i], sig)
+	}
+	ok, err = batch.Verify()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// a single invalid signature
+	batch = NewBatchVerifier()
+	batch.Add(pubs[0], msgs[1], sigs[0])
+	ok, err = batch.Verify()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// empty batch
+	ok, err = NewBatchVerifier().Verify()
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -0,0 +1,47 @@
+// Package bls implements the BLS signatures of the Ethereum consensus layer
+// (BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_). It is backed by blst in the cgo
+// builds and by kilic/bls12-381 otherwise. Both backends follow the same contract:
+//
+//   - SecretKey.Unmarshal only accepts 32 bytes (big endian) with 0 < sk < r.
+//   - PublicKey.Deserialize only accepts a 48 bytes compressed G1 point in the
+//     subgroup that is not the point at infinity (KeyValidate).
+//   - Signature.Deserialize only accepts a 96 bytes compressed G2 point in the
+//     subgroup. The point at infinity is a valid signature encoding.
+//   - VerifyByte, FastAggregateVerify and AggregateVerify return false for the
+//     signature at infinity, for an empty list of public keys and if the aggregated
+//     public key is the point at infinity.
+//   - AggregateSignatures and AggregatePublicKeys return nil for an empty list.
+package bls
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	secretKeySize = 32
+	publicKeySize = 48
+	signatureSize = 96
+)
+
+func checkSize(buf []byte, size int, name string) error {
+	if len(buf) != size {
+		return fmt.Errorf("%s must be %d bytes but found %d", name, size, len(buf))
+	}
+	return nil
+}
+
+// secretKeyFromBytes decodes a secret key in the range (0, r)
+func secretKeyFromBytes(data []byte) (*big.Int, error) {
+	if err := checkSize(data, secretKeySize, "secret key"); err != nil {
+		return nil, err
+	}
+	key := new(big.Int).SetBytes(data)
+	if key.Sign() == 0 {
+		return nil, fmt.Errorf("secret key is zero")
+	}
+	if key.Cmp(curveOrder) >= 0 {
+		return nil, fmt.Errorf("secret key is not lower than the curve order")
+	}
+	return key, nil
+}
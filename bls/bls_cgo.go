@@ -6,6 +6,7 @@ package bls
 import (
 	"crypto/rand"
 	"fmt"
+	"math/big"
 
 	blst "github.com/supranational/blst/bindings/go"
 )
@@ -21,12 +22,15 @@ type Signature struct {
 }
 
 func (s *Signature) Deserialize(buf []byte) error {
+	if err := checkSize(buf, signatureSize, "signature"); err != nil {
+		return err
+	}
 	sig := new(blstSignature).Uncompress(buf)
 	if sig == nil {
-		return fmt.Errorf("failed to deserialize")
+		return fmt.Errorf("failed to deserialize signature")
 	}
 	if !sig.SigValidate(false) {
-		return fmt.Errorf("signature not in group")
+		return fmt.Errorf("signature is not in the subgroup")
 	}
 	s.sig = sig
 	return nil
@@ -38,16 +42,68 @@ func (s *Signature) Serialize() (buf [96]byte) {
 }
 
 func (s *Signature) VerifyByte(pub *PublicKey, msg []byte) (bool, error) {
+	if s.isInfinity() {
+		return false, nil
+	}
 	return s.sig.Verify(false, pub.pub, false, msg, dst), nil
 }
 
 func (s *Signature) FastAggregateVerify(pubKeys []*PublicKey, msg []byte) (bool, error) {
+	if len(pubKeys) == 0 || s.isInfinity() {
+		return false, nil
+	}
+	aggPub := AggregatePublicKeys(pubKeys)
+	if !aggPub.pub.KeyValidate() {
+		// the aggregated public key is the point at infinity
+		return false, nil
+	}
+	return s.sig.Verify(false, aggPub.pub, false, msg, dst), nil
+}
+
+func (s *Signature) isInfinity() bool {
+	return s.sig.Equals(new(blstSignature))
+}
+
+// AggregateVerify verifies an aggregated signature of a different message for each public key
+func (s *Signature) AggregateVerify(pubKeys []*PublicKey, msgs [][]byte) (bool, error) {
+	if len(pubKeys) != len(msgs) {
+		return false, fmt.Errorf("expected the same number of public keys and messages")
+	}
+	if len(pubKeys) == 0 || s.isInfinity() {
+		return false, nil
+	}
 	raw := make([]*blstPublicKey, len(pubKeys))
 	for indx, i := range pubKeys {
 		raw[indx] = i.pub
 	}
+	rawMsgs := make([]blst.Message, len(msgs))
+	for indx, msg := range msgs {
+		rawMsgs[indx] = msg
+	}
 
-	return s.sig.FastAggregateVerify(true, raw, msg, dst), nil
+	return s.sig.AggregateVerify(false, raw, false, rawMsgs, dst), nil
+}
+
+// verifyBatch uses the multi-pairing of blst to verify the random linear combination of the signatures
+func verifyBatch(pubKeys []*PublicKey, msgs [][]byte, sigs []*Signature) (bool, error) {
+	rawPubs := make([]*blstPublicKey, len(pubKeys))
+	rawSigs := make([]*blstSignature, len(sigs))
+	rawMsgs := make([]blst.Message, len(msgs))
+
+	for i := range pubKeys {
+		rawPubs[i] = pubKeys[i].pub
+		rawSigs[i] = sigs[i].sig
+		rawMsgs[i] = msgs[i]
+	}
+
+	randFn := func(scalar *blst.Scalar) {
+		var buf [32]byte
+		_, _ = rand.Read(buf[32-batchRandomBits/8:])
+		scalar.Deserialize(buf[:])
+	}
+
+	ok := new(blstSignature).MultipleAggregateVerify(rawSigs, false, rawPubs, false, rawMsgs, dst, randFn, batchRandomBits)
+	return ok, nil
 }
 
 func AggregateSignatures(sigs []*Signature) *Signature {
@@ -65,18 +121,74 @@ func AggregateSignatures(sigs []*Signature) *Signature {
 	return &Signature{sig: sig.ToAffine()}
 }
 
+// AggregatePublicKeys aggregates a list of public keys into a single one
+func AggregatePublicKeys(pubs []*PublicKey) *PublicKey {
+	if len(pubs) == 0 {
+		return nil
+	}
+	raw := make([]*blstPublicKey, len(pubs))
+	for indx, i := range pubs {
+		raw[indx] = i.pub
+	}
+
+	pub := new(blst.P1Aggregate)
+	pub.Aggregate(raw, false)
+
+	return &PublicKey{pub: pub.ToAffine()}
+}
+
+// signatureLinearCombination returns sum(scalars_i * sigs_i)
+func signatureLinearCombination(sigs []*Signature, scalars []*big.Int) *Signature {
+	points := make([]*blstSignature, len(sigs))
+	for indx, sig := range sigs {
+		points[indx] = sig.sig
+	}
+	res := blst.P2AffinesMult(points, scalarsToLittleEndian(scalars), scalarBits)
+	return &Signature{sig: res.ToAffine()}
+}
+
+// publicKeyLinearCombination returns sum(scalars_i * pubs_i)
+func publicKeyLinearCombination(pubs []*PublicKey, scalars []*big.Int) *PublicKey {
+	points := make([]*blstPublicKey, len(pubs))
+	for indx, pub := range pubs {
+		points[indx] = pub.pub
+	}
+	res := blst.P1AffinesMult(points, scalarsToLittleEndian(scalars), scalarBits)
+	return &PublicKey{pub: res.ToAffine()}
+}
+
+// scalarBits is the number of bits of the scalars lower than the curve order
+const scalarBits = 255
+
+// scalarsToLittleEndian encodes the scalars in the little endian format of blst
+func scalarsToLittleEndian(scalars []*big.Int) [][]byte {
+	res := make([][]byte, len(scalars))
+	for indx, scalar := range scalars {
+		buf := make([]byte, 32)
+		scalar.FillBytes(buf)
+		for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+			buf[i], buf[j] = buf[j], buf[i]
+		}
+		res[indx] = buf
+	}
+	return res
+}
+
 // PublicKey is a Bls public key
 type PublicKey struct {
 	pub *blstPublicKey
 }
 
 func (p *PublicKey) Deserialize(buf []byte) error {
+	if err := checkSize(buf, publicKeySize, "public key"); err != nil {
+		return err
+	}
 	pub := new(blstPublicKey).Uncompress(buf)
 	if pub == nil {
-		return fmt.Errorf("failed to deserialize")
+		return fmt.Errorf("failed to deserialize public key")
 	}
 	if !pub.KeyValidate() {
-		return fmt.Errorf("point at infinity")
+		return fmt.Errorf("public key is the point at infinity or not in the subgroup")
 	}
 	p.pub = pub
 	return nil
@@ -93,7 +205,14 @@ type SecretKey struct {
 }
 
 func (s *SecretKey) Unmarshal(data []byte) error {
-	s.key = new(blst.SecretKey).Deserialize(data)
+	if _, err := secretKeyFromBytes(data); err != nil {
+		return err
+	}
+	key := new(blst.SecretKey).Deserialize(data)
+	if key == nil {
+		return fmt.Errorf("failed to deserialize secret key")
+	}
+	s.key = key
 	return nil
 }
 
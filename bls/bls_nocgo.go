@@ -7,6 +7,8 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"runtime"
+	"sync"
 
 	bls12381 "github.com/kilic/bls12-381"
 )
@@ -22,9 +24,12 @@ type Signature struct {
 }
 
 func (s *Signature) Deserialize(buf []byte) error {
+	if err := checkSize(buf, signatureSize, "signature"); err != nil {
+		return err
+	}
 	g2, err := bls12381.NewG2().FromCompressed(buf)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to deserialize signature: %v", err)
 	}
 	s.sig = g2
 	return nil
@@ -37,9 +42,16 @@ func (s *Signature) Serialize() (res [96]byte) {
 }
 
 func (s *Signature) VerifyByte(pub *PublicKey, msg []byte) (bool, error) {
+	if s.isInfinity() {
+		return false, nil
+	}
 	return s.verifyImpl(pub.pub, msg)
 }
 
+func (s *Signature) isInfinity() bool {
+	return bls12381.NewG2().IsZero(s.sig)
+}
+
 func (s *Signature) verifyImpl(g1 *bls12381.PointG1, msg []byte) (bool, error) {
 	hash, err := bls12381.NewG2().HashToCurve(msg, domain)
 	if err != nil {
@@ -54,24 +66,117 @@ func (s *Signature) verifyImpl(g1 *bls12381.PointG1, msg []byte) (bool, error) {
 }
 
 func (s *Signature) FastAggregateVerify(pubKeys []*PublicKey, msg []byte) (bool, error) {
-	if bls12381.NewG2().IsZero(s.sig) {
-		// signature is infinite
+	if len(pubKeys) == 0 || s.isInfinity() {
 		return false, nil
 	}
 
-	// aggregate public keys
-	aggPub := new(bls12381.PointG1)
+	aggPub := AggregatePublicKeys(pubKeys)
+	if bls12381.NewG1().IsZero(aggPub.pub) {
+		// the aggregated public key is the point at infinity
+		return false, nil
+	}
+	return s.verifyImpl(aggPub.pub, msg)
+}
+
+// AggregateVerify verifies an aggregated signature of a different message for each public key
+func (s *Signature) AggregateVerify(pubKeys []*PublicKey, msgs [][]byte) (bool, error) {
+	if len(pubKeys) != len(msgs) {
+		return false, fmt.Errorf("expected the same number of public keys and messages")
+	}
+	if len(pubKeys) == 0 || s.isInfinity() {
+		return false, nil
+	}
+
+	g2 := bls12381.NewG2()
+	e := bls12381.NewEngine()
+	e.AddPairInv(e.G1.One(), s.sig)
+
+	for indx, pub := range pubKeys {
+		hash, err := g2.HashToCurve(msgs[indx], domain)
+		if err != nil {
+			return false, err
+		}
+		e.AddPair(pub.pub, hash)
+	}
+	return e.Check(), nil
+}
+
+// verifyBatch verifies the random linear combination of the signatures. The pairings are computed
+// in parallel over chunks of the batch and the results are multiplied in the target group.
+func verifyBatch(pubKeys []*PublicKey, msgs [][]byte, sigs []*Signature) (bool, error) {
+	workers := runtime.NumCPU()
+	if workers > len(pubKeys) {
+		workers = len(pubKeys)
+	}
+	chunkSize := (len(pubKeys) + workers - 1) / workers
+
+	results := make([]*bls12381.E, workers)
+	errs := make([]error, workers)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		start := worker * chunkSize
+		end := start + chunkSize
+		if end > len(pubKeys) {
+			end = len(pubKeys)
+		}
+
+		wg.Add(1)
+		go func(worker, start, end int) {
+			defer wg.Done()
+			results[worker], errs[worker] = verifyBatchChunk(pubKeys[start:end], msgs[start:end], sigs[start:end])
+		}(worker, start, end)
+	}
+	wg.Wait()
+
+	gt := bls12381.NewGT()
+	res := gt.New()
+	for worker := range results {
+		if errs[worker] != nil {
+			return false, errs[worker]
+		}
+		gt.Mul(res, res, results[worker])
+	}
+	return res.IsOne(), nil
+}
+
+// verifyBatchChunk returns e(-g1, sum(r_i * sig_i)) * prod(e(r_i * pub_i, H(msg_i)))
+func verifyBatchChunk(pubKeys []*PublicKey, msgs [][]byte, sigs []*Signature) (*bls12381.E, error) {
 	g1 := bls12381.NewG1()
+	g2 := bls12381.NewG2()
+	e := bls12381.NewEngine()
 
-	for _, pub := range pubKeys {
-		aggPub = g1.Add(aggPub, aggPub, pub.pub)
+	aggSig := g2.Zero()
+	for indx, pub := range pubKeys {
+		r, err := randomScalar()
+		if err != nil {
+			return nil, err
+		}
+		hash, err := g2.HashToCurve(msgs[indx], domain)
+		if err != nil {
+			return nil, err
+		}
+
+		e.AddPair(g1.MulScalarBig(g1.New(), pub.pub, r), hash)
+		g2.Add(aggSig, aggSig, g2.MulScalarBig(g2.New(), sigs[indx].sig, r))
 	}
+	e.AddPairInv(g1.One(), aggSig)
 
-	ok, err := s.verifyImpl(aggPub, msg)
-	if err != nil {
-		return false, err
+	return e.Result(), nil
+}
+
+// randomScalar returns a non zero random scalar of batchRandomBits
+func randomScalar() (*big.Int, error) {
+	var buf [batchRandomBits / 8]byte
+	for {
+		if _, err := rand.Read(buf[:]); err != nil {
+			return nil, err
+		}
+		r := new(big.Int).SetBytes(buf[:])
+		if r.Sign() != 0 {
+			return r, nil
+		}
 	}
-	return ok, nil
 }
 
 func AggregateSignatures(sigs []*Signature) *Signature {
@@ -89,18 +194,59 @@ func AggregateSignatures(sigs []*Signature) *Signature {
 	return &Signature{sig: aggSig}
 }
 
+// AggregatePublicKeys aggregates a list of public keys into a single one
+func AggregatePublicKeys(pubs []*PublicKey) *PublicKey {
+	if len(pubs) == 0 {
+		return nil
+	}
+
+	aggPub := new(bls12381.PointG1)
+	g1 := bls12381.NewG1()
+
+	for _, pub := range pubs {
+		aggPub = g1.Add(aggPub, aggPub, pub.pub)
+	}
+
+	return &PublicKey{pub: aggPub}
+}
+
+// signatureLinearCombination returns sum(scalars_i * sigs_i)
+func signatureLinearCombination(sigs []*Signature, scalars []*big.Int) *Signature {
+	g2 := bls12381.NewG2()
+
+	res := g2.Zero()
+	for indx, sig := range sigs {
+		g2.Add(res, res, g2.MulScalarBig(g2.New(), sig.sig, scalars[indx]))
+	}
+	return &Signature{sig: res}
+}
+
+// publicKeyLinearCombination returns sum(scalars_i * pubs_i)
+func publicKeyLinearCombination(pubs []*PublicKey, scalars []*big.Int) *PublicKey {
+	g1 := bls12381.NewG1()
+
+	res := g1.Zero()
+	for indx, pub := range pubs {
+		g1.Add(res, res, g1.MulScalarBig(g1.New(), pub.pub, scalars[indx]))
+	}
+	return &PublicKey{pub: res}
+}
+
 // PublicKey is a Bls public key
 type PublicKey struct {
 	pub *blstPublicKey
 }
 
 func (p *PublicKey) Deserialize(buf []byte) error {
+	if err := checkSize(buf, publicKeySize, "public key"); err != nil {
+		return err
+	}
 	g1, err := bls12381.NewG1().FromCompressed(buf)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to deserialize public key: %v", err)
 	}
 	if bls12381.NewG1().IsZero(g1) {
-		return fmt.Errorf("infinity")
+		return fmt.Errorf("public key is the point at infinity or not in the subgroup")
 	}
 	p.pub = g1
 	return nil
@@ -118,12 +264,21 @@ type SecretKey struct {
 }
 
 func (s *SecretKey) Unmarshal(data []byte) error {
-	s.key = new(big.Int).SetBytes(data)
+	key, err := secretKeyFromBytes(data)
+	if err != nil {
+		return err
+	}
+	s.key = key
 	return nil
 }
 
 func (s *SecretKey) Marshal() ([]byte, error) {
-	return s.key.Bytes(), nil
+	buf := s.key.Bytes()
+	if len(buf) < 32 {
+		// keep the 32 bytes encoding of the cgo build
+		buf = append(make([]byte, 32-len(buf)), buf...)
+	}
+	return buf, nil
 }
 
 func (s *SecretKey) GetPublicKey() *PublicKey {
@@ -144,12 +299,14 @@ func (s *SecretKey) Sign(msg []byte) (*Signature, error) {
 	return &Signature{sig: hash}, nil
 }
 
-var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
-
 func RandomKey() *SecretKey {
-	k, err := rand.Int(rand.Reader, curveOrder)
-	if err != nil {
-		panic(err)
+	for {
+		k, err := rand.Int(rand.Reader, curveOrder)
+		if err != nil {
+			panic(err)
+		}
+		if k.Sign() != 0 {
+			return &SecretKey{key: k}
+		}
 	}
-	return &SecretKey{key: k}
 }
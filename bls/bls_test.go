@@ -37,6 +37,31 @@ func TestBLS_Simple(t *testing.T) {
 	require.True(t, valid)
 }
 
+func TestBLS_AggregatePublicKeys(t *testing.T) {
+	msg := []byte("msg")
+
+	pubs := []*PublicKey{}
+	sigs := []*Signature{}
+	for i := 0; i < 3; i++ {
+		priv := RandomKey()
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		pubs = append(pubs, priv.GetPublicKey())
+		sigs = append(sigs, sig)
+	}
+
+	aggPub := AggregatePublicKeys(pubs)
+	aggSig := AggregateSignatures(sigs)
+
+	valid, err := aggSig.VerifyByte(aggPub, msg)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	require.Nil(t, AggregatePublicKeys(nil))
+}
+
 func TestBLS_Aggregate(t *testing.T) {
 	type ref struct {
 		Input  []argBytes
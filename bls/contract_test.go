@@ -0,0 +1,186 @@
+package bls
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// the tests in this file do not depend on the backend and check that both
+// follow the contract described in the package documentation
+
+func decodeHex(t *testing.T, str string) []byte {
+	buf, err := hex.DecodeString(str)
+	require.NoError(t, err)
+	return buf
+}
+
+func TestContract_SecretKey(t *testing.T) {
+	bigToBytes := func(i *big.Int) []byte {
+		buf := make([]byte, 32)
+		i.FillBytes(buf)
+		return buf
+	}
+	one := big.NewInt(1)
+
+	invalid := [][]byte{
+		nil,
+		make([]byte, 31),
+		make([]byte, 33),
+		make([]byte, 32),
+		bigToBytes(curveOrder),
+		bigToBytes(new(big.Int).Add(curveOrder, one)),
+	}
+	for _, buf := range invalid {
+		require.Error(t, new(SecretKey).Unmarshal(buf))
+	}
+
+	// the largest secret key
+	sk := new(SecretKey)
+	require.NoError(t, sk.Unmarshal(bigToBytes(new(big.Int).Sub(curveOrder, one))))
+
+	// the public key of the secret key 1 is the generator of G1
+	sk = new(SecretKey)
+	require.NoError(t, sk.Unmarshal(bigToBytes(one)))
+
+	pub := sk.GetPublicKey().Serialize()
+	require.Equal(t, "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb", hex.EncodeToString(pub[:]))
+
+	buf, err := sk.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, bigToBytes(one), buf)
+}
+
+func TestContract_PublicKey(t *testing.T) {
+	infinity := make([]byte, 48)
+	infinity[0] = 0xc0
+
+	infinityExtraBits := make([]byte, 48)
+	infinityExtraBits[0] = 0xc0
+	infinityExtraBits[47] = 0x1
+
+	// x coordinate equal to the field modulus
+	xOverflow := decodeHex(t, "9a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab")
+
+	invalid := [][]byte{
+		nil,
+		infinity,
+		infinityExtraBits,
+		xOverflow,
+		make([]byte, 48),
+		decodeHex(t, "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6"),
+	}
+	for _, buf := range invalid {
+		require.Error(t, new(PublicKey).Deserialize(buf))
+	}
+
+	pub := new(PublicKey)
+	require.NoError(t, pub.Deserialize(decodeHex(t, "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb")))
+}
+
+func TestContract_Signature(t *testing.T) {
+	infinity := make([]byte, 96)
+	infinity[0] = 0xc0
+
+	// the signature at infinity is a valid encoding
+	sig := new(Signature)
+	require.NoError(t, sig.Deserialize(infinity))
+	require.Equal(t, infinity, toSlice(sig.Serialize()))
+
+	// the generator of G2
+	require.NoError(t, new(Signature).Deserialize(decodeHex(t, "93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8")))
+
+	invalid := [][]byte{
+		nil,
+		infinity[:95],
+		append(infinity, 0),
+		make([]byte, 96),
+	}
+	for _, buf := range invalid {
+		require.Error(t, new(Signature).Deserialize(buf))
+	}
+}
+
+func TestContract_Verify(t *testing.T) {
+	msg := []byte("msg")
+
+	infinity := make([]byte, 96)
+	infinity[0] = 0xc0
+
+	infinitySig := new(Signature)
+	require.NoError(t, infinitySig.Deserialize(infinity))
+
+	key := NewRandomKey()
+	sig, err := key.Prv.Sign(msg)
+	require.NoError(t, err)
+
+	// the signature at infinity is never valid
+	ok, err := infinitySig.VerifyByte(key.Pub, msg)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = infinitySig.FastAggregateVerify([]*PublicKey{key.Pub}, msg)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = infinitySig.AggregateVerify([]*PublicKey{key.Pub}, [][]byte{msg})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// empty list of public keys
+	ok, err = sig.FastAggregateVerify(nil, msg)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = sig.AggregateVerify(nil, nil)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// a valid signature
+	ok, err = sig.FastAggregateVerify([]*PublicKey{key.Pub}, msg)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// empty aggregates
+	require.Nil(t, AggregateSignatures(nil))
+	require.Nil(t, AggregatePublicKeys(nil))
+}
+
+func TestContract_AggregatedInfinity(t *testing.T) {
+	// the public keys of sk and r - sk add up to the point at infinity
+	key := NewRandomKey()
+
+	skBuf, err := key.Prv.Marshal()
+	require.NoError(t, err)
+
+	negBuf := make([]byte, 32)
+	new(big.Int).Sub(curveOrder, new(big.Int).SetBytes(skBuf)).FillBytes(negBuf)
+
+	neg := new(SecretKey)
+	require.NoError(t, neg.Unmarshal(negBuf))
+
+	msg := []byte("msg")
+
+	infinity := make([]byte, 96)
+	infinity[0] = 0xc0
+
+	// the aggregated signature of both keys is the signature at infinity
+	sig1, err := key.Prv.Sign(msg)
+	require.NoError(t, err)
+	sig2, err := neg.Sign(msg)
+	require.NoError(t, err)
+
+	aggSig := AggregateSignatures([]*Signature{sig1, sig2})
+	require.Equal(t, infinity, toSlice(aggSig.Serialize()))
+
+	// a signature over the aggregated public key at infinity is not valid either
+	ok, err := sig1.FastAggregateVerify([]*PublicKey{key.Pub, neg.GetPublicKey()}, msg)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func toSlice(buf [96]byte) []byte {
+	return buf[:]
+}
@@ -0,0 +1,200 @@
+package bls
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	uuid "github.com/hashicorp/go-uuid"
+	"golang.org/x/crypto/hkdf"
+)
+
+// curveOrder is the order (r) of the BLS12-381 curve
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// EIP-2333 (https://eips.ethereum.org/EIPS/eip-2333) key derivation
+
+const (
+	keygenSalt = "BLS-SIG-KEYGEN-SALT-"
+
+	// number of bytes of the hkdf output to reduce modulo r (ceil((3 * ceil(log2(r))) / 16))
+	hkdfModROutput = 48
+
+	// number of chunks of the lamport keys
+	lamportChunks = 255
+)
+
+// DeriveMasterSK derives the master secret key from a seed of at least 32 bytes
+func DeriveMasterSK(seed []byte) (*SecretKey, error) {
+	if len(seed) < 32 {
+		return nil, fmt.Errorf("seed must be at least 32 bytes but found %d", len(seed))
+	}
+	return hkdfModR(seed)
+}
+
+// DeriveChildSK derives the child secret key at the given index of a parent secret key
+func DeriveChildSK(parent *SecretKey, index uint32) (*SecretKey, error) {
+	parentBytes, err := parent.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	lamportPK, err := parentSKToLamportPK(parentBytes, index)
+	if err != nil {
+		return nil, err
+	}
+	return hkdfModR(lamportPK)
+}
+
+func hkdfModR(ikm []byte) (*SecretKey, error) {
+	// IKM || I2OSP(0, 1)
+	input := make([]byte, len(ikm)+1)
+	copy(input, ikm)
+
+	// key_info || I2OSP(L, 2) with an empty key_info
+	info := []byte{0, hkdfModROutput}
+
+	salt := []byte(keygenSalt)
+	sk := new(big.Int)
+
+	for sk.Sign() == 0 {
+		hash := sha256.Sum256(salt)
+		salt = hash[:]
+
+		okm := make([]byte, hkdfModROutput)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, input, salt, info), okm); err != nil {
+			return nil, err
+		}
+		sk.SetBytes(okm)
+		sk.Mod(sk, curveOrder)
+	}
+
+	buf := make([]byte, 32)
+	sk.FillBytes(buf)
+
+	key := &SecretKey{}
+	if err := key.Unmarshal(buf); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func parentSKToLamportPK(parent []byte, index uint32) ([]byte, error) {
+	// I2OSP(parent_SK, 32)
+	ikm := make([]byte, 32)
+	if len(parent) > 32 {
+		return nil, fmt.Errorf("secret key is over 32 bytes")
+	}
+	copy(ikm[32-len(parent):], parent)
+
+	salt := make([]byte, 4)
+	binary.BigEndian.PutUint32(salt, index)
+
+	notIkm := make([]byte, len(ikm))
+	for i := range ikm {
+		notIkm[i] = ^ikm[i]
+	}
+
+	lamportPK := make([]byte, 0, 2*lamportChunks*32)
+	for _, input := range [][]byte{ikm, notIkm} {
+		lamportSK := make([]byte, lamportChunks*32)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, input, salt, nil), lamportSK); err != nil {
+			return nil, err
+		}
+		for i := 0; i < lamportChunks; i++ {
+			hash := sha256.Sum256(lamportSK[i*32 : (i+1)*32])
+			lamportPK = append(lamportPK, hash[:]...)
+		}
+	}
+
+	compressed := sha256.Sum256(lamportPK)
+	return compressed[:], nil
+}
+
+// EIP-2334 (https://eips.ethereum.org/EIPS/eip-2334) key paths
+
+const (
+	purpose  = 12381
+	coinType = 3600
+)
+
+// SigningKeyPath returns the path of the signing key of the validator at the index
+func SigningKeyPath(index uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0/0", purpose, coinType, index)
+}
+
+// WithdrawalKeyPath returns the path of the withdrawal key of the validator at the index
+func WithdrawalKeyPath(index uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0", purpose, coinType, index)
+}
+
+// ParsePath parses a key path (i.e. m/12381/3600/0/0/0) into the indices of each level.
+// The levels after the master key must follow EIP-2334 (purpose 12381 and coin type 3600).
+func ParsePath(path string) ([]uint32, error) {
+	indices, err := parsePathIndices(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) > 0 && indices[0] != purpose {
+		return nil, fmt.Errorf("path '%s' must have purpose %d", path, purpose)
+	}
+	if len(indices) > 1 && indices[1] != coinType {
+		return nil, fmt.Errorf("path '%s' must have coin type %d", path, coinType)
+	}
+	return indices, nil
+}
+
+// parsePathIndices parses the indices of a path without the EIP-2334 restrictions
+func parsePathIndices(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("path '%s' must start with 'm'", path)
+	}
+
+	indices := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		indx, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index '%s' in path '%s'", part, path)
+		}
+		indices = append(indices, uint32(indx))
+	}
+	return indices, nil
+}
+
+// DeriveSKFromPath derives the secret key of the path from a seed
+func DeriveSKFromPath(seed []byte, path string) (*SecretKey, error) {
+	indices, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := DeriveMasterSK(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, indx := range indices {
+		if sk, err = DeriveChildSK(sk, indx); err != nil {
+			return nil, err
+		}
+	}
+	return sk, nil
+}
+
+// NewKeyFromSeed derives the key of the path from a seed
+func NewKeyFromSeed(seed []byte, path string) (*Key, error) {
+	sk, err := DeriveSKFromPath(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	id, _ := uuid.GenerateUUID()
+
+	k := &Key{
+		Id:  id,
+		Prv: sk,
+		Pub: sk.GetPublicKey(),
+	}
+	return k, nil
+}
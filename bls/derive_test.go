@@ -0,0 +1,110 @@
+package bls
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerive_EIP2333(t *testing.T) {
+	// test vectors from https://eips.ethereum.org/EIPS/eip-2333#test-cases
+	cases := []struct {
+		seed       string
+		master     string
+		childIndex uint32
+		child      string
+	}{
+		{
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			"6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			0,
+			"20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			"3141592653589793238462643383279502884197169399375105820974944592",
+			"29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			3141592653,
+			"25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+		{
+			"0099FF991111002299DD7744EE3355BBDD8844115566CC55663355668888CC00",
+			"27580842291869792442942448775674722299803720648445448686099262467207037398656",
+			4294967295,
+			"29358610794459428860402234341874281240803786294062035874021252734817515685787",
+		},
+		{
+			"d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+			"19022158461524446591288038168518313374041767046816487870552872741050760015818",
+			42,
+			"31372231650479070279774297061823572166496564838472787488249775572789064611981",
+		},
+	}
+
+	toInt := func(sk *SecretKey) string {
+		buf, err := sk.Marshal()
+		require.NoError(t, err)
+		return new(big.Int).SetBytes(buf).String()
+	}
+
+	for _, c := range cases {
+		seed, err := hex.DecodeString(c.seed)
+		require.NoError(t, err)
+
+		master, err := DeriveMasterSK(seed)
+		require.NoError(t, err)
+		require.Equal(t, c.master, toInt(master))
+
+		child, err := DeriveChildSK(master, c.childIndex)
+		require.NoError(t, err)
+		require.Equal(t, c.child, toInt(child))
+	}
+}
+
+func TestDerive_ShortSeed(t *testing.T) {
+	_, err := DeriveMasterSK(make([]byte, 31))
+	require.Error(t, err)
+}
+
+func TestDerive_Path(t *testing.T) {
+	require.Equal(t, "m/12381/3600/5/0/0", SigningKeyPath(5))
+	require.Equal(t, "m/12381/3600/5/0", WithdrawalKeyPath(5))
+
+	indices, err := ParsePath(SigningKeyPath(5))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{12381, 3600, 5, 0, 0}, indices)
+
+	invalid := []string{
+		"",
+		"12381/3600/0/0/0",
+		"m/12381/3600/a/0",
+		"m/12381/3600/4294967296",
+		"m/12382/3600/0/0/0",
+		"m/12381/60/0/0/0",
+		"m/12381/3600//0",
+	}
+	for _, path := range invalid {
+		_, err := ParsePath(path)
+		require.Error(t, err, path)
+	}
+
+	// the key of the path is derived level by level
+	seed := make([]byte, 32)
+	key, err := NewKeyFromSeed(seed, WithdrawalKeyPath(1))
+	require.NoError(t, err)
+
+	sk, err := DeriveMasterSK(seed)
+	require.NoError(t, err)
+	for _, indx := range []uint32{12381, 3600, 1, 0} {
+		sk, err = DeriveChildSK(sk, indx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, toBytes(t, sk), toBytes(t, key.Prv))
+}
+
+func toBytes(t *testing.T, sk *SecretKey) []byte {
+	buf, err := sk.Marshal()
+	require.NoError(t, err)
+	return buf
+}
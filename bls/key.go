@@ -3,11 +3,10 @@ package bls
 import (
 	"bytes"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
+	"strings"
 
 	uuid "github.com/hashicorp/go-uuid"
-	"github.com/umbracle/ethgo/keystore"
 )
 
 // Key is a reference to a key in the keymanager
@@ -68,13 +67,13 @@ func NewRandomKey() *Key {
 	return k
 }
 
+// FromKeystore decrypts the key of an EIP-2335 keystore
 func FromKeystore(content []byte, password string) (*Key, error) {
-	var dec map[string]interface{}
-	if err := json.Unmarshal(content, &dec); err != nil {
+	ks, err := ParseKeystore(content)
+	if err != nil {
 		return nil, err
 	}
-
-	priv, err := keystore.DecryptV4(content, password)
+	priv, err := ks.Decrypt(password)
 	if err != nil {
 		return nil, err
 	}
@@ -83,39 +82,26 @@ func FromKeystore(content []byte, password string) (*Key, error) {
 		return nil, err
 	}
 
-	pub := key.PubKey()
-	if hex.EncodeToString(pub[:]) != dec["pubkey"] {
-		return nil, fmt.Errorf("pub key does not match")
+	if ks.Pubkey != "" {
+		pub := key.PubKey()
+		if hex.EncodeToString(pub[:]) != strings.ToLower(ks.Pubkey) {
+			return nil, fmt.Errorf("pub key does not match")
+		}
 	}
-	key.Id = dec["uuid"].(string)
+	key.Id = ks.UUID
 	return key, nil
 }
 
-func ToKeystore(k *Key, password string) ([]byte, error) {
-	priv, err := k.Prv.Marshal()
+// ToKeystore encrypts the key in an EIP-2335 keystore
+func ToKeystore(k *Key, password string, opts ...KeystoreOption) ([]byte, error) {
+	ks, err := EncryptKeystore(k, password, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return ks.Marshal()
+}
 
-	keystore, err := keystore.EncryptV4(priv, password)
-	if err != nil {
-		return nil, err
-	}
-
-	var dec map[string]interface{}
-	if err := json.Unmarshal(keystore, &dec); err != nil {
-		return nil, err
-	}
-
-	serializePub := k.Pub.Serialize()
-
-	dec["pubkey"] = hex.EncodeToString(serializePub[:])
-	dec["uuid"] = k.Id
-
-	// small error here, params is set to nil in ethgo
-	a := dec["crypto"].(map[string]interface{})
-	b := a["checksum"].(map[string]interface{})
-	b["params"] = map[string]interface{}{}
-
-	return json.Marshal(dec)
+// ToKeystoreWithPath encrypts the key in a keystore with the EIP-2334 path used to derive it
+func ToKeystoreWithPath(k *Key, password string, path string) ([]byte, error) {
+	return ToKeystore(k, password, WithKeystorePath(path))
 }
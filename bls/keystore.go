@@ -0,0 +1,416 @@
+package bls
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	uuid "github.com/hashicorp/go-uuid"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+// EIP-2335 (https://eips.ethereum.org/EIPS/eip-2335) keystores
+
+const (
+	keystoreVersion = 4
+
+	kdfScrypt      = "scrypt"
+	kdfPbkdf2      = "pbkdf2"
+	prfHmacSha256  = "hmac-sha256"
+	checksumSha256 = "sha256"
+	cipherAes128   = "aes-128-ctr"
+
+	// length of the decryption key, the first half is the aes key and
+	// the second half is used in the checksum
+	keystoreDklen = 32
+
+	// default kdf parameters as in the EIP-2335 test vectors
+	defaultScryptN = 1 << 18
+	defaultScryptR = 8
+	defaultScryptP = 1
+	defaultPbkdf2C = 1 << 18
+)
+
+// Keystore is an EIP-2335 keystore
+type Keystore struct {
+	Crypto      *KeystoreCrypto `json:"crypto"`
+	Description string          `json:"description"`
+	Pubkey      string          `json:"pubkey"`
+	Path        string          `json:"path"`
+	UUID        string          `json:"uuid"`
+	Version     int             `json:"version"`
+}
+
+// KeystoreCrypto are the modules to encrypt the secret of the keystore
+type KeystoreCrypto struct {
+	Kdf      *KeystoreKdf      `json:"kdf"`
+	Checksum *KeystoreChecksum `json:"checksum"`
+	Cipher   *KeystoreCipher   `json:"cipher"`
+}
+
+// KeystoreKdf is the key derivation function of the decryption key (scrypt or pbkdf2)
+type KeystoreKdf struct {
+	Function string             `json:"function"`
+	Params   *KeystoreKdfParams `json:"params"`
+	Message  string             `json:"message"`
+}
+
+// KeystoreKdfParams are the parameters of either the scrypt or the pbkdf2 kdf
+type KeystoreKdfParams struct {
+	Dklen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+
+	// scrypt parameters
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+
+	// pbkdf2 parameters
+	C   int    `json:"c,omitempty"`
+	Prf string `json:"prf,omitempty"`
+}
+
+// KeystoreChecksum is the checksum to verify the password
+type KeystoreChecksum struct {
+	Function string   `json:"function"`
+	Params   struct{} `json:"params"`
+	Message  string   `json:"message"`
+}
+
+// KeystoreCipher is the cipher of the secret
+type KeystoreCipher struct {
+	Function string                `json:"function"`
+	Params   *KeystoreCipherParams `json:"params"`
+	Message  string                `json:"message"`
+}
+
+// KeystoreCipherParams are the parameters of the aes-128-ctr cipher
+type KeystoreCipherParams struct {
+	IV string `json:"iv"`
+}
+
+// KeystoreConfig is the configuration to encrypt a keystore
+type KeystoreConfig struct {
+	kdf         string
+	scryptN     int
+	scryptR     int
+	scryptP     int
+	pbkdf2C     int
+	path        string
+	description string
+}
+
+type KeystoreOption func(*KeystoreConfig)
+
+// WithScrypt uses the scrypt kdf with the given parameters (the default)
+func WithScrypt(n, r, p int) KeystoreOption {
+	return func(c *KeystoreConfig) {
+		c.kdf = kdfScrypt
+		c.scryptN, c.scryptR, c.scryptP = n, r, p
+	}
+}
+
+// WithPbkdf2 uses the pbkdf2 kdf with the given number of iterations
+func WithPbkdf2(c int) KeystoreOption {
+	return func(cfg *KeystoreConfig) {
+		cfg.kdf = kdfPbkdf2
+		cfg.pbkdf2C = c
+	}
+}
+
+// WithKeystorePath sets the path used to derive the key
+func WithKeystorePath(path string) KeystoreOption {
+	return func(c *KeystoreConfig) {
+		c.path = path
+	}
+}
+
+// WithKeystoreDescription sets the description of the keystore
+func WithKeystoreDescription(description string) KeystoreOption {
+	return func(c *KeystoreConfig) {
+		c.description = description
+	}
+}
+
+// EncryptKeystore encrypts the key with the password
+func EncryptKeystore(k *Key, password string, opts ...KeystoreOption) (*Keystore, error) {
+	config := &KeystoreConfig{
+		kdf:     kdfScrypt,
+		scryptN: defaultScryptN,
+		scryptR: defaultScryptR,
+		scryptP: defaultScryptP,
+		pbkdf2C: defaultPbkdf2C,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	secret, err := k.Prv.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := randomBytes(32)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	kdf := &KeystoreKdf{
+		Function: config.kdf,
+		Params: &KeystoreKdfParams{
+			Dklen: keystoreDklen,
+			Salt:  hex.EncodeToString(salt),
+		},
+	}
+	switch config.kdf {
+	case kdfScrypt:
+		kdf.Params.N, kdf.Params.R, kdf.Params.P = config.scryptN, config.scryptR, config.scryptP
+	case kdfPbkdf2:
+		kdf.Params.C, kdf.Params.Prf = config.pbkdf2C, prfHmacSha256
+	}
+	if err := kdf.validate(); err != nil {
+		return nil, err
+	}
+	if config.path != "" {
+		if _, err := parsePathIndices(config.path); err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := kdf.decryptionKey(password)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(key[:16], iv, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	id := k.Id
+	if id == "" {
+		if id, err = uuid.GenerateUUID(); err != nil {
+			return nil, err
+		}
+	}
+	pub := k.Pub.Serialize()
+
+	ks := &Keystore{
+		Crypto: &KeystoreCrypto{
+			Kdf: kdf,
+			Checksum: &KeystoreChecksum{
+				Function: checksumSha256,
+				Message:  hex.EncodeToString(keystoreChecksum(key, cipherText)),
+			},
+			Cipher: &KeystoreCipher{
+				Function: cipherAes128,
+				Params: &KeystoreCipherParams{
+					IV: hex.EncodeToString(iv),
+				},
+				Message: hex.EncodeToString(cipherText),
+			},
+		},
+		Description: config.description,
+		Pubkey:      hex.EncodeToString(pub[:]),
+		Path:        config.path,
+		UUID:        id,
+		Version:     keystoreVersion,
+	}
+	return ks, nil
+}
+
+// ParseKeystore decodes and validates the format of a keystore
+func ParseKeystore(content []byte) (*Keystore, error) {
+	var ks Keystore
+	if err := json.Unmarshal(content, &ks); err != nil {
+		return nil, fmt.Errorf("failed to decode keystore: %v", err)
+	}
+	if err := ks.Validate(); err != nil {
+		return nil, err
+	}
+	return &ks, nil
+}
+
+// Validate checks the format of the keystore
+func (ks *Keystore) Validate() error {
+	if ks.Version != keystoreVersion {
+		return fmt.Errorf("keystore version %d not supported", ks.Version)
+	}
+	if _, err := uuid.ParseUUID(ks.UUID); err != nil {
+		return fmt.Errorf("invalid keystore uuid '%s': %v", ks.UUID, err)
+	}
+	if ks.Path != "" {
+		if _, err := parsePathIndices(ks.Path); err != nil {
+			return err
+		}
+	}
+	if ks.Pubkey != "" {
+		if _, err := decodeHexField("pubkey", ks.Pubkey, publicKeySize); err != nil {
+			return err
+		}
+	}
+	if ks.Crypto == nil || ks.Crypto.Kdf == nil || ks.Crypto.Checksum == nil || ks.Crypto.Cipher == nil {
+		return fmt.Errorf("keystore crypto modules not found")
+	}
+
+	if err := ks.Crypto.Kdf.validate(); err != nil {
+		return err
+	}
+
+	if ks.Crypto.Checksum.Function != checksumSha256 {
+		return fmt.Errorf("checksum '%s' not supported", ks.Crypto.Checksum.Function)
+	}
+	if _, err := decodeHexField("checksum", ks.Crypto.Checksum.Message, sha256.Size); err != nil {
+		return err
+	}
+
+	cipherModule := ks.Crypto.Cipher
+	if cipherModule.Function != cipherAes128 {
+		return fmt.Errorf("cipher '%s' not supported", cipherModule.Function)
+	}
+	if cipherModule.Params == nil {
+		return fmt.Errorf("cipher params not found")
+	}
+	if _, err := decodeHexField("cipher iv", cipherModule.Params.IV, aes.BlockSize); err != nil {
+		return err
+	}
+	if _, err := decodeHexField("cipher message", cipherModule.Message, -1); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validate checks the function and the parameters of the kdf
+func (kdf *KeystoreKdf) validate() error {
+	if kdf.Params == nil {
+		return fmt.Errorf("kdf params not found")
+	}
+	if kdf.Params.Dklen < keystoreDklen {
+		return fmt.Errorf("kdf dklen must be at least %d but found %d", keystoreDklen, kdf.Params.Dklen)
+	}
+	if _, err := decodeHexField("kdf salt", kdf.Params.Salt, -1); err != nil {
+		return err
+	}
+	switch kdf.Function {
+	case kdfScrypt:
+		if kdf.Params.N <= 1 || kdf.Params.N&(kdf.Params.N-1) != 0 {
+			return fmt.Errorf("scrypt n must be a power of two over 1 but found %d", kdf.Params.N)
+		}
+		if kdf.Params.R <= 0 || kdf.Params.P <= 0 {
+			return fmt.Errorf("scrypt r and p must be positive")
+		}
+	case kdfPbkdf2:
+		if kdf.Params.Prf != prfHmacSha256 {
+			return fmt.Errorf("pbkdf2 prf '%s' not supported", kdf.Params.Prf)
+		}
+		if kdf.Params.C <= 0 {
+			return fmt.Errorf("pbkdf2 c must be positive")
+		}
+	default:
+		return fmt.Errorf("kdf '%s' not supported", kdf.Function)
+	}
+	return nil
+}
+
+// Decrypt decrypts the secret of the keystore with the password
+func (ks *Keystore) Decrypt(password string) ([]byte, error) {
+	if err := ks.Validate(); err != nil {
+		return nil, err
+	}
+
+	key, err := ks.Crypto.Kdf.decryptionKey(password)
+	if err != nil {
+		return nil, err
+	}
+
+	// the fields are already validated
+	cipherText, _ := hex.DecodeString(ks.Crypto.Cipher.Message)
+	checksum, _ := hex.DecodeString(ks.Crypto.Checksum.Message)
+	iv, _ := hex.DecodeString(ks.Crypto.Cipher.Params.IV)
+
+	if !bytes.Equal(checksum, keystoreChecksum(key, cipherText)) {
+		return nil, fmt.Errorf("invalid keystore password")
+	}
+	return aes128CTR(key[:16], iv, cipherText)
+}
+
+// Marshal encodes the keystore in json
+func (ks *Keystore) Marshal() ([]byte, error) {
+	return json.Marshal(ks)
+}
+
+func (k *KeystoreKdf) decryptionKey(password string) ([]byte, error) {
+	salt, err := hex.DecodeString(k.Params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	passwordBytes := []byte(NormalizeKeystorePassword(password))
+
+	switch k.Function {
+	case kdfScrypt:
+		return scrypt.Key(passwordBytes, salt, k.Params.N, k.Params.R, k.Params.P, k.Params.Dklen)
+	case kdfPbkdf2:
+		return pbkdf2.Key(passwordBytes, salt, k.Params.C, k.Params.Dklen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("kdf '%s' not supported", k.Function)
+	}
+}
+
+// NormalizeKeystorePassword applies the NFKD normalization to the password and removes
+// the control codes (C0, C1 and Delete) as in the EIP-2335 password requirements
+func NormalizeKeystorePassword(password string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= 0x1F || (r >= 0x7F && r <= 0x9F) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password))
+}
+
+func keystoreChecksum(key, cipherText []byte) []byte {
+	hash := sha256.New()
+	hash.Write(key[16:32])
+	hash.Write(cipherText)
+	return hash.Sum(nil)
+}
+
+func aes128CTR(key, iv, input []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(output, input)
+	return output, nil
+}
+
+// decodeHexField decodes a hex field of the keystore of the given size (-1 for any size)
+func decodeHexField(name, str string, size int) ([]byte, error) {
+	buf, err := hex.DecodeString(str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore %s: %v", name, err)
+	}
+	if size >= 0 && len(buf) != size {
+		return nil, fmt.Errorf("keystore %s must be %d bytes but found %d", name, size, len(buf))
+	}
+	return buf, nil
+}
+
+func randomBytes(size int) ([]byte, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
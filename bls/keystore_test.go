@@ -0,0 +1,165 @@
+package bls
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystore_EIP2335(t *testing.T) {
+	// test vectors from https://eips.ethereum.org/EIPS/eip-2335#test-cases
+	content, err := ioutil.ReadFile("./fixtures/keystore.json")
+	require.NoError(t, err)
+
+	var data []json.RawMessage
+	require.NoError(t, json.Unmarshal(content, &data))
+
+	password := "𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑"
+	secret := "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	paths := []string{"m/12381/60/3141592653/589793238", "m/12381/60/0/0"}
+	kdfs := []string{"scrypt", "pbkdf2"}
+
+	for indx, raw := range data {
+		ks, err := ParseKeystore(raw)
+		require.NoError(t, err)
+		require.Equal(t, paths[indx], ks.Path)
+		require.Equal(t, kdfs[indx], ks.Crypto.Kdf.Function)
+
+		priv, err := ks.Decrypt(password)
+		require.NoError(t, err)
+		require.Equal(t, secret, hex.EncodeToString(priv))
+
+		_, err = ks.Decrypt("testpassword")
+		require.Error(t, err)
+
+		key, err := FromKeystore(raw, password)
+		require.NoError(t, err)
+		require.Equal(t, ks.UUID, key.Id)
+	}
+}
+
+func TestKeystore_Encrypt(t *testing.T) {
+	key := NewRandomKey()
+	password := "pass\x7fword"
+
+	opts := [][]KeystoreOption{
+		{WithScrypt(1<<4, 8, 1)},
+		{WithPbkdf2(2), WithKeystorePath(SigningKeyPath(1)), WithKeystoreDescription("a")},
+	}
+	for _, opt := range opts {
+		content, err := ToKeystore(key, password, opt...)
+		require.NoError(t, err)
+
+		ks, err := ParseKeystore(content)
+		require.NoError(t, err)
+		require.Equal(t, key.Id, ks.UUID)
+
+		// the control codes are removed from the password
+		key1, err := FromKeystore(content, "password")
+		require.NoError(t, err)
+		require.True(t, key.Equal(key1))
+		require.Equal(t, key.Id, key1.Id)
+	}
+
+	ks, err := EncryptKeystore(key, password, WithPbkdf2(2), WithKeystorePath(SigningKeyPath(1)), WithKeystoreDescription("a"))
+	require.NoError(t, err)
+	require.Equal(t, "pbkdf2", ks.Crypto.Kdf.Function)
+	require.Equal(t, "hmac-sha256", ks.Crypto.Kdf.Params.Prf)
+	require.Equal(t, SigningKeyPath(1), ks.Path)
+	require.Equal(t, "a", ks.Description)
+
+	_, err = EncryptKeystore(key, password, WithKeystorePath("a/b"))
+	require.Error(t, err)
+
+	// invalid kdf parameters
+	invalid := []KeystoreOption{
+		WithPbkdf2(0),
+		WithPbkdf2(-1),
+		WithScrypt(3, 8, 1),
+		WithScrypt(1<<4, 0, 1),
+		WithScrypt(1<<4, 8, 0),
+	}
+	for _, opt := range invalid {
+		_, err = EncryptKeystore(key, password, opt)
+		require.Error(t, err)
+	}
+}
+
+func TestKeystore_Invalid(t *testing.T) {
+	key := NewRandomKey()
+	ks, err := EncryptKeystore(key, "password", WithScrypt(1<<4, 8, 1))
+	require.NoError(t, err)
+
+	valid, err := ks.Marshal()
+	require.NoError(t, err)
+
+	// returns a copy of the valid keystore with the modified field
+	modify := func(f func(obj map[string]interface{})) []byte {
+		var obj map[string]interface{}
+		require.NoError(t, json.Unmarshal(valid, &obj))
+		f(obj)
+		buf, err := json.Marshal(obj)
+		require.NoError(t, err)
+		return buf
+	}
+	crypto := func(obj map[string]interface{}, module string) map[string]interface{} {
+		return obj["crypto"].(map[string]interface{})[module].(map[string]interface{})
+	}
+
+	cases := [][]byte{
+		[]byte(""),
+		[]byte("{}"),
+		[]byte("[]"),
+		modify(func(obj map[string]interface{}) { obj["uuid"] = 1 }),
+		modify(func(obj map[string]interface{}) { obj["uuid"] = "a" }),
+		modify(func(obj map[string]interface{}) { obj["version"] = 3 }),
+		modify(func(obj map[string]interface{}) { obj["path"] = "m/a" }),
+		modify(func(obj map[string]interface{}) { obj["pubkey"] = "00" }),
+		modify(func(obj map[string]interface{}) { delete(obj, "crypto") }),
+		modify(func(obj map[string]interface{}) { delete(crypto(obj, "kdf"), "params") }),
+		modify(func(obj map[string]interface{}) { crypto(obj, "kdf")["function"] = "argon2" }),
+		modify(func(obj map[string]interface{}) { crypto(obj, "kdf")["params"] = "a" }),
+		modify(func(obj map[string]interface{}) {
+			crypto(obj, "kdf")["params"].(map[string]interface{})["n"] = 3
+		}),
+		modify(func(obj map[string]interface{}) {
+			crypto(obj, "kdf")["params"].(map[string]interface{})["dklen"] = 16
+		}),
+		modify(func(obj map[string]interface{}) { crypto(obj, "checksum")["function"] = "keccak" }),
+		modify(func(obj map[string]interface{}) { crypto(obj, "checksum")["message"] = "zz" }),
+		modify(func(obj map[string]interface{}) { crypto(obj, "cipher")["function"] = "aes-256-gcm" }),
+		modify(func(obj map[string]interface{}) { delete(crypto(obj, "cipher"), "params") }),
+		modify(func(obj map[string]interface{}) {
+			crypto(obj, "cipher")["params"].(map[string]interface{})["iv"] = "00"
+		}),
+	}
+	for _, c := range cases {
+		_, err := FromKeystore(c, "password")
+		require.Error(t, err, string(c))
+	}
+
+	// the public key does not match the secret
+	other := NewRandomKey().PubKey()
+	content := modify(func(obj map[string]interface{}) { obj["pubkey"] = hex.EncodeToString(other[:]) })
+	_, err = FromKeystore(content, "password")
+	require.Error(t, err)
+}
+
+func TestKeystore_NormalizePassword(t *testing.T) {
+	cases := []struct {
+		password   string
+		normalized string
+	}{
+		{"𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑", "testpassword🔑"},
+		{"a\x00b\x1fc\x7fd\u0080e\u009ff", "abcdef"},
+		{"a b", "a b"},
+		{"\u00e9", "e\u0301"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.normalized, NormalizeKeystorePassword(c.password))
+	}
+}
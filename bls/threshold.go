@@ -0,0 +1,151 @@
+package bls
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+// Threshold signatures with Shamir secret sharing of the secret key. The secret key is
+// the constant term of a random polynomial f of degree threshold-1 and the share with
+// index i is f(i). Any threshold shares recover f(0) with Lagrange interpolation which,
+// since the signatures and public keys are linear in the secret key, also recovers the
+// signature and the public key of the group from the partial ones.
+
+// KeyShare is a share of a secret key split with SplitSecretKey
+type KeyShare struct {
+	// Index is the (non zero) point at which the polynomial is evaluated
+	Index uint64
+
+	// Prv is the secret key of the share
+	Prv *SecretKey
+
+	// Pub is the public key of the share
+	Pub *PublicKey
+}
+
+// Sign signs the message with the secret key of the share
+func (k *KeyShare) Sign(msg []byte) (*Signature, error) {
+	return k.Prv.Sign(msg)
+}
+
+// SplitSecretKey splits the secret key in total shares with indices 1 to total such that
+// any threshold of them can recover the signatures and the public key of the secret key
+func SplitSecretKey(sk *SecretKey, threshold, total uint64) ([]*KeyShare, error) {
+	if threshold == 0 {
+		return nil, fmt.Errorf("threshold must be at least 1")
+	}
+	if threshold > total {
+		return nil, fmt.Errorf("threshold %d is over the number of shares %d", threshold, total)
+	}
+
+	secret, err := sk.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	// f(x) = secret + a_1 * x + ... + a_{threshold-1} * x^(threshold-1)
+	coefficients := make([]*big.Int, threshold)
+	coefficients[0] = new(big.Int).SetBytes(secret)
+	for i := uint64(1); i < threshold; i++ {
+		if coefficients[i], err = rand.Int(rand.Reader, curveOrder); err != nil {
+			return nil, err
+		}
+	}
+
+	shares := make([]*KeyShare, 0, total)
+	for indx := uint64(1); indx <= total; indx++ {
+		x := new(big.Int).SetUint64(indx)
+
+		// evaluate the polynomial with the Horner's method
+		y := new(big.Int)
+		for i := len(coefficients) - 1; i >= 0; i-- {
+			y.Mul(y, x)
+			y.Add(y, coefficients[i])
+			y.Mod(y, curveOrder)
+		}
+
+		buf := make([]byte, secretKeySize)
+		y.FillBytes(buf)
+
+		prv := new(SecretKey)
+		if err := prv.Unmarshal(buf); err != nil {
+			// only if the share is zero (negligible probability)
+			return nil, fmt.Errorf("failed to create share %d: %v", indx, err)
+		}
+		shares = append(shares, &KeyShare{
+			Index: indx,
+			Prv:   prv,
+			Pub:   prv.GetPublicKey(),
+		})
+	}
+	return shares, nil
+}
+
+// RecoverSignature recovers the signature of the group from the partial signatures of
+// at least threshold shares. The signature at index i is signed by the share with indices[i].
+func RecoverSignature(indices []uint64, sigs []*Signature) (*Signature, error) {
+	if len(indices) != len(sigs) {
+		return nil, fmt.Errorf("expected the same number of indices and signatures")
+	}
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	return signatureLinearCombination(sigs, coefficients), nil
+}
+
+// RecoverPublicKey recovers the public key of the group from the public keys of at
+// least threshold shares. The public key at index i belongs to the share with indices[i].
+func RecoverPublicKey(indices []uint64, pubs []*PublicKey) (*PublicKey, error) {
+	if len(indices) != len(pubs) {
+		return nil, fmt.Errorf("expected the same number of indices and public keys")
+	}
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	return publicKeyLinearCombination(pubs, coefficients), nil
+}
+
+// lagrangeCoefficients returns the coefficients to interpolate at x = 0 the polynomial
+// evaluated at the indices: l_i = prod(x_j / (x_j - x_i)) for j != i
+func lagrangeCoefficients(indices []uint64) ([]*big.Int, error) {
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("no shares to recover")
+	}
+
+	seen := map[uint64]struct{}{}
+	for _, indx := range indices {
+		if indx == 0 {
+			return nil, fmt.Errorf("share index cannot be zero")
+		}
+		if _, ok := seen[indx]; ok {
+			return nil, fmt.Errorf("duplicated share index %d", indx)
+		}
+		seen[indx] = struct{}{}
+	}
+
+	coefficients := make([]*big.Int, len(indices))
+	for i, xi := range indices {
+		num := big.NewInt(1)
+		den := big.NewInt(1)
+
+		for j, xj := range indices {
+			if i == j {
+				continue
+			}
+			num.Mul(num, new(big.Int).SetUint64(xj))
+			num.Mod(num, curveOrder)
+
+			diff := new(big.Int).Sub(new(big.Int).SetUint64(xj), new(big.Int).SetUint64(xi))
+			den.Mul(den, diff)
+			den.Mod(den, curveOrder)
+		}
+
+		// the indices are distinct and lower than r, then den is invertible
+		den.ModInverse(den, curveOrder)
+		coefficients[i] = num.Mul(num, den).Mod(num, curveOrder)
+	}
+	return coefficients, nil
+}
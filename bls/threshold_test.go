@@ -0,0 +1,99 @@
+package bls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreshold_SplitAndRecover(t *testing.T) {
+	key := NewRandomKey()
+	msg := []byte("msg")
+
+	shares, err := SplitSecretKey(key.Prv, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	sigs := make([]*Signature, len(shares))
+	for indx, share := range shares {
+		require.Equal(t, uint64(indx+1), share.Index)
+
+		sigs[indx], err = share.Sign(msg)
+		require.NoError(t, err)
+
+		// the partial signature is valid for the share public key
+		ok, err := sigs[indx].VerifyByte(share.Pub, msg)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	expectedSig, err := key.Prv.Sign(msg)
+	require.NoError(t, err)
+
+	// any subset of threshold or more shares recovers the group signature and public key
+	subsets := [][]int{
+		{0, 1, 2},
+		{4, 2, 0},
+		{1, 3, 4},
+		{0, 1, 2, 3, 4},
+	}
+	for _, subset := range subsets {
+		indices := []uint64{}
+		partialSigs := []*Signature{}
+		pubs := []*PublicKey{}
+		for _, i := range subset {
+			indices = append(indices, shares[i].Index)
+			partialSigs = append(partialSigs, sigs[i])
+			pubs = append(pubs, shares[i].Pub)
+		}
+
+		sig, err := RecoverSignature(indices, partialSigs)
+		require.NoError(t, err)
+		require.Equal(t, expectedSig.Serialize(), sig.Serialize())
+
+		pub, err := RecoverPublicKey(indices, pubs)
+		require.NoError(t, err)
+		require.Equal(t, key.Pub.Serialize(), pub.Serialize())
+	}
+
+	// less than threshold shares do not recover the signature
+	sig, err := RecoverSignature([]uint64{1, 2}, sigs[:2])
+	require.NoError(t, err)
+	require.NotEqual(t, expectedSig.Serialize(), sig.Serialize())
+}
+
+func TestThreshold_OneOfOne(t *testing.T) {
+	key := NewRandomKey()
+
+	shares, err := SplitSecretKey(key.Prv, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, key.Pub.Serialize(), shares[0].Pub.Serialize())
+}
+
+func TestThreshold_Errors(t *testing.T) {
+	key := NewRandomKey()
+
+	_, err := SplitSecretKey(key.Prv, 0, 3)
+	require.Error(t, err)
+
+	_, err = SplitSecretKey(key.Prv, 4, 3)
+	require.Error(t, err)
+
+	shares, err := SplitSecretKey(key.Prv, 2, 3)
+	require.NoError(t, err)
+	pubs := []*PublicKey{shares[0].Pub, shares[1].Pub}
+
+	// mismatched lengths
+	_, err = RecoverPublicKey([]uint64{1}, pubs)
+	require.Error(t, err)
+
+	invalid := [][]uint64{
+		{},
+		{1, 1},
+		{0, 1},
+	}
+	for _, indices := range invalid {
+		_, err := RecoverPublicKey(indices, pubs[:len(indices)])
+		require.Error(t, err)
+	}
+}
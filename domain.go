@@ -37,14 +37,7 @@ func ToBytes32(b []byte) (res [32]byte) {
 }
 
 func ComputeDomain(domain Domain, forkVersion [4]byte, genesisValidatorsRoot Root) ([32]byte, error) {
-	// compute_fork_data_root
-	// this returns the 32byte fork data root for the ``current_version`` and ``genesis_validators_root``.
-	// This is used primarily in signature domains to avoid collisions across forks/chains.
-	forkData := ForkData{
-		CurrentVersion:        forkVersion,
-		GenesisValidatorsRoot: genesisValidatorsRoot,
-	}
-	forkRoot, err := forkData.HashTreeRoot()
+	forkRoot, err := ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
 	if err != nil {
 		return [32]byte{}, err
 	}
@@ -73,7 +66,7 @@ type DomainType Domain
 var (
 	DomainBeaconProposerType          = Domain{0, 0, 0, 0}
 	DomainBeaconAttesterType          = Domain{1, 0, 0, 0}
-	DomainRandaomType                 = Domain{2, 0, 0, 0}
+	DomainRandaoType                  = Domain{2, 0, 0, 0}
 	DomainDepositType                 = Domain{3, 0, 0, 0}
 	DomainVoluntaryExitType           = Domain{4, 0, 0, 0}
 	DomainSelectionProofType          = Domain{5, 0, 0, 0}
@@ -81,4 +74,9 @@ var (
 	DomainSyncCommitteeType           = Domain{7, 0, 0, 0}
 	DomainSyncCommitteeSelectionProof = Domain{8, 0, 0, 0}
 	DomainContributionAndProof        = Domain{9, 0, 0, 0}
+	DomainBLSToExecutionChange        = Domain{10, 0, 0, 0}
+	DomainApplicationBuilder          = Domain{0, 0, 0, 1}
+
+	// Deprecated: use DomainRandaoType
+	DomainRandaomType = DomainRandaoType
 )
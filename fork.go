@@ -0,0 +1,99 @@
+package consensus
+
+// FarFutureEpoch is the activation epoch of the forks that are not scheduled
+const FarFutureEpoch = 18446744073709551615 // 2**64-1
+
+// ForkVersionAtEpoch returns the version of the fork active at the given epoch
+func (s *Spec) ForkVersionAtEpoch(epoch uint64) Domain {
+	version := s.GenesisForkVersion
+	for _, fork := range s.forkSchedule() {
+		if epoch >= fork.epoch {
+			version = fork.version
+		}
+	}
+	return version
+}
+
+// ForkAtEpoch returns the fork active at the given epoch. The previous version is
+// the version active before the fork or the current version for the genesis fork.
+func (s *Spec) ForkAtEpoch(epoch uint64) *Fork {
+	current := s.forkSchedule()[0]
+	for _, fork := range s.forkSchedule() {
+		if epoch >= fork.epoch {
+			current = fork
+		}
+	}
+
+	previous := current.version
+	if current.epoch > 0 {
+		previous = s.ForkVersionAtEpoch(current.epoch - 1)
+	}
+	return &Fork{
+		PreviousVersion: previous,
+		CurrentVersion:  current.version,
+		Epoch:           current.epoch,
+	}
+}
+
+// NextForkEpoch returns the activation epoch of the first fork scheduled after
+// the given epoch or FarFutureEpoch if there is no fork scheduled.
+func (s *Spec) NextForkEpoch(epoch uint64) uint64 {
+	_, nextEpoch := s.nextFork(epoch)
+	return nextEpoch
+}
+
+// nextFork returns the version and activation epoch of the first fork scheduled after the
+// given epoch. If there is no fork scheduled, it returns the current version and FarFutureEpoch.
+func (s *Spec) nextFork(epoch uint64) (Domain, uint64) {
+	for _, fork := range s.forkSchedule() {
+		if fork.epoch > epoch && fork.epoch != FarFutureEpoch {
+			return fork.version, fork.epoch
+		}
+	}
+	return s.ForkVersionAtEpoch(epoch), FarFutureEpoch
+}
+
+// ComputeForkDataRoot returns the root of the fork data for the fork version and the
+// genesis validators root. It is used in the signature domains and in the fork digest
+// to avoid collisions across forks and chains.
+func ComputeForkDataRoot(forkVersion Domain, genesisValidatorsRoot Root) ([32]byte, error) {
+	forkData := ForkData{
+		CurrentVersion:        forkVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	return forkData.HashTreeRoot()
+}
+
+// ComputeForkDigest returns the 4 bytes digest of the fork version and the genesis
+// validators root used in the gossip topics and in the peer discovery.
+func ComputeForkDigest(forkVersion Domain, genesisValidatorsRoot Root) ([4]byte, error) {
+	root, err := ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return [4]byte{}, err
+	}
+
+	var digest [4]byte
+	copy(digest[:], root[:4])
+	return digest, nil
+}
+
+// ForkDigestAtEpoch returns the fork digest of the fork active at the given epoch
+func (s *Spec) ForkDigestAtEpoch(epoch uint64, genesisValidatorsRoot Root) ([4]byte, error) {
+	return ComputeForkDigest(s.ForkVersionAtEpoch(epoch), genesisValidatorsRoot)
+}
+
+// ENRForkID returns the fork id advertised in the 'eth2' field of the ENR at the given epoch
+func (s *Spec) ENRForkID(epoch uint64, genesisValidatorsRoot Root) (*ENRForkID, error) {
+	digest, err := s.ForkDigestAtEpoch(epoch, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	nextVersion, nextEpoch := s.nextFork(epoch)
+
+	forkID := &ENRForkID{
+		ForkDigest:      digest,
+		NextForkVersion: nextVersion,
+		NextForkEpoch:   nextEpoch,
+	}
+	return forkID, nil
+}
@@ -0,0 +1,92 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testForkSpec() *Spec {
+	return &Spec{
+		GenesisForkVersion:   Domain{0x00, 0x00, 0x00, 0x00},
+		AltairForkVersion:    Domain{0x01, 0x00, 0x00, 0x00},
+		AltairForkEpoch:      74240,
+		BellatrixForkVersion: Domain{0x02, 0x00, 0x00, 0x00},
+		BellatrixForkEpoch:   144896,
+		CapellaForkVersion:   Domain{0x03, 0x00, 0x00, 0x00},
+		CapellaForkEpoch:     194048,
+		DenebForkVersion:     Domain{0x04, 0x00, 0x00, 0x00},
+		DenebForkEpoch:       269568,
+		ElectraForkVersion:   Domain{0x05, 0x00, 0x00, 0x00},
+		ElectraForkEpoch:     FarFutureEpoch,
+	}
+}
+
+func TestFork_AtEpoch(t *testing.T) {
+	spec := testForkSpec()
+
+	assert.Equal(t, Domain{0x00, 0x00, 0x00, 0x00}, spec.ForkVersionAtEpoch(0))
+	assert.Equal(t, Domain{0x00, 0x00, 0x00, 0x00}, spec.ForkVersionAtEpoch(74239))
+	assert.Equal(t, Domain{0x01, 0x00, 0x00, 0x00}, spec.ForkVersionAtEpoch(74240))
+	assert.Equal(t, Domain{0x04, 0x00, 0x00, 0x00}, spec.ForkVersionAtEpoch(300000))
+
+	assert.Equal(t, &Fork{Epoch: 0}, spec.ForkAtEpoch(10))
+	assert.Equal(t, &Fork{
+		PreviousVersion: [4]byte{0x02, 0x00, 0x00, 0x00},
+		CurrentVersion:  [4]byte{0x03, 0x00, 0x00, 0x00},
+		Epoch:           194048,
+	}, spec.ForkAtEpoch(200000))
+
+	assert.Equal(t, uint64(74240), spec.NextForkEpoch(0))
+	assert.Equal(t, uint64(269568), spec.NextForkEpoch(194048))
+	// electra is not scheduled
+	assert.Equal(t, uint64(FarFutureEpoch), spec.NextForkEpoch(269568))
+
+	// several forks at genesis
+	spec.AltairForkEpoch = 0
+	spec.BellatrixForkEpoch = 0
+	assert.Equal(t, &Fork{
+		PreviousVersion: [4]byte{0x02, 0x00, 0x00, 0x00},
+		CurrentVersion:  [4]byte{0x02, 0x00, 0x00, 0x00},
+		Epoch:           0,
+	}, spec.ForkAtEpoch(0))
+}
+
+func TestFork_Digest(t *testing.T) {
+	spec := testForkSpec()
+
+	// mainnet genesis validators root
+	var genesisValidatorsRoot Root
+	buf, _ := hex.DecodeString("4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95")
+	copy(genesisValidatorsRoot[:], buf)
+
+	cases := []struct {
+		epoch  uint64
+		digest string
+	}{
+		{0, "b5303f2a"},
+		{74240, "afcaaba0"},
+		{144896, "4a26c58b"},
+		{194048, "bba4da96"},
+		{269568, "6a95a1a9"},
+	}
+	for _, c := range cases {
+		digest, err := spec.ForkDigestAtEpoch(c.epoch, genesisValidatorsRoot)
+		require.NoError(t, err)
+		assert.Equal(t, c.digest, hex.EncodeToString(digest[:]))
+	}
+
+	forkID, err := spec.ENRForkID(200000, genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Equal(t, "bba4da96", hex.EncodeToString(forkID.ForkDigest[:]))
+	assert.Equal(t, [4]byte{0x04, 0x00, 0x00, 0x00}, forkID.NextForkVersion)
+	assert.Equal(t, uint64(269568), forkID.NextForkEpoch)
+
+	// no fork scheduled after deneb
+	forkID, err = spec.ENRForkID(269568, genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Equal(t, [4]byte{0x04, 0x00, 0x00, 0x00}, forkID.NextForkVersion)
+	assert.Equal(t, uint64(FarFutureEpoch), forkID.NextForkEpoch)
+}
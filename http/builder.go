@@ -30,16 +30,212 @@ type SignedBuilderBid struct {
 	Signature [96]byte    `json:"signature" ssz-size:"96"`
 }
 
-func (b *BuilderEndpoint) GetExecutionPayload(slot uint64, parentHash [32]byte, pubKey [48]byte) (*SignedBuilderBid, error) {
-	var out *SignedBuilderBid
-	err := b.c.Get(fmt.Sprintf("/eth/v1/builder/header/%d/0x%x/0x%x", slot, parentHash[:], pubKey[:]), &out)
-	return out, err
+type BuilderBidCapella struct {
+	Header *consensus.ExecutionPayloadHeaderCapella `json:"header"`
+	Value  consensus.Uint256                        `json:"value" ssz-size:"32"`
+	Pubkey [48]byte                                 `json:"pubkey" ssz-size:"48"`
 }
 
-func (b *BuilderEndpoint) SubmitBlindedBlock(msg *consensus.SignedBlindedBeaconBlock) (*consensus.ExecutionPayload, error) {
-	var out *consensus.ExecutionPayload
-	err := b.c.Post("/eth/v1/builder/blinded_blocks", msg, &out)
-	return out, err
+type SignedBuilderBidCapella struct {
+	Message   *BuilderBidCapella `json:"message"`
+	Signature [96]byte           `json:"signature" ssz-size:"96"`
+}
+
+type BuilderBidDeneb struct {
+	Header             *consensus.ExecutionPayloadHeaderDeneb `json:"header"`
+	BlobKzgCommitments [][48]byte                             `json:"blob_kzg_commitments" ssz-max:"4096" ssz-size:"?,48"`
+	Value              consensus.Uint256                      `json:"value" ssz-size:"32"`
+	Pubkey             [48]byte                               `json:"pubkey" ssz-size:"48"`
+}
+
+type SignedBuilderBidDeneb struct {
+	Message   *BuilderBidDeneb `json:"message"`
+	Signature [96]byte         `json:"signature" ssz-size:"96"`
+}
+
+type BuilderBidElectra struct {
+	Header             *consensus.ExecutionPayloadHeaderDeneb `json:"header"`
+	BlobKzgCommitments [][48]byte                             `json:"blob_kzg_commitments" ssz-max:"4096" ssz-size:"?,48"`
+	ExecutionRequests  *consensus.ExecutionRequests           `json:"execution_requests"`
+	Value              consensus.Uint256                      `json:"value" ssz-size:"32"`
+	Pubkey             [48]byte                               `json:"pubkey" ssz-size:"48"`
+}
+
+type SignedBuilderBidElectra struct {
+	Message   *BuilderBidElectra `json:"message"`
+	Signature [96]byte           `json:"signature" ssz-size:"96"`
+}
+
+// VersionedSignedBuilderBid is a signed builder bid of any fork.
+// Only the field of the given version is set.
+type VersionedSignedBuilderBid struct {
+	Version   consensus.ForkName
+	Bellatrix *SignedBuilderBid
+	Capella   *SignedBuilderBidCapella
+	Deneb     *SignedBuilderBidDeneb
+	Electra   *SignedBuilderBidElectra
+}
+
+// Header returns the execution payload header of the bid
+func (v *VersionedSignedBuilderBid) Header() (consensus.PayloadHeader, error) {
+	switch {
+	case v.Bellatrix != nil && v.Bellatrix.Message != nil:
+		return v.Bellatrix.Message.Header, nil
+	case v.Capella != nil && v.Capella.Message != nil:
+		return v.Capella.Message.Header, nil
+	case v.Deneb != nil && v.Deneb.Message != nil:
+		return v.Deneb.Message.Header, nil
+	case v.Electra != nil && v.Electra.Message != nil:
+		return v.Electra.Message.Header, nil
+	default:
+		return nil, fmt.Errorf("bid for version '%s' not found", v.Version)
+	}
+}
+
+// Value returns the value of the bid in wei
+func (v *VersionedSignedBuilderBid) Value() (consensus.Uint256, error) {
+	switch {
+	case v.Bellatrix != nil && v.Bellatrix.Message != nil:
+		return v.Bellatrix.Message.Value, nil
+	case v.Capella != nil && v.Capella.Message != nil:
+		return v.Capella.Message.Value, nil
+	case v.Deneb != nil && v.Deneb.Message != nil:
+		return v.Deneb.Message.Value, nil
+	case v.Electra != nil && v.Electra.Message != nil:
+		return v.Electra.Message.Value, nil
+	default:
+		return consensus.Uint256{}, fmt.Errorf("bid for version '%s' not found", v.Version)
+	}
+}
+
+// Pubkey returns the public key of the builder
+func (v *VersionedSignedBuilderBid) Pubkey() ([48]byte, error) {
+	switch {
+	case v.Bellatrix != nil && v.Bellatrix.Message != nil:
+		return v.Bellatrix.Message.Pubkey, nil
+	case v.Capella != nil && v.Capella.Message != nil:
+		return v.Capella.Message.Pubkey, nil
+	case v.Deneb != nil && v.Deneb.Message != nil:
+		return v.Deneb.Message.Pubkey, nil
+	case v.Electra != nil && v.Electra.Message != nil:
+		return v.Electra.Message.Pubkey, nil
+	default:
+		return [48]byte{}, fmt.Errorf("bid for version '%s' not found", v.Version)
+	}
+}
+
+// VersionedExecutionPayload is the execution payload revealed by the builder
+// for a blinded block. After Deneb it includes the blobs bundle.
+// Only the field of the given version is set.
+type VersionedExecutionPayload struct {
+	Version   consensus.ForkName
+	Bellatrix *consensus.ExecutionPayload
+	Capella   *consensus.ExecutionPayloadCapella
+	Deneb     *consensus.ExecutionPayloadAndBlobsBundle
+	Electra   *consensus.ExecutionPayloadAndBlobsBundle
+}
+
+// Payload returns the execution payload
+func (v *VersionedExecutionPayload) Payload() (consensus.Payload, error) {
+	switch {
+	case v.Bellatrix != nil:
+		return v.Bellatrix, nil
+	case v.Capella != nil:
+		return v.Capella, nil
+	case v.Deneb != nil && v.Deneb.ExecutionPayload != nil:
+		return v.Deneb.ExecutionPayload, nil
+	case v.Electra != nil && v.Electra.ExecutionPayload != nil:
+		return v.Electra.ExecutionPayload, nil
+	default:
+		return nil, fmt.Errorf("payload for version '%s' not found", v.Version)
+	}
+}
+
+// BlobsBundle returns the blobs bundle of the payload (only after Deneb)
+func (v *VersionedExecutionPayload) BlobsBundle() (*consensus.BlobsBundle, error) {
+	switch {
+	case v.Deneb != nil:
+		return v.Deneb.BlobsBundle, nil
+	case v.Electra != nil:
+		return v.Electra.BlobsBundle, nil
+	default:
+		return nil, fmt.Errorf("blobs bundle not found for version '%s'", v.Version)
+	}
+}
+
+// ErrorNoBid is returned by GetExecutionPayload if the builder does not have a bid (204)
+var ErrorNoBid = fmt.Errorf("no builder bid (204)")
+
+// GetExecutionPayload returns the builder bid for the given slot. The type of the bid
+// depends on the fork of the response. It returns ErrorNoBid if there is no bid.
+func (b *BuilderEndpoint) GetExecutionPayload(slot uint64, parentHash [32]byte, pubKey [48]byte) (*VersionedSignedBuilderBid, error) {
+	resp, err := b.c.getVersioned(fmt.Sprintf("/eth/v1/builder/header/%d/0x%x/0x%x", slot, parentHash[:], pubKey[:]))
+	if err == errNoContent {
+		return nil, ErrorNoBid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out := &VersionedSignedBuilderBid{Version: consensus.ForkName(resp.Version)}
+	switch out.Version {
+	case consensus.ForkBellatrix:
+		err = Unmarshal(resp.Data, &out.Bellatrix, b.c.config.untrackedKeys)
+	case consensus.ForkCapella:
+		err = Unmarshal(resp.Data, &out.Capella, b.c.config.untrackedKeys)
+	case consensus.ForkDeneb:
+		err = Unmarshal(resp.Data, &out.Deneb, b.c.config.untrackedKeys)
+	case consensus.ForkElectra:
+		err = Unmarshal(resp.Data, &out.Electra, b.c.config.untrackedKeys)
+	default:
+		return nil, fmt.Errorf("unsupported builder bid version '%s'", resp.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubmitBlindedBlock submits the signed blinded block to the builder and returns the
+// revealed execution payload. The type of the payload depends on the fork of the block.
+func (b *BuilderEndpoint) SubmitBlindedBlock(msg consensus.SignedBlindedBlock) (*VersionedExecutionPayload, error) {
+	var version consensus.ForkName
+	switch msg.(type) {
+	case *consensus.SignedBlindedBeaconBlock:
+		version = consensus.ForkBellatrix
+	case *consensus.SignedBlindedBeaconBlockCapella:
+		version = consensus.ForkCapella
+	case *consensus.SignedBlindedBeaconBlockDeneb:
+		version = consensus.ForkDeneb
+	case *consensus.SignedBlindedBeaconBlockElectra:
+		version = consensus.ForkElectra
+	default:
+		return nil, fmt.Errorf("unknown blinded block type %T", msg)
+	}
+
+	resp, err := b.c.postVersioned("/eth/v1/builder/blinded_blocks", string(version), msg)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Version != "" && consensus.ForkName(resp.Version) != version {
+		return nil, fmt.Errorf("payload version '%s' does not match block version '%s'", resp.Version, version)
+	}
+
+	out := &VersionedExecutionPayload{Version: version}
+	switch version {
+	case consensus.ForkBellatrix:
+		err = Unmarshal(resp.Data, &out.Bellatrix, b.c.config.untrackedKeys)
+	case consensus.ForkCapella:
+		err = Unmarshal(resp.Data, &out.Capella, b.c.config.untrackedKeys)
+	case consensus.ForkDeneb:
+		err = Unmarshal(resp.Data, &out.Deneb, b.c.config.untrackedKeys)
+	case consensus.ForkElectra:
+		err = Unmarshal(resp.Data, &out.Electra, b.c.config.untrackedKeys)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (b *BuilderEndpoint) Status() (bool, error) {
@@ -1,9 +1,13 @@
 package http
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	consensus "github.com/umbracle/go-eth-consensus"
 )
 
@@ -35,3 +39,83 @@ func TestBuilderEndpoint(t *testing.T) {
 		assert.True(t, ok)
 	})
 }
+
+func TestBuilderEndpoint_Versioned(t *testing.T) {
+	bid := &SignedBuilderBidDeneb{
+		Message: &BuilderBidDeneb{
+			Header:             &consensus.ExecutionPayloadHeaderDeneb{BlockNumber: 10},
+			BlobKzgCommitments: [][48]byte{{0x1}},
+			Pubkey:             [48]byte{0x2},
+		},
+	}
+	payload := &consensus.ExecutionPayloadAndBlobsBundle{
+		ExecutionPayload: &consensus.ExecutionPayloadDeneb{BlockNumber: 10},
+		BlobsBundle: &consensus.BlobsBundle{
+			Commitments: [][48]byte{{0x1}},
+			Proofs:      [][48]byte{{0x2}},
+			Blobs:       [][131072]byte{{0x3}},
+		},
+	}
+
+	writeData := func(w http.ResponseWriter, version string, obj interface{}) {
+		data, err := Marshal(obj)
+		require.NoError(t, err)
+		fmt.Fprintf(w, `{"version": "%s", "data": %s}`, version, string(data))
+	}
+
+	var reqVersion string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/builder/blinded_blocks":
+			reqVersion = r.Header.Get(versionHeader)
+			writeData(w, "deneb", payload)
+		default:
+			w.Header().Set(versionHeader, "deneb")
+			writeData(w, "", bid)
+		}
+	}))
+	defer srv.Close()
+
+	n := New(srv.URL).Builder()
+
+	foundBid, err := n.GetExecutionPayload(1, [32]byte{}, [48]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, consensus.ForkDeneb, foundBid.Version)
+	assert.Equal(t, bid.Message.BlobKzgCommitments, foundBid.Deneb.Message.BlobKzgCommitments)
+
+	pubkey, err := foundBid.Pubkey()
+	require.NoError(t, err)
+	assert.Equal(t, bid.Message.Pubkey, pubkey)
+
+	header, err := foundBid.Header()
+	require.NoError(t, err)
+	assert.Equal(t, bid.Message.Header.BlockNumber, header.(*consensus.ExecutionPayloadHeaderDeneb).BlockNumber)
+
+	found, err := n.SubmitBlindedBlock(&consensus.SignedBlindedBeaconBlockDeneb{})
+	require.NoError(t, err)
+	assert.Equal(t, "deneb", reqVersion)
+	assert.Equal(t, consensus.ForkDeneb, found.Version)
+	assert.Equal(t, payload.BlobsBundle, found.Deneb.BlobsBundle)
+
+	foundPayload, err := found.Payload()
+	require.NoError(t, err)
+	assert.Equal(t, payload.ExecutionPayload.BlockNumber, foundPayload.(*consensus.ExecutionPayloadDeneb).BlockNumber)
+
+	// the version of the response does not match the block
+	_, err = n.SubmitBlindedBlock(&consensus.SignedBlindedBeaconBlockCapella{})
+	require.Error(t, err)
+}
+
+func TestBuilderEndpoint_NoBid(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	n := New(srv.URL).Builder()
+
+	bid, err := n.GetExecutionPayload(1, [32]byte{}, [48]byte{})
+	require.ErrorIs(t, err, ErrorNoBid)
+	require.Nil(t, bid)
+}
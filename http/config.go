@@ -17,9 +17,15 @@ func (c *ConfigEndpoint) ForkSchedule() ([]*consensus.Fork, error) {
 }
 
 func (c *ConfigEndpoint) Spec() (*consensus.Spec, error) {
-	var spec *consensus.Spec
-	err := c.c.Get("/eth/v1/config/spec", &spec)
-	return spec, err
+	var values map[string]string
+	if err := c.c.Get("/eth/v1/config/spec", &values); err != nil {
+		return nil, err
+	}
+	spec := &consensus.Spec{}
+	if err := spec.Merge(values); err != nil {
+		return nil, err
+	}
+	return spec, nil
 }
 
 type DepositContract struct {
@@ -122,26 +122,33 @@ type httpErrorMessage struct {
 	Message string `json:"message"`
 }
 
-func (c *Client) decodeResp(resp *http.Response, out interface{}) error {
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
+func decodeRespError(resp *http.Response, data []byte) error {
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	// decode the error message
+	var msg httpErrorMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		// decode the error message
-		var msg httpErrorMessage
-		if err := json.Unmarshal(data, &msg); err != nil {
-			return err
-		}
+	errorMsgCode, ok := httpErrorMapping[resp.StatusCode]
+	if ok {
+		return fmt.Errorf("%w: %v", errorMsgCode, msg.Message)
+	}
 
-		errorMsgCode, ok := httpErrorMapping[resp.StatusCode]
-		if ok {
-			return fmt.Errorf("%w: %v", errorMsgCode, msg.Message)
-		}
+	// return the error message as is
+	return fmt.Errorf(msg.Message)
+}
 
-		// return the error message as is
-		return fmt.Errorf(msg.Message)
+func (c *Client) decodeResp(resp *http.Response, out interface{}) error {
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := decodeRespError(resp, data); err != nil {
+		return err
 	}
 
 	c.config.logger.Printf("[TRACE] Http response: data, %s", string(data))
@@ -171,3 +178,84 @@ func (c *Client) decodeResp(resp *http.Response, out interface{}) error {
 	}
 	return nil
 }
+
+// versionHeader is the header that includes the fork of the
+// object in the request or the response
+const versionHeader = "Eth-Consensus-Version"
+
+// versionedResponse is a response whose data depends on the fork
+type versionedResponse struct {
+	Version string
+	Data    json.RawMessage
+}
+
+// getVersioned queries a versioned endpoint and returns the raw data
+// and the fork of the response
+func (c *Client) getVersioned(path string) (*versionedResponse, error) {
+	resp, err := http.Get(c.url + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	c.config.logger.Printf("[TRACE] Get request: path, %s", path)
+
+	return c.decodeVersionedResp(resp)
+}
+
+// postVersioned sends the input object with the fork version header
+// and returns the raw data and the fork of the response
+func (c *Client) postVersioned(path string, version string, input interface{}) (*versionedResponse, error) {
+	postBody, err := Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.url+path, bytes.NewBuffer(postBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(versionHeader, version)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return c.decodeVersionedResp(resp)
+}
+
+// errNoContent is returned by the versioned endpoints for an empty response (204)
+var errNoContent = fmt.Errorf("no content (204)")
+
+func (c *Client) decodeVersionedResp(resp *http.Response) (*versionedResponse, error) {
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, errNoContent
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := decodeRespError(resp, data); err != nil {
+		return nil, err
+	}
+
+	c.config.logger.Printf("[TRACE] Http response: data, %s", string(data))
+
+	var output struct {
+		Version string          `json:"version,omitempty"`
+		Data    json.RawMessage `json:"data,omitempty"`
+	}
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, err
+	}
+
+	// the header takes precedence over the version in the body
+	version := resp.Header.Get(versionHeader)
+	if version == "" {
+		version = output.Version
+	}
+	return &versionedResponse{Version: version, Data: output.Data}, nil
+}
@@ -1,6 +1,15 @@
 package consensus
 
+import ssz "github.com/ferranbt/fastssz"
+
+type sszObject interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
 type SignedBeaconBlock interface {
+	sszObject
 	isSignedBeaconBlock()
 }
 
@@ -16,7 +25,14 @@ func (s *SignedBeaconBlockBellatrix) isSignedBeaconBlock() {
 func (s *SignedBeaconBlockCapella) isSignedBeaconBlock() {
 }
 
+func (s *SignedBeaconBlockDeneb) isSignedBeaconBlock() {
+}
+
+func (s *SignedBeaconBlockElectra) isSignedBeaconBlock() {
+}
+
 type BeaconBlock interface {
+	sszObject
 	isBeaconBlock()
 }
 
@@ -32,7 +48,14 @@ func (s *BeaconBlockBellatrix) isBeaconBlock() {
 func (s *BeaconBlockCapella) isBeaconBlock() {
 }
 
+func (s *BeaconBlockDeneb) isBeaconBlock() {
+}
+
+func (s *BeaconBlockElectra) isBeaconBlock() {
+}
+
 type BeaconState interface {
+	sszObject
 	isBeaconState()
 }
 
@@ -47,3 +70,43 @@ func (s *BeaconStateBellatrix) isBeaconState() {
 
 func (s *BeaconStateCapella) isBeaconState() {
 }
+
+func (s *BeaconStateDeneb) isBeaconState() {
+}
+
+func (s *BeaconStateElectra) isBeaconState() {
+}
+
+type SignedBlindedBlock interface {
+	sszObject
+	isSignedBlindedBlock()
+}
+
+func (s *SignedBlindedBeaconBlock) isSignedBlindedBlock() {
+}
+
+func (s *SignedBlindedBeaconBlockCapella) isSignedBlindedBlock() {
+}
+
+func (s *SignedBlindedBeaconBlockDeneb) isSignedBlindedBlock() {
+}
+
+func (s *SignedBlindedBeaconBlockElectra) isSignedBlindedBlock() {
+}
+
+type BlindedBlock interface {
+	sszObject
+	isBlindedBlock()
+}
+
+func (s *BlindedBeaconBlock) isBlindedBlock() {
+}
+
+func (s *BlindedBeaconBlockCapella) isBlindedBlock() {
+}
+
+func (s *BlindedBeaconBlockDeneb) isBlindedBlock() {
+}
+
+func (s *BlindedBeaconBlockElectra) isBlindedBlock() {
+}
@@ -0,0 +1,310 @@
+// copygen generates the Copy and Equal methods for the structs of a file.
+//
+// Copy returns a deep copy of the object and keeps nil pointers and slices as nil.
+// Equal compares two objects the same way that ssz does, a nil pointer
+// is equal to an empty object and a nil slice is equal to an empty slice.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strings"
+)
+
+func main() {
+	var path, output string
+
+	flag.StringVar(&path, "path", "", "file with the structs")
+	flag.StringVar(&output, "output", "", "output file")
+	flag.Parse()
+
+	if path == "" || output == "" {
+		fmt.Println("path and output are required")
+		os.Exit(1)
+	}
+
+	res, err := generate(path)
+	if err != nil {
+		fmt.Printf("failed to generate: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(output, res, 0644); err != nil {
+		fmt.Printf("failed to write output: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type generator struct {
+	buf bytes.Buffer
+
+	// structs is the set of struct types in the file
+	structs map[string]struct{}
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func generate(path string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{structs: map[string]struct{}{}}
+
+	specs := []*ast.TypeSpec{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if _, ok := typeSpec.Type.(*ast.StructType); ok {
+				g.structs[typeSpec.Name.Name] = struct{}{}
+				specs = append(specs, typeSpec)
+			}
+		}
+	}
+
+	for _, spec := range specs {
+		if err := g.genCopy(spec); err != nil {
+			return nil, fmt.Errorf("type %s: %v", spec.Name.Name, err)
+		}
+		if err := g.genEqual(spec); err != nil {
+			return nil, fmt.Errorf("type %s: %v", spec.Name.Name, err)
+		}
+	}
+
+	body := g.buf.Bytes()
+
+	var res bytes.Buffer
+	fmt.Fprintf(&res, "// Code generated by copygen. DO NOT EDIT.\n")
+	fmt.Fprintf(&res, "package %s\n\n", file.Name.Name)
+	if bytes.Contains(body, []byte("bytes.Equal")) {
+		fmt.Fprintf(&res, "import \"bytes\"\n\n")
+	}
+	res.Write(body)
+
+	return format.Source(res.Bytes())
+}
+
+func receiver(name string) string {
+	return strings.ToLower(name[:1])
+}
+
+// fieldKind is how a field is copied and compared
+type fieldKind int
+
+const (
+	// kindValue is a type without references (basic types and arrays of basic types)
+	kindValue fieldKind = iota
+
+	// kindPtr is a pointer to a struct
+	kindPtr
+
+	// kindValues is a slice of values
+	kindValues
+
+	// kindSliceBytes is a slice of byte slices
+	kindSliceBytes
+
+	// kindSlicePtr is a slice of pointers to structs
+	kindSlicePtr
+)
+
+func (g *generator) fieldKind(expr ast.Expr) (fieldKind, string, error) {
+	switch obj := expr.(type) {
+	case *ast.Ident:
+		if _, ok := g.structs[obj.Name]; ok {
+			return 0, "", fmt.Errorf("struct value %s not supported", obj.Name)
+		}
+		return kindValue, "", nil
+
+	case *ast.ArrayType:
+		if obj.Len != nil {
+			// fixed size array
+			kind, _, err := g.fieldKind(obj.Elt)
+			if err != nil {
+				return 0, "", err
+			}
+			if kind != kindValue {
+				return 0, "", fmt.Errorf("array of references not supported")
+			}
+			return kindValue, "", nil
+		}
+
+		switch elem := obj.Elt.(type) {
+		case *ast.StarExpr:
+			ident, ok := elem.X.(*ast.Ident)
+			if !ok {
+				return 0, "", fmt.Errorf("pointer type not supported")
+			}
+			return kindSlicePtr, ident.Name, nil
+
+		case *ast.ArrayType:
+			if elem.Len == nil {
+				if ident, ok := elem.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+					return kindSliceBytes, "", nil
+				}
+				return 0, "", fmt.Errorf("nested slices not supported")
+			}
+		}
+		kind, _, err := g.fieldKind(obj.Elt)
+		if err != nil {
+			return 0, "", err
+		}
+		if kind != kindValue {
+			return 0, "", fmt.Errorf("slice type not supported")
+		}
+		return kindValues, exprString(obj.Elt), nil
+
+	case *ast.StarExpr:
+		ident, ok := obj.X.(*ast.Ident)
+		if !ok {
+			return 0, "", fmt.Errorf("pointer type not supported")
+		}
+		if _, ok := g.structs[ident.Name]; !ok {
+			return 0, "", fmt.Errorf("pointer to %s not supported", ident.Name)
+		}
+		return kindPtr, ident.Name, nil
+
+	default:
+		return 0, "", fmt.Errorf("type %T not supported", expr)
+	}
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+func fieldNames(field *ast.Field) []string {
+	names := []string{}
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+	return names
+}
+
+func (g *generator) genCopy(spec *ast.TypeSpec) error {
+	name := spec.Name.Name
+	r := receiver(name)
+
+	g.printf("// Copy returns a deep copy of the %s object\n", name)
+	g.printf("func (%s *%s) Copy() *%s {\n", r, name, name)
+	g.printf("if %s == nil {\nreturn nil\n}\n", r)
+	g.printf("cpy := *%s\n", r)
+
+	for _, field := range spec.Type.(*ast.StructType).Fields.List {
+		kind, elem, err := g.fieldKind(field.Type)
+		if err != nil {
+			return err
+		}
+		for _, fieldName := range fieldNames(field) {
+			src := r + "." + fieldName
+			dst := "cpy." + fieldName
+
+			switch kind {
+			case kindPtr:
+				g.printf("%s = %s.Copy()\n", dst, src)
+
+			case kindValues:
+				g.printf("if %s != nil {\n", src)
+				g.printf("%s = make([]%s, len(%s))\n", dst, elem, src)
+				g.printf("copy(%s, %s)\n", dst, src)
+				g.printf("}\n")
+
+			case kindSliceBytes:
+				g.printf("if %s != nil {\n", src)
+				g.printf("%s = make([][]byte, len(%s))\n", dst, src)
+				g.printf("for indx, elem := range %s {\n", src)
+				g.printf("if elem != nil {\n")
+				g.printf("%s[indx] = append([]byte{}, elem...)\n", dst)
+				g.printf("}\n}\n}\n")
+
+			case kindSlicePtr:
+				g.printf("if %s != nil {\n", src)
+				g.printf("%s = make([]*%s, len(%s))\n", dst, elem, src)
+				g.printf("for indx, elem := range %s {\n", src)
+				g.printf("%s[indx] = elem.Copy()\n", dst)
+				g.printf("}\n}\n")
+			}
+		}
+	}
+
+	g.printf("return &cpy\n}\n\n")
+	return nil
+}
+
+func (g *generator) genEqual(spec *ast.TypeSpec) error {
+	name := spec.Name.Name
+	r := receiver(name)
+	other := "other"
+
+	g.printf("// Equal returns whether two %s objects are equal. As in ssz, a nil object\n", name)
+	g.printf("// is equal to an empty object and a nil list is equal to an empty list.\n")
+	g.printf("func (%s *%s) Equal(%s *%s) bool {\n", r, name, other, name)
+	g.printf("if %s == %s {\nreturn true\n}\n", r, other)
+	g.printf("if %s == nil {\n%s = new(%s)\n}\n", r, r, name)
+	g.printf("if %s == nil {\n%s = new(%s)\n}\n", other, other, name)
+
+	for _, field := range spec.Type.(*ast.StructType).Fields.List {
+		if field.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+			if tag.Get("ssz") == "-" {
+				// the field is not part of the ssz object
+				continue
+			}
+		}
+
+		kind, elem, err := g.fieldKind(field.Type)
+		if err != nil {
+			return err
+		}
+		for _, fieldName := range fieldNames(field) {
+			a := r + "." + fieldName
+			b := other + "." + fieldName
+
+			switch kind {
+			case kindValue:
+				g.printf("if %s != %s {\nreturn false\n}\n", a, b)
+
+			case kindPtr:
+				g.printf("if !%s.Equal(%s) {\nreturn false\n}\n", a, b)
+
+			case kindValues:
+				if elem == "byte" {
+					g.printf("if !bytes.Equal(%s, %s) {\nreturn false\n}\n", a, b)
+					continue
+				}
+				g.printf("if len(%s) != len(%s) {\nreturn false\n}\n", a, b)
+				g.printf("for indx := range %s {\n", a)
+				g.printf("if %s[indx] != %s[indx] {\nreturn false\n}\n}\n", a, b)
+
+			case kindSliceBytes:
+				g.printf("if len(%s) != len(%s) {\nreturn false\n}\n", a, b)
+				g.printf("for indx := range %s {\n", a)
+				g.printf("if !bytes.Equal(%s[indx], %s[indx]) {\nreturn false\n}\n}\n", a, b)
+
+			case kindSlicePtr:
+				g.printf("if len(%s) != len(%s) {\nreturn false\n}\n", a, b)
+				g.printf("for indx := range %s {\n", a)
+				g.printf("if !%s[indx].Equal(%s[indx]) {\nreturn false\n}\n}\n", a, b)
+			}
+		}
+	}
+
+	g.printf("return true\n}\n\n")
+	return nil
+}
@@ -0,0 +1,372 @@
+// jsongen generates the MarshalJSON and UnmarshalJSON methods for the structs of a file.
+//
+// The encoding follows the conventions of the beacon api: integers are encoded as
+// quoted strings and bytes (byte arrays, byte lists and bitlists) as 0x prefixed hex strings.
+// Nil containers and lists are encoded as empty ones.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// uint8Lists are the json names of the byte lists that are encoded
+// as lists of integers (List[ParticipationFlags]) and not as hex strings
+var uint8Lists = map[string]bool{
+	"previous_epoch_participation": true,
+	"current_epoch_participation":  true,
+}
+
+// textTypes are the types that are encoded with their text marshaler
+var textTypes = map[string]bool{
+	"Uint256": true,
+}
+
+func main() {
+	var path, output string
+
+	flag.StringVar(&path, "path", "", "file with the structs")
+	flag.StringVar(&output, "output", "", "output file")
+	flag.Parse()
+
+	if path == "" || output == "" {
+		fmt.Println("path and output are required")
+		os.Exit(1)
+	}
+
+	res, err := generate(path)
+	if err != nil {
+		fmt.Printf("failed to generate: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(output, res, 0644); err != nil {
+		fmt.Printf("failed to write output: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type generator struct {
+	buf bytes.Buffer
+
+	// structs is the set of struct types in the file
+	structs map[string]struct{}
+
+	// types are the non struct types in the file
+	types map[string]ast.Expr
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func generate(path string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		structs: map[string]struct{}{},
+		types:   map[string]ast.Expr{},
+	}
+
+	specs := []*ast.TypeSpec{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if _, ok := typeSpec.Type.(*ast.StructType); ok {
+				g.structs[typeSpec.Name.Name] = struct{}{}
+				specs = append(specs, typeSpec)
+			} else {
+				g.types[typeSpec.Name.Name] = typeSpec.Type
+			}
+		}
+	}
+
+	g.printf("// Code generated by jsongen. DO NOT EDIT.\n")
+	g.printf("package %s\n\n", file.Name.Name)
+	g.printf("import \"encoding/json\"\n\n")
+
+	for _, spec := range specs {
+		if err := g.genStruct(spec); err != nil {
+			return nil, fmt.Errorf("type %s: %v", spec.Name.Name, err)
+		}
+	}
+
+	return format.Source(g.buf.Bytes())
+}
+
+// fieldKind is how a field is encoded
+type fieldKind int
+
+const (
+	// kindUint64 is an uint64 encoded as a quoted string
+	kindUint64 fieldKind = iota
+
+	// kindPlain is a type encoded as it is (bool or text marshalers)
+	kindPlain
+
+	// kindFixedBytes is a byte array
+	kindFixedBytes
+
+	// kindBytes is a byte list or a bitlist
+	kindBytes
+
+	// kindUint8List is a byte list encoded as a list of integers
+	kindUint8List
+
+	// kindUint64List is a list of uint64
+	kindUint64List
+
+	// kindBytesList is a list of byte lists
+	kindBytesList
+
+	// kindFixedBytesList is a list of byte arrays
+	kindFixedBytesList
+
+	// kindFixedBytesVector is an array of byte arrays
+	kindFixedBytesVector
+
+	// kindPtr is a pointer to a struct
+	kindPtr
+
+	// kindPtrList is a list of pointers to structs
+	kindPtrList
+)
+
+type field struct {
+	name     string
+	jsonName string
+	kind     fieldKind
+	typ      string
+}
+
+// resolve returns the underlying type of the types declared in the file
+func (g *generator) resolve(expr ast.Expr) ast.Expr {
+	if ident, ok := expr.(*ast.Ident); ok {
+		if typ, ok := g.types[ident.Name]; ok && !textTypes[ident.Name] {
+			return typ
+		}
+	}
+	return expr
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+func isByteArray(expr ast.Expr) bool {
+	arr, ok := expr.(*ast.ArrayType)
+	return ok && arr.Len != nil && isIdent(arr.Elt, "byte")
+}
+
+func (g *generator) fieldKind(expr ast.Expr, jsonName string) (fieldKind, error) {
+	expr = g.resolve(expr)
+
+	switch obj := expr.(type) {
+	case *ast.Ident:
+		switch {
+		case obj.Name == "uint64":
+			return kindUint64, nil
+		case obj.Name == "bool", textTypes[obj.Name]:
+			return kindPlain, nil
+		}
+
+	case *ast.StarExpr:
+		if ident, ok := obj.X.(*ast.Ident); ok {
+			if _, ok := g.structs[ident.Name]; ok {
+				return kindPtr, nil
+			}
+		}
+
+	case *ast.ArrayType:
+		elem := g.resolve(obj.Elt)
+
+		if obj.Len != nil {
+			// fixed size array
+			if isIdent(elem, "byte") {
+				return kindFixedBytes, nil
+			}
+			if isByteArray(elem) {
+				return kindFixedBytesVector, nil
+			}
+			break
+		}
+
+		switch {
+		case isIdent(elem, "byte"):
+			if uint8Lists[jsonName] {
+				return kindUint8List, nil
+			}
+			return kindBytes, nil
+
+		case isIdent(elem, "uint64"):
+			return kindUint64List, nil
+
+		case isByteArray(elem):
+			return kindFixedBytesList, nil
+		}
+
+		if arr, ok := elem.(*ast.ArrayType); ok && arr.Len == nil && isIdent(arr.Elt, "byte") {
+			return kindBytesList, nil
+		}
+		if star, ok := elem.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok {
+				if _, ok := g.structs[ident.Name]; ok {
+					return kindPtrList, nil
+				}
+			}
+		}
+	}
+	return 0, fmt.Errorf("type %s not supported", exprString(expr))
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+func (g *generator) structFields(spec *ast.TypeSpec) ([]*field, error) {
+	fields := []*field{}
+	for _, f := range spec.Type.(*ast.StructType).Fields.List {
+		jsonName := ""
+		if f.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(f.Tag.Value, "`"))
+			if tag.Get("ssz") == "-" {
+				// the field is not part of the ssz object
+				continue
+			}
+			jsonName = tag.Get("json")
+		}
+
+		for _, name := range f.Names {
+			if jsonName == "" || jsonName == "-" {
+				return nil, fmt.Errorf("field %s does not have a json name", name.Name)
+			}
+			kind, err := g.fieldKind(f.Type, jsonName)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %v", name.Name, err)
+			}
+			fields = append(fields, &field{
+				name:     name.Name,
+				jsonName: jsonName,
+				kind:     kind,
+				typ:      exprString(f.Type),
+			})
+		}
+	}
+	return fields, nil
+}
+
+// jsonType returns the type of the field in the json object
+func (f *field) jsonType() string {
+	switch f.kind {
+	case kindUint64:
+		return "jsonUint64"
+	case kindFixedBytes, kindBytes:
+		return "jsonBytes"
+	case kindUint8List:
+		return "jsonUint8List"
+	case kindUint64List:
+		return "jsonUint64List"
+	case kindBytesList, kindFixedBytesList, kindFixedBytesVector:
+		return "jsonBytesList"
+	default:
+		return f.typ
+	}
+}
+
+func (g *generator) genStruct(spec *ast.TypeSpec) error {
+	name := spec.Name.Name
+	jsonName := "json" + name
+	r := strings.ToLower(name[:1])
+
+	fields, err := g.structFields(spec)
+	if err != nil {
+		return err
+	}
+
+	// json object
+	g.printf("type %s struct {\n", jsonName)
+	for _, f := range fields {
+		g.printf("%s %s `json:\"%s\"`\n", f.name, f.jsonType(), f.jsonName)
+	}
+	g.printf("}\n\n")
+
+	// marshal
+	g.printf("// MarshalJSON implements the json.Marshaler interface\n")
+	g.printf("func (%s *%s) MarshalJSON() ([]byte, error) {\n", r, name)
+	g.printf("enc := &%s{}\n", jsonName)
+	for _, f := range fields {
+		src := r + "." + f.name
+		dst := "enc." + f.name
+
+		switch f.kind {
+		case kindUint64:
+			g.printf("%s = jsonUint64(%s)\n", dst, src)
+		case kindFixedBytes:
+			g.printf("%s = %s[:]\n", dst, src)
+		case kindFixedBytesList, kindFixedBytesVector:
+			g.printf("%s = make(jsonBytesList, len(%s))\n", dst, src)
+			g.printf("for indx := range %s {\n", src)
+			g.printf("%s[indx] = %s[indx][:]\n", dst, src)
+			g.printf("}\n")
+		case kindPtr:
+			g.printf("%s = jsonObj(%s)\n", dst, src)
+		case kindPtrList:
+			g.printf("%s = jsonList(%s)\n", dst, src)
+		default:
+			g.printf("%s = %s\n", dst, src)
+		}
+	}
+	g.printf("return json.Marshal(enc)\n")
+	g.printf("}\n\n")
+
+	// unmarshal
+	g.printf("// UnmarshalJSON implements the json.Unmarshaler interface\n")
+	g.printf("func (%s *%s) UnmarshalJSON(data []byte) error {\n", r, name)
+	g.printf("var dec %s\n", jsonName)
+	g.printf("if err := json.Unmarshal(data, &dec); err != nil {\nreturn err\n}\n")
+	for _, f := range fields {
+		src := "dec." + f.name
+		dst := r + "." + f.name
+
+		switch f.kind {
+		case kindUint64:
+			g.printf("%s = uint64(%s)\n", dst, src)
+		case kindFixedBytes:
+			g.printf("if err := copyFixedBytes(%s[:], %s, \"%s\"); err != nil {\nreturn err\n}\n", dst, src, f.jsonName)
+		case kindFixedBytesList:
+			g.printf("%s = make(%s, len(%s))\n", dst, f.typ, src)
+			g.printf("for indx := range %s {\n", src)
+			g.printf("if err := copyFixedBytes(%s[indx][:], %s[indx], \"%s\"); err != nil {\nreturn err\n}\n", dst, src, f.jsonName)
+			g.printf("}\n")
+		case kindFixedBytesVector:
+			g.printf("if err := checkVectorLength(len(%s), len(%s), \"%s\"); err != nil {\nreturn err\n}\n", dst, src, f.jsonName)
+			g.printf("for indx := range %s {\n", src)
+			g.printf("if err := copyFixedBytes(%s[indx][:], %s[indx], \"%s\"); err != nil {\nreturn err\n}\n", dst, src, f.jsonName)
+			g.printf("}\n")
+		default:
+			g.printf("%s = %s\n", dst, src)
+		}
+	}
+	g.printf("return nil\n")
+	g.printf("}\n\n")
+
+	return nil
+}
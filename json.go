@@ -0,0 +1,162 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The json encoding of the consensus types follows the conventions of the beacon api.
+// Integers are encoded as quoted decimal strings and bytes as 0x prefixed hex strings.
+
+// jsonUint64 is an uint64 encoded as a quoted decimal string
+type jsonUint64 uint64
+
+func (u jsonUint64) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + strconv.FormatUint(uint64(u), 10) + `"`), nil
+}
+
+func (u *jsonUint64) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("expected a quoted integer: %v", err)
+	}
+	num, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return err
+	}
+	*u = jsonUint64(num)
+	return nil
+}
+
+// jsonUint64List is a list of uint64 encoded as quoted decimal strings
+type jsonUint64List []uint64
+
+func (l jsonUint64List) MarshalJSON() ([]byte, error) {
+	res := make([]jsonUint64, len(l))
+	for indx, num := range l {
+		res[indx] = jsonUint64(num)
+	}
+	return json.Marshal(res)
+}
+
+func (l *jsonUint64List) UnmarshalJSON(data []byte) error {
+	var res []jsonUint64
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	*l = make(jsonUint64List, len(res))
+	for indx, num := range res {
+		(*l)[indx] = uint64(num)
+	}
+	return nil
+}
+
+// jsonUint8List is a list of uint8 encoded as quoted decimal strings
+// (i.e. participation flags) instead of as hex bytes
+type jsonUint8List []byte
+
+func (l jsonUint8List) MarshalJSON() ([]byte, error) {
+	res := make([]jsonUint64, len(l))
+	for indx, num := range l {
+		res[indx] = jsonUint64(num)
+	}
+	return json.Marshal(res)
+}
+
+func (l *jsonUint8List) UnmarshalJSON(data []byte) error {
+	var res []jsonUint64
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	*l = make(jsonUint8List, len(res))
+	for indx, num := range res {
+		if num > 0xff {
+			return fmt.Errorf("uint8 overflow: %d", num)
+		}
+		(*l)[indx] = byte(num)
+	}
+	return nil
+}
+
+// jsonBytes are bytes encoded as a 0x prefixed hex string
+type jsonBytes []byte
+
+func (b jsonBytes) MarshalJSON() ([]byte, error) {
+	return []byte(`"0x` + hex.EncodeToString(b) + `"`), nil
+}
+
+func (b *jsonBytes) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("expected a hex string: %v", err)
+	}
+	if !strings.HasPrefix(str, "0x") {
+		return fmt.Errorf("0x prefix not found in '%s'", str)
+	}
+	buf, err := hex.DecodeString(str[2:])
+	if err != nil {
+		return err
+	}
+	*b = buf
+	return nil
+}
+
+// jsonBytesList is a list of bytes encoded as 0x prefixed hex strings
+type jsonBytesList [][]byte
+
+func (l jsonBytesList) MarshalJSON() ([]byte, error) {
+	res := make([]jsonBytes, len(l))
+	for indx, buf := range l {
+		res[indx] = buf
+	}
+	return json.Marshal(res)
+}
+
+func (l *jsonBytesList) UnmarshalJSON(data []byte) error {
+	var res []jsonBytes
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	*l = make(jsonBytesList, len(res))
+	for indx, buf := range res {
+		(*l)[indx] = buf
+	}
+	return nil
+}
+
+// jsonObj returns an empty object if the object is nil since
+// a nil container is encoded as an empty one
+func jsonObj[T any](obj *T) *T {
+	if obj == nil {
+		return new(T)
+	}
+	return obj
+}
+
+// jsonList returns an empty list if the list is nil
+func jsonList[T any](list []T) []T {
+	if list == nil {
+		return []T{}
+	}
+	return list
+}
+
+// copyFixedBytes copies the decoded bytes of a fixed size field
+func copyFixedBytes(dst, src []byte, name string) error {
+	if len(dst) != len(src) {
+		return fmt.Errorf("incorrect length for '%s': expected %d but found %d", name, len(dst), len(src))
+	}
+	copy(dst, src)
+	return nil
+}
+
+// checkVectorLength checks the length of the decoded elements of a vector field
+func checkVectorLength(expected, found int, name string) error {
+	if expected != found {
+		return fmt.Errorf("incorrect length for '%s': expected %d elements but found %d", name, expected, found)
+	}
+	return nil
+}
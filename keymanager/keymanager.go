@@ -0,0 +1,365 @@
+package keymanager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+// KeyManager loads the EIP-2335 keystores (*.json files) of a directory and indexes the
+// keys by public key. The keystores are decrypted in parallel with a pool of workers and
+// the directory can be rescanned (Reload or Watch) to add or remove keys on the fly.
+type KeyManager struct {
+	dir    string
+	config *Config
+
+	// reloadLock serializes the scans of the directory
+	reloadLock sync.Mutex
+
+	lock  sync.RWMutex
+	files map[string]*keyFile
+	keys  map[[48]byte]*keyEntry
+}
+
+type keyFile struct {
+	modTime time.Time
+	size    int64
+
+	// pub is the public key of the file if it was loaded
+	pub *[48]byte
+}
+
+type keyEntry struct {
+	file string
+	key  *bls.Key
+}
+
+type Config struct {
+	logger      *log.Logger
+	password    string
+	passwordDir string
+	workers     int
+}
+
+type ConfigOption func(*Config)
+
+func WithLogger(logger *log.Logger) ConfigOption {
+	return func(c *Config) {
+		c.logger = logger
+	}
+}
+
+// WithPassword sets the password of all the keystores
+func WithPassword(password string) ConfigOption {
+	return func(c *Config) {
+		c.password = password
+	}
+}
+
+// WithPasswordDir sets the directory with the password of each keystore in a file with the
+// name of the keystore and the .txt extension or without extension (i.e. keystore-0.txt for
+// keystore-0.json). If the password file does not exist, the password of WithPassword is used.
+func WithPasswordDir(dir string) ConfigOption {
+	return func(c *Config) {
+		c.passwordDir = dir
+	}
+}
+
+// WithWorkers sets the number of keystores decrypted in parallel (runtime.NumCPU by default)
+func WithWorkers(workers int) ConfigOption {
+	return func(c *Config) {
+		c.workers = workers
+	}
+}
+
+// New creates a key manager and loads the keystores of the directory. The keystores that
+// fail to load are reported in a *LoadError, in that case the key manager is still returned
+// with the rest of keys.
+func New(dir string, opts ...ConfigOption) (*KeyManager, error) {
+	config := &Config{
+		logger:  log.New(io.Discard, "", 0),
+		workers: runtime.NumCPU(),
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.workers <= 0 {
+		return nil, fmt.Errorf("the number of workers must be positive")
+	}
+
+	k := &KeyManager{
+		dir:    dir,
+		config: config,
+		files:  map[string]*keyFile{},
+		keys:   map[[48]byte]*keyEntry{},
+	}
+	if err := k.Reload(); err != nil {
+		if _, ok := err.(*LoadError); !ok {
+			return nil, err
+		}
+		return k, err
+	}
+	return k, nil
+}
+
+// LoadError is the error of the keystores that failed to load indexed by file
+type LoadError struct {
+	Files map[string]error
+}
+
+func (l *LoadError) Error() string {
+	files := make([]string, 0, len(l.Files))
+	for file := range l.Files {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	errs := make([]string, 0, len(files))
+	for _, file := range files {
+		errs = append(errs, fmt.Sprintf("%s: %v", file, l.Files[file]))
+	}
+	return fmt.Sprintf("failed to load %d keystores: %s", len(files), strings.Join(errs, ", "))
+}
+
+// Get returns the key of the public key
+func (k *KeyManager) Get(pub [48]byte) (*bls.Key, bool) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+
+	entry, ok := k.keys[pub]
+	if !ok {
+		return nil, false
+	}
+	return entry.key, true
+}
+
+// Keys returns the keys sorted by public key
+func (k *KeyManager) Keys() []*bls.Key {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+
+	keys := make([]*bls.Key, 0, len(k.keys))
+	for _, entry := range k.keys {
+		keys = append(keys, entry.key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i].PubKey(), keys[j].PubKey()
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+	return keys
+}
+
+// Len returns the number of keys
+func (k *KeyManager) Len() int {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+
+	return len(k.keys)
+}
+
+// Watch reloads the directory every interval until the context is done
+func (k *KeyManager) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := k.Reload(); err != nil {
+				k.config.logger.Printf("[ERROR]: failed to reload keystores: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Reload scans the directory to load the new or modified keystores and to remove
+// the keys of the deleted ones. A keystore that fails to load is not retried until
+// the file is modified.
+func (k *KeyManager) Reload() error {
+	k.reloadLock.Lock()
+	defer k.reloadLock.Unlock()
+
+	current, err := k.scan()
+	if err != nil {
+		return err
+	}
+
+	// files that are new or have changed since the last scan
+	k.lock.RLock()
+	updated := []string{}
+	for file, info := range current {
+		if prev, ok := k.files[file]; !ok || !prev.modTime.Equal(info.modTime) || prev.size != info.size {
+			updated = append(updated, file)
+		}
+	}
+	removed := []string{}
+	for file := range k.files {
+		if _, ok := current[file]; !ok {
+			removed = append(removed, file)
+		}
+	}
+	k.lock.RUnlock()
+
+	sort.Strings(updated)
+	results := k.decrypt(updated)
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	for _, file := range removed {
+		k.removeFile(file)
+	}
+	for _, file := range updated {
+		k.removeFile(file)
+	}
+
+	loadErr := &LoadError{Files: map[string]error{}}
+	for indx, file := range updated {
+		info := current[file]
+		k.files[file] = info
+
+		res := results[indx]
+		if res.err != nil {
+			loadErr.Files[file] = res.err
+			continue
+		}
+
+		pub := res.key.PubKey()
+		if entry, ok := k.keys[pub]; ok {
+			loadErr.Files[file] = fmt.Errorf("duplicated key 0x%x in %s", pub, entry.file)
+			continue
+		}
+		k.keys[pub] = &keyEntry{file: file, key: res.key}
+		info.pub = &pub
+	}
+
+	k.config.logger.Printf("[INFO]: keystores reloaded: updated=%d removed=%d failed=%d keys=%d", len(updated), len(removed), len(loadErr.Files), len(k.keys))
+
+	if len(loadErr.Files) != 0 {
+		return loadErr
+	}
+	return nil
+}
+
+// removeFile removes the file and its key (if any)
+func (k *KeyManager) removeFile(file string) {
+	info, ok := k.files[file]
+	if !ok {
+		return
+	}
+	if info.pub != nil {
+		delete(k.keys, *info.pub)
+	}
+	delete(k.files, file)
+}
+
+// scan returns the keystore files of the directory
+func (k *KeyManager) scan() (map[string]*keyFile, error) {
+	entries, err := os.ReadDir(k.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*keyFile{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != ".json" {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				// removed during the scan
+				continue
+			}
+			return nil, err
+		}
+		files[filepath.Join(k.dir, name)] = &keyFile{
+			modTime: info.ModTime(),
+			size:    info.Size(),
+		}
+	}
+	return files, nil
+}
+
+type decryptResult struct {
+	key *bls.Key
+	err error
+}
+
+// decrypt decrypts the keystores of the files with a pool of workers
+func (k *KeyManager) decrypt(files []string) []*decryptResult {
+	results := make([]*decryptResult, len(files))
+
+	jobs := make(chan int)
+	go func() {
+		for indx := range files {
+			jobs <- indx
+		}
+		close(jobs)
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < k.config.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for indx := range jobs {
+				key, err := k.loadKeystore(files[indx])
+				results[indx] = &decryptResult{key: key, err: err}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (k *KeyManager) loadKeystore(file string) (*bls.Key, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	password, err := k.password(file)
+	if err != nil {
+		return nil, err
+	}
+	return bls.FromKeystore(content, password)
+}
+
+// password returns the password of the keystore file. The trailing new line of the password
+// files does not need to be trimmed since the control codes are removed from the password
+// when the keystore is decrypted.
+func (k *KeyManager) password(file string) (string, error) {
+	if k.config.passwordDir == "" {
+		return k.config.password, nil
+	}
+
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	for _, passwordFile := range []string{name + ".txt", name} {
+		data, err := os.ReadFile(filepath.Join(k.config.passwordDir, passwordFile))
+		if err == nil {
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	if k.config.password != "" {
+		return k.config.password, nil
+	}
+	return "", fmt.Errorf("password file for '%s' not found", name)
+}
@@ -0,0 +1,149 @@
+package keymanager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+// writeKeystore writes the keystore of a new key in the directory
+func writeKeystore(t *testing.T, dir, name, password string) *bls.Key {
+	key := bls.NewRandomKey()
+
+	// low scrypt parameters to speed up the tests
+	content, err := bls.ToKeystore(key, password, bls.WithScrypt(1<<4, 8, 1))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), content, 0600))
+
+	return key
+}
+
+func requireKeys(t *testing.T, k *KeyManager, keys ...*bls.Key) {
+	require.Equal(t, len(keys), k.Len())
+	for _, key := range keys {
+		found, ok := k.Get(key.PubKey())
+		require.True(t, ok)
+		require.True(t, key.Equal(found))
+	}
+}
+
+func TestKeyManager_Password(t *testing.T) {
+	dir := t.TempDir()
+
+	keys := []*bls.Key{}
+	for _, name := range []string{"a.json", "b.json", "c.json"} {
+		keys = append(keys, writeKeystore(t, dir, name, "password"))
+	}
+	// files without the json extension are skipped
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "d.txt"), []byte("password"), 0600))
+
+	k, err := New(dir, WithPassword("password"), WithWorkers(2))
+	require.NoError(t, err)
+	requireKeys(t, k, keys...)
+	require.Len(t, k.Keys(), 3)
+
+	_, ok := k.Get(bls.NewRandomKey().PubKey())
+	require.False(t, ok)
+}
+
+func TestKeyManager_PasswordDir(t *testing.T) {
+	dir := t.TempDir()
+	passwordDir := t.TempDir()
+
+	writePassword := func(name, password string) {
+		require.NoError(t, os.WriteFile(filepath.Join(passwordDir, name), []byte(password), 0600))
+	}
+
+	keyA := writeKeystore(t, dir, "a.json", "passwordA")
+	writePassword("a.txt", "passwordA\n")
+
+	keyB := writeKeystore(t, dir, "b.json", "passwordB")
+	writePassword("b", "passwordB")
+
+	// uses the default password
+	keyC := writeKeystore(t, dir, "c.json", "password")
+
+	// wrong password
+	writeKeystore(t, dir, "d.json", "passwordD")
+	writePassword("d.txt", "wrong")
+
+	k, err := New(dir, WithPasswordDir(passwordDir), WithPassword("password"))
+	require.Error(t, err)
+
+	loadErr, ok := err.(*LoadError)
+	require.True(t, ok)
+	require.Len(t, loadErr.Files, 1)
+	require.Contains(t, loadErr.Files, filepath.Join(dir, "d.json"))
+
+	requireKeys(t, k, keyA, keyB, keyC)
+
+	// without a default password
+	_, err = New(dir, WithPasswordDir(passwordDir))
+	require.Error(t, err)
+}
+
+func TestKeyManager_Reload(t *testing.T) {
+	dir := t.TempDir()
+
+	keyA := writeKeystore(t, dir, "a.json", "password")
+
+	k, err := New(dir, WithPassword("password"))
+	require.NoError(t, err)
+	requireKeys(t, k, keyA)
+
+	// add a keystore
+	keyB := writeKeystore(t, dir, "b.json", "password")
+	require.NoError(t, k.Reload())
+	requireKeys(t, k, keyA, keyB)
+
+	// remove a keystore
+	require.NoError(t, os.Remove(filepath.Join(dir, "a.json")))
+	require.NoError(t, k.Reload())
+	requireKeys(t, k, keyB)
+
+	// replace a keystore
+	keyC := writeKeystore(t, dir, "b.json", "password")
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "b.json"), time.Now(), time.Now().Add(time.Minute)))
+	require.NoError(t, k.Reload())
+	requireKeys(t, k, keyC)
+
+	// the same key in two files
+	content, err := os.ReadFile(filepath.Join(dir, "b.json"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.json"), content, 0600))
+	require.Error(t, k.Reload())
+	requireKeys(t, k, keyC)
+
+	// the directory does not exist
+	_, err = New(filepath.Join(dir, "missing"), WithPassword("password"))
+	require.Error(t, err)
+}
+
+func TestKeyManager_Watch(t *testing.T) {
+	dir := t.TempDir()
+
+	k, err := New(dir, WithPassword("password"))
+	require.NoError(t, err)
+	require.Equal(t, 0, k.Len())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go k.Watch(ctx, 10*time.Millisecond)
+
+	key := writeKeystore(t, dir, "a.json", "password")
+	require.Eventually(t, func() bool {
+		_, ok := k.Get(key.PubKey())
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "a.json")))
+	require.Eventually(t, func() bool {
+		return k.Len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,137 @@
+package mnemonic
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"golang.org/x/text/unicode/norm"
+)
+
+// entropyBits is the entropy of the mnemonics (24 words) as in the staking-deposit-cli
+const entropyBits = 256
+
+// New generates a new BIP-39 mnemonic of 24 words
+func New() (string, error) {
+	entropy, err := bip39.NewEntropy(entropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// Validate checks that the mnemonic has a valid number of words of the english
+// wordlist and a valid checksum
+func Validate(mnemonic string) error {
+	if _, err := bip39.EntropyFromMnemonic(normalize(mnemonic)); err != nil {
+		return fmt.Errorf("invalid mnemonic: %v", err)
+	}
+	return nil
+}
+
+// Seed returns the BIP-39 seed of the mnemonic and the (optional) mnemonic password
+func Seed(mnemonic string, password string) ([]byte, error) {
+	mnemonic = normalize(mnemonic)
+	if err := Validate(mnemonic); err != nil {
+		return nil, err
+	}
+	return bip39.NewSeed(mnemonic, norm.NFKD.String(password)), nil
+}
+
+// normalize applies the NFKD normalization and removes the extra whitespace
+func normalize(mnemonic string) string {
+	return strings.Join(strings.Fields(norm.NFKD.String(mnemonic)), " ")
+}
+
+// Validator is the pair of keys of a validator derived from a mnemonic
+type Validator struct {
+	// Index is the index of the validator in the EIP-2334 paths
+	Index uint32
+
+	// Signing is the signing key (m/12381/3600/i/0/0)
+	Signing *bls.Key
+
+	// Withdrawal is the withdrawal key (m/12381/3600/i/0)
+	Withdrawal *bls.Key
+}
+
+// SigningPath returns the path of the signing key
+func (v *Validator) SigningPath() string {
+	return bls.SigningKeyPath(v.Index)
+}
+
+// WithdrawalPath returns the path of the withdrawal key
+func (v *Validator) WithdrawalPath() string {
+	return bls.WithdrawalKeyPath(v.Index)
+}
+
+// Keystore encrypts the signing key of the validator in an EIP-2335 keystore
+func (v *Validator) Keystore(password string) ([]byte, error) {
+	return bls.ToKeystoreWithPath(v.Signing, password, v.SigningPath())
+}
+
+// KeystoreFileName returns the name of the keystore file of the validator as in the
+// staking-deposit-cli (i.e. keystore-m_12381_3600_0_0_0-1600000000.json)
+func (v *Validator) KeystoreFileName(timestamp time.Time) string {
+	return fmt.Sprintf("keystore-%s-%d.json", strings.ReplaceAll(v.SigningPath(), "/", "_"), timestamp.Unix())
+}
+
+// DeriveValidators derives the keys of num validators starting at the index start
+func DeriveValidators(mnemonic string, password string, start, num uint32) ([]*Validator, error) {
+	seed, err := Seed(mnemonic, password)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]*Validator, 0, num)
+	for i := uint32(0); i < num; i++ {
+		index := start + i
+		if index < start {
+			return nil, fmt.Errorf("validator index overflow")
+		}
+
+		withdrawal, err := bls.NewKeyFromSeed(seed, bls.WithdrawalKeyPath(index))
+		if err != nil {
+			return nil, err
+		}
+		signing, err := bls.NewKeyFromSeed(seed, bls.SigningKeyPath(index))
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, &Validator{
+			Index:      index,
+			Signing:    signing,
+			Withdrawal: withdrawal,
+		})
+	}
+	return validators, nil
+}
+
+// WriteKeystores writes the keystores of the signing keys of the validators in the
+// directory and returns the paths of the files
+func WriteKeystores(dir string, validators []*Validator, password string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	files := make([]string, 0, len(validators))
+
+	for _, validator := range validators {
+		keystore, err := validator.Keystore(password)
+		if err != nil {
+			return nil, err
+		}
+		file := filepath.Join(dir, validator.KeystoreFileName(now))
+		if err := os.WriteFile(file, keystore, 0600); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
@@ -0,0 +1,131 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+// bip-39 test vector whose seed is the first test vector of EIP-2333
+const (
+	testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testPassword = "TREZOR"
+	testSeed     = "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"
+)
+
+func TestMnemonic_New(t *testing.T) {
+	mnemonic, err := New()
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 24)
+	require.NoError(t, Validate(mnemonic))
+
+	// invalid checksum
+	require.Error(t, Validate(strings.Repeat("abandon ", 12)))
+}
+
+func TestMnemonic_Seed(t *testing.T) {
+	seed, err := Seed(testMnemonic, testPassword)
+	require.NoError(t, err)
+	require.Equal(t, testSeed, hex.EncodeToString(seed))
+
+	// extra whitespace is ignored
+	seed, err = Seed("  "+strings.ReplaceAll(testMnemonic, " ", "   ")+"\n", testPassword)
+	require.NoError(t, err)
+	require.Equal(t, testSeed, hex.EncodeToString(seed))
+}
+
+func TestMnemonic_DeriveValidators(t *testing.T) {
+	validators, err := DeriveValidators(testMnemonic, testPassword, 5, 2)
+	require.NoError(t, err)
+	require.Len(t, validators, 2)
+
+	seed, err := hex.DecodeString(testSeed)
+	require.NoError(t, err)
+
+	for indx, validator := range validators {
+		index := uint32(5 + indx)
+		require.Equal(t, index, validator.Index)
+
+		signing, err := bls.NewKeyFromSeed(seed, bls.SigningKeyPath(index))
+		require.NoError(t, err)
+		require.True(t, signing.Equal(validator.Signing))
+
+		withdrawal, err := bls.NewKeyFromSeed(seed, bls.WithdrawalKeyPath(index))
+		require.NoError(t, err)
+		require.True(t, withdrawal.Equal(validator.Withdrawal))
+
+		// the signing key is the first child of the withdrawal key
+		child, err := bls.DeriveChildSK(withdrawal.Prv, 0)
+		require.NoError(t, err)
+		require.True(t, (&bls.Key{Pub: child.GetPublicKey()}).Equal(validator.Signing))
+	}
+
+	_, err = DeriveValidators("invalid mnemonic", "", 0, 1)
+	require.Error(t, err)
+}
+
+func TestMnemonic_DepositCli(t *testing.T) {
+	// signing keys of the first validators of the mnemonic with the derivation of
+	// staking-deposit-cli (EIP-2333 keys at the EIP-2334 paths and no mnemonic password)
+	fixtures := []struct {
+		pubkey string
+		path   string
+	}{
+		{
+			"b3e445d43871965d890a398f719348a1405ac72e35b92727cc570026f54471af7ea7b2040622a8fd0b5bfb2a209b5911",
+			"m/12381/3600/0/0/0",
+		},
+		{
+			"aeb399bf5648b0e9980c1731824c269631a41320c3d7f730c40587e1a37a5e1c8b5755fd90080a7b3fb90d3fd419c0a7",
+			"m/12381/3600/1/0/0",
+		},
+	}
+
+	validators, err := DeriveValidators(testMnemonic, "", 0, uint32(len(fixtures)))
+	require.NoError(t, err)
+
+	for indx, fixture := range fixtures {
+		validator := validators[indx]
+
+		pub := validator.Signing.PubKey()
+		require.Equal(t, fixture.pubkey, hex.EncodeToString(pub[:]))
+		require.Equal(t, fixture.path, validator.SigningPath())
+
+		content, err := validator.Keystore("password")
+		require.NoError(t, err)
+
+		ks, err := bls.ParseKeystore(content)
+		require.NoError(t, err)
+		require.Equal(t, fixture.path, ks.Path)
+		require.Equal(t, fixture.pubkey, ks.Pubkey)
+	}
+}
+
+func TestMnemonic_WriteKeystores(t *testing.T) {
+	validators, err := DeriveValidators(testMnemonic, "", 0, 1)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	files, err := WriteKeystores(dir, validators, "password")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Contains(t, files[0], "keystore-m_12381_3600_0_0_0-")
+
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	var keystore struct {
+		Path string `json:"path"`
+	}
+	require.NoError(t, json.Unmarshal(content, &keystore))
+	require.Equal(t, "m/12381/3600/0/0/0", keystore.Path)
+
+	key, err := bls.FromKeystore(content, "password")
+	require.NoError(t, err)
+	require.True(t, key.Equal(validators[0].Signing))
+}
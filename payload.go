@@ -0,0 +1,180 @@
+package consensus
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Payload is an execution payload of any fork after Bellatrix
+type Payload interface {
+	sszObject
+	isPayload()
+}
+
+func (e *ExecutionPayload) isPayload() {
+}
+
+func (e *ExecutionPayloadCapella) isPayload() {
+}
+
+func (e *ExecutionPayloadDeneb) isPayload() {
+}
+
+// PayloadHeader is an execution payload header of any fork after Bellatrix
+type PayloadHeader interface {
+	sszObject
+	isPayloadHeader()
+}
+
+func (e *ExecutionPayloadHeader) isPayloadHeader() {
+}
+
+func (e *ExecutionPayloadHeaderCapella) isPayloadHeader() {
+}
+
+func (e *ExecutionPayloadHeaderDeneb) isPayloadHeader() {
+}
+
+// PayloadToHeader returns the header of an execution payload
+func PayloadToHeader(payload Payload) (PayloadHeader, error) {
+	switch obj := payload.(type) {
+	case *ExecutionPayload:
+		return obj.Header()
+	case *ExecutionPayloadCapella:
+		return obj.Header()
+	case *ExecutionPayloadDeneb:
+		return obj.Header()
+	default:
+		return nil, errUnknownType(payload)
+	}
+}
+
+// Header returns the execution payload header of the payload
+func (e *ExecutionPayload) Header() (*ExecutionPayloadHeader, error) {
+	txRoot, err := transactionsRoot(e.Transactions)
+	if err != nil {
+		return nil, err
+	}
+	header := &ExecutionPayloadHeader{
+		ParentHash:       e.ParentHash,
+		FeeRecipient:     e.FeeRecipient,
+		StateRoot:        e.StateRoot,
+		ReceiptsRoot:     e.ReceiptsRoot,
+		LogsBloom:        e.LogsBloom,
+		PrevRandao:       e.PrevRandao,
+		BlockNumber:      e.BlockNumber,
+		GasLimit:         e.GasLimit,
+		GasUsed:          e.GasUsed,
+		Timestamp:        e.Timestamp,
+		ExtraData:        append([]byte{}, e.ExtraData...),
+		BaseFeePerGas:    e.BaseFeePerGas,
+		BlockHash:        e.BlockHash,
+		TransactionsRoot: txRoot,
+	}
+	return header, nil
+}
+
+// Header returns the execution payload header of the payload
+func (e *ExecutionPayloadCapella) Header() (*ExecutionPayloadHeaderCapella, error) {
+	txRoot, err := transactionsRoot(e.Transactions)
+	if err != nil {
+		return nil, err
+	}
+	withdrawalRoot, err := withdrawalsRoot(e.Withdrawals)
+	if err != nil {
+		return nil, err
+	}
+	header := &ExecutionPayloadHeaderCapella{
+		ParentHash:       e.ParentHash,
+		FeeRecipient:     e.FeeRecipient,
+		StateRoot:        e.StateRoot,
+		ReceiptsRoot:     e.ReceiptsRoot,
+		LogsBloom:        e.LogsBloom,
+		PrevRandao:       e.PrevRandao,
+		BlockNumber:      e.BlockNumber,
+		GasLimit:         e.GasLimit,
+		GasUsed:          e.GasUsed,
+		Timestamp:        e.Timestamp,
+		ExtraData:        append([]byte{}, e.ExtraData...),
+		BaseFeePerGas:    e.BaseFeePerGas,
+		BlockHash:        e.BlockHash,
+		TransactionsRoot: txRoot,
+		WithdrawalRoot:   withdrawalRoot,
+	}
+	return header, nil
+}
+
+// Header returns the execution payload header of the payload
+func (e *ExecutionPayloadDeneb) Header() (*ExecutionPayloadHeaderDeneb, error) {
+	txRoot, err := transactionsRoot(e.Transactions)
+	if err != nil {
+		return nil, err
+	}
+	withdrawalRoot, err := withdrawalsRoot(e.Withdrawals)
+	if err != nil {
+		return nil, err
+	}
+	header := &ExecutionPayloadHeaderDeneb{
+		ParentHash:       e.ParentHash,
+		FeeRecipient:     e.FeeRecipient,
+		StateRoot:        e.StateRoot,
+		ReceiptsRoot:     e.ReceiptsRoot,
+		LogsBloom:        e.LogsBloom,
+		PrevRandao:       e.PrevRandao,
+		BlockNumber:      e.BlockNumber,
+		GasLimit:         e.GasLimit,
+		GasUsed:          e.GasUsed,
+		Timestamp:        e.Timestamp,
+		ExtraData:        append([]byte{}, e.ExtraData...),
+		BaseFeePerGas:    e.BaseFeePerGas,
+		BlockHash:        e.BlockHash,
+		TransactionsRoot: txRoot,
+		WithdrawalRoot:   withdrawalRoot,
+		BlobGasUsed:      e.BlobGasUsed,
+		ExcessBlobGas:    e.ExcessBlobGas,
+	}
+	return header, nil
+}
+
+// transactionsRoot returns the hash tree root of the
+// ssz List[Transaction, MAX_TRANSACTIONS_PER_PAYLOAD] type
+func transactionsRoot(txs [][]byte) ([32]byte, error) {
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	num := uint64(len(txs))
+	if num > 1048576 {
+		return [32]byte{}, ssz.ErrIncorrectListSize
+	}
+	indx := hh.Index()
+	for _, elem := range txs {
+		elemIndx := hh.Index()
+		byteLen := uint64(len(elem))
+		if byteLen > 1073741824 {
+			return [32]byte{}, ssz.ErrIncorrectListSize
+		}
+		hh.AppendBytes32(elem)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+	}
+	hh.MerkleizeWithMixin(indx, num, 1048576)
+	return hh.HashRoot()
+}
+
+// withdrawalsRoot returns the hash tree root of the
+// ssz List[Withdrawal, MAX_WITHDRAWALS_PER_PAYLOAD] type
+func withdrawalsRoot(ws []*Withdrawal) ([32]byte, error) {
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+
+	num := uint64(len(ws))
+	if num > 16 {
+		return [32]byte{}, ssz.ErrIncorrectListSize
+	}
+	indx := hh.Index()
+	for _, elem := range ws {
+		if err := elem.HashTreeRootWith(hh); err != nil {
+			return [32]byte{}, err
+		}
+	}
+	hh.MerkleizeWithMixin(indx, num, 16)
+	return hh.HashRoot()
+}
@@ -0,0 +1,292 @@
+package consensus
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// GeneralizedIndex returns the generalized index of the value at the given
+// path inside the ssz object. The path is a list of json field names
+// separated by dots, with list and vector items accessed by index
+// (i.e. body.execution_payload.block_hash or validators[123].pubkey).
+// The special __len__ item references the length mixin of a list.
+func GeneralizedIndex(obj interface{}, path string) (uint64, error) {
+	typ, err := sszTypeOf(reflect.TypeOf(obj))
+	if err != nil {
+		return 0, err
+	}
+	elems, err := parsePath(path)
+	if err != nil {
+		return 0, err
+	}
+
+	root := uint64(1)
+	for _, elem := range elems {
+		if elem == "__len__" {
+			if typ == nil || !typ.isList() {
+				return 0, fmt.Errorf("'%s' is not a list", path)
+			}
+			root = root*2 + 1
+			typ = nil
+			continue
+		}
+		if typ == nil || typ.kind == sszBasic {
+			return 0, fmt.Errorf("path '%s' goes through a basic type", path)
+		}
+
+		var pos uint64
+		var next *sszType
+
+		if typ.kind == sszContainer {
+			field, indx, ok := typ.field(elem)
+			if !ok {
+				return 0, fmt.Errorf("field '%s' not found in path '%s'", elem, path)
+			}
+			pos, next = uint64(indx), field.typ
+		} else {
+			indx, err := strconv.ParseUint(elem, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("incorrect index '%s' in path '%s'", elem, path)
+			}
+
+			var length, elemSize uint64
+			switch typ.kind {
+			case sszBytes:
+				length, elemSize, next = typ.size, 1, &sszType{kind: sszBasic, size: 1}
+			case sszByteList:
+				length, elemSize, next = typ.limit, 1, &sszType{kind: sszBasic, size: 1}
+			case sszBitlist:
+				return 0, fmt.Errorf("cannot index bitlist in path '%s'", path)
+			case sszVector:
+				length, next = typ.size, typ.elem
+			case sszList:
+				length, next = typ.limit, typ.elem
+			}
+			if indx >= length {
+				return 0, fmt.Errorf("index %d out of bounds in path '%s'", indx, path)
+			}
+			if next.kind == sszBasic {
+				elemSize = next.size
+			}
+			if elemSize != 0 {
+				pos = indx * elemSize / 32
+			} else {
+				pos = indx
+			}
+		}
+
+		base := uint64(1)
+		if typ.isList() {
+			base = 2
+		}
+		root = root*base*nextPowerOfTwo(typ.chunkCount()) + pos
+		typ = next
+	}
+	return root, nil
+}
+
+func parsePath(path string) ([]string, error) {
+	if path == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+
+	res := []string{}
+	for _, part := range strings.Split(path, ".") {
+		name := part
+		if indx := strings.Index(part, "["); indx != -1 {
+			name = part[:indx]
+		}
+		if name != "" {
+			res = append(res, name)
+		}
+		part = part[len(name):]
+
+		for part != "" {
+			if part[0] != '[' {
+				return nil, fmt.Errorf("incorrect path '%s'", path)
+			}
+			end := strings.Index(part, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("incorrect path '%s'", path)
+			}
+			res = append(res, part[1:end])
+			part = part[end+1:]
+		}
+	}
+	return res, nil
+}
+
+// Prove returns a merkle proof for the value at the given path
+// in the ssz object.
+func Prove(obj ssz.HashRoot, path string) (*ssz.Proof, error) {
+	gindex, err := GeneralizedIndex(obj, path)
+	if err != nil {
+		return nil, err
+	}
+	node, err := obj.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	// populate the hashes of the intermediate nodes
+	node.Hash()
+
+	return node.Prove(int(gindex))
+}
+
+// ProveMulti returns a merkle multiproof for the values at the given paths
+// in the ssz object.
+func ProveMulti(obj ssz.HashRoot, paths ...string) (*ssz.Multiproof, error) {
+	indices := make([]int, len(paths))
+	for i, path := range paths {
+		gindex, err := GeneralizedIndex(obj, path)
+		if err != nil {
+			return nil, err
+		}
+		indices[i] = int(gindex)
+	}
+	node, err := obj.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	// populate the hashes of the intermediate nodes
+	node.Hash()
+
+	return node.ProveMulti(indices)
+}
+
+// VerifyProof verifies a merkle proof against the given root
+func VerifyProof(root [32]byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProof(root[:], proof)
+}
+
+// VerifyMultiproof verifies a merkle multiproof against the given root.
+// The hashes of the proof are expected in the order returned by ProveMulti.
+// Unlike ssz.VerifyMultiproof, it does not depend on the order in which
+// the intermediate nodes are computed.
+func VerifyMultiproof(root [32]byte, proof *ssz.Multiproof) (bool, error) {
+	if len(proof.Leaves) != len(proof.Indices) {
+		return false, fmt.Errorf("number of leaves %d and indices %d mismatch", len(proof.Leaves), len(proof.Indices))
+	}
+	required := multiproofHelperIndices(proof.Indices)
+	if len(required) != len(proof.Hashes) {
+		return false, fmt.Errorf("number of proof hashes %d and required indices %d mismatch", len(proof.Hashes), len(required))
+	}
+
+	db := map[int][]byte{}
+	maxIndex := 0
+	add := func(indx int, val []byte) error {
+		if indx < 1 {
+			return fmt.Errorf("incorrect index %d", indx)
+		}
+		if len(val) != 32 {
+			return fmt.Errorf("incorrect length %d for node %d", len(val), indx)
+		}
+		if _, ok := db[indx]; ok {
+			return fmt.Errorf("duplicated node %d", indx)
+		}
+		db[indx] = val
+		if indx > maxIndex {
+			maxIndex = indx
+		}
+		return nil
+	}
+	for i, indx := range proof.Indices {
+		if err := add(indx, proof.Leaves[i]); err != nil {
+			return false, err
+		}
+	}
+	for i, indx := range required {
+		if err := add(indx, proof.Hashes[i]); err != nil {
+			return false, err
+		}
+	}
+
+	// compute the root from the top. Every node in the proof must
+	// be used, otherwise a leaf could hide below a provided hash.
+	used := 0
+
+	var compute func(indx int) ([]byte, error)
+	compute = func(indx int) ([]byte, error) {
+		if val, ok := db[indx]; ok {
+			used++
+			return val, nil
+		}
+		if 2*indx > maxIndex {
+			return nil, fmt.Errorf("proof is missing node %d", indx)
+		}
+		left, err := compute(2 * indx)
+		if err != nil {
+			return nil, err
+		}
+		right, err := compute(2*indx + 1)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(append(append([]byte{}, left...), right...))
+		return hash[:], nil
+	}
+
+	res, err := compute(1)
+	if err != nil {
+		return false, err
+	}
+	if used != len(db) {
+		return false, fmt.Errorf("proof has unused nodes")
+	}
+	return bytes.Equal(res, root[:]), nil
+}
+
+// multiproofHelperIndices returns the generalized indices of the sibling
+// nodes required to verify a multiproof sorted in descending order
+// (get_helper_indices).
+func multiproofHelperIndices(indices []int) []int {
+	leaves := map[int]struct{}{}
+	for _, indx := range indices {
+		leaves[indx] = struct{}{}
+	}
+
+	required := map[int]struct{}{}
+	computed := map[int]struct{}{}
+	for _, indx := range indices {
+		for cur := indx; cur > 1; cur /= 2 {
+			required[cur^1] = struct{}{}
+			computed[cur/2] = struct{}{}
+		}
+	}
+
+	res := []int{}
+	for indx := range required {
+		_, isComputed := computed[indx]
+		_, isLeaf := leaves[indx]
+		if !isComputed && !isLeaf {
+			res = append(res, indx)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(res)))
+	return res
+}
+
+// VerifyMerkleBranch checks that the leaf at the given index and depth
+// is part of the tree with the given root (is_valid_merkle_branch).
+func VerifyMerkleBranch(leaf [32]byte, branch [][32]byte, depth uint64, index uint64, root [32]byte) bool {
+	if uint64(len(branch)) < depth {
+		return false
+	}
+
+	value := leaf
+	for i := uint64(0); i < depth; i++ {
+		if (index>>i)&1 == 1 {
+			value = sha256.Sum256(append(branch[i][:], value[:]...))
+		} else {
+			value = sha256.Sum256(append(value[:], branch[i][:]...))
+		}
+	}
+	return value == root
+}
@@ -0,0 +1,186 @@
+package consensus
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testProofState() *BeaconStateDeneb {
+	committee := func() *SyncCommittee {
+		return &SyncCommittee{}
+	}
+	state := &BeaconStateDeneb{
+		Slot:                         100,
+		Slashings:                    make([]uint64, 8192),
+		HistoricalRoots:              [][]byte{make([]byte, 32)},
+		FinalizedCheckpoint:          &Checkpoint{Epoch: 5, Root: Root{0x1, 0x2}},
+		CurrentSyncCommittee:         committee(),
+		NextSyncCommittee:            committee(),
+		LatestExecutionPayloadHeader: &ExecutionPayloadHeaderDeneb{BlockHash: [32]byte{0x3}},
+	}
+	for i := 0; i < 10; i++ {
+		state.Validators = append(state.Validators, &Validator{EffectiveBalance: uint64(i)})
+		state.Balances = append(state.Balances, uint64(i))
+	}
+	return state
+}
+
+func TestGeneralizedIndex(t *testing.T) {
+	cases := []struct {
+		obj    interface{}
+		path   string
+		gindex uint64
+	}{
+		// light client gindices from the altair and electra specs
+		{&BeaconStateAltair{}, "finalized_checkpoint.root", 105},
+		{&BeaconStateAltair{}, "current_sync_committee", 54},
+		{&BeaconStateAltair{}, "next_sync_committee", 55},
+		{&BeaconStateElectra{}, "finalized_checkpoint.root", 169},
+		{&BeaconStateElectra{}, "current_sync_committee", 86},
+		{&BeaconStateElectra{}, "next_sync_committee", 87},
+		{&BeaconBlockBodyCapella{}, "execution_payload", 25},
+		// kzg commitment inclusion proof (depth 17)
+		{&BeaconBlockBodyDeneb{}, "blob_kzg_commitments[0]", 221184},
+		{&BeaconStateAltair{}, "validators", 43},
+		{&BeaconStateAltair{}, "validators.__len__", 87},
+		{&BeaconStateAltair{}, "validators[0]", 86 << 40},
+		{&BeaconStateAltair{}, "balances[5]", 88<<38 + 1},
+		{&BeaconStateAltair{}, "block_roots[3]", 37<<13 + 3},
+	}
+	for _, c := range cases {
+		gindex, err := GeneralizedIndex(c.obj, c.path)
+		require.NoError(t, err, c.path)
+		assert.Equal(t, c.gindex, gindex, c.path)
+	}
+
+	for _, path := range []string{"", "unknown", "slot.a", "block_roots[8192]", "validators[a]", "slot.__len__", "validators.__len__.__len__"} {
+		_, err := GeneralizedIndex(&BeaconStateAltair{}, path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestProve(t *testing.T) {
+	state := testProofState()
+
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := Prove(state, "finalized_checkpoint.root")
+	require.NoError(t, err)
+	assert.Equal(t, 105, proof.Index)
+	assert.Equal(t, state.FinalizedCheckpoint.Root[:], proof.Leaf)
+
+	ok, err := VerifyProof(root, proof)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// the same proof as an spec merkle branch
+	var leaf [32]byte
+	copy(leaf[:], proof.Leaf)
+
+	branch := make([][32]byte, len(proof.Hashes))
+	for i, hash := range proof.Hashes {
+		copy(branch[i][:], hash)
+	}
+	assert.True(t, VerifyMerkleBranch(leaf, branch, 6, 105%64, root))
+	assert.False(t, VerifyMerkleBranch(leaf, branch, 6, 104%64, root))
+	assert.False(t, VerifyMerkleBranch(leaf, branch[:5], 6, 105%64, root))
+
+	// proof of a validator
+	proof, err = Prove(state, "validators[3]")
+	require.NoError(t, err)
+
+	valRoot, err := state.Validators[3].HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, valRoot[:], proof.Leaf)
+
+	ok, err = VerifyProof(root, proof)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// proof of a packed balance (4 balances per leaf)
+	proof, err = Prove(state, "balances[5]")
+	require.NoError(t, err)
+	assert.Equal(t, state.Balances[5], binary.LittleEndian.Uint64(proof.Leaf[8:16]))
+
+	ok, err = VerifyProof(root, proof)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// proof of the length of a list
+	proof, err = Prove(state, "validators.__len__")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), binary.LittleEndian.Uint64(proof.Leaf[:8]))
+
+	ok, err = VerifyProof(root, proof)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// a modified leaf does not verify
+	proof, err = Prove(state, "latest_execution_payload_header.block_hash")
+	require.NoError(t, err)
+	assert.Equal(t, state.LatestExecutionPayloadHeader.BlockHash[:], proof.Leaf)
+
+	proof.Leaf = make([]byte, 32)
+	ok, err = VerifyProof(root, proof)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestProve_Block(t *testing.T) {
+	block := &BeaconBlockDeneb{
+		Slot: 10,
+		Body: &BeaconBlockBodyDeneb{
+			ExecutionPayload:   &ExecutionPayloadDeneb{BlockHash: [32]byte{0x1}},
+			BlobKzgCommitments: [][48]byte{{0x1}, {0x2}},
+		},
+	}
+	root, err := block.HashTreeRoot()
+	require.NoError(t, err)
+
+	for _, path := range []string{"body.execution_payload.block_hash", "body.blob_kzg_commitments[1]", "slot"} {
+		proof, err := Prove(block, path)
+		require.NoError(t, err)
+
+		ok, err := VerifyProof(root, proof)
+		require.NoError(t, err)
+		assert.True(t, ok, path)
+	}
+}
+
+func TestProveMulti(t *testing.T) {
+	state := testProofState()
+
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := ProveMulti(state, "finalized_checkpoint.root", "slot", "validators[1]", "validators[2]")
+	require.NoError(t, err)
+
+	ok, err := VerifyMultiproof(root, proof)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	proof.Leaves[0] = make([]byte, 32)
+	ok, err = VerifyMultiproof(root, proof)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestVerifyMultiproof_UnusedNodes(t *testing.T) {
+	state := testProofState()
+
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	// the root of the checkpoint is below the checkpoint
+	// and it is never checked
+	proof, err := ProveMulti(state, "finalized_checkpoint", "finalized_checkpoint.root")
+	require.NoError(t, err)
+
+	_, err = VerifyMultiproof(root, proof)
+	require.Error(t, err)
+}
@@ -0,0 +1,216 @@
+package consensus
+
+import (
+	"fmt"
+)
+
+// ForkName is the name of a consensus layer fork.
+type ForkName string
+
+const (
+	ForkPhase0    ForkName = "phase0"
+	ForkAltair    ForkName = "altair"
+	ForkBellatrix ForkName = "bellatrix"
+	ForkCapella   ForkName = "capella"
+	ForkDeneb     ForkName = "deneb"
+	ForkElectra   ForkName = "electra"
+)
+
+// Forks is the list of known forks in activation order
+var Forks = []ForkName{
+	ForkPhase0,
+	ForkAltair,
+	ForkBellatrix,
+	ForkCapella,
+	ForkDeneb,
+	ForkElectra,
+}
+
+type forkEntry struct {
+	name    ForkName
+	version Domain
+	epoch   uint64
+}
+
+// forkSchedule returns the version and activation epoch of every fork in activation order.
+// Forks that are not scheduled are expected to have FAR_FUTURE_EPOCH as activation epoch.
+// The forks after genesis without a version (i.e. the spec of a node that does not know
+// about the fork) are skipped, otherwise their zero activation epoch makes them active
+// from genesis.
+func (s *Spec) forkSchedule() []forkEntry {
+	forks := []forkEntry{
+		{ForkAltair, s.AltairForkVersion, s.AltairForkEpoch},
+		{ForkBellatrix, s.BellatrixForkVersion, s.BellatrixForkEpoch},
+		{ForkCapella, s.CapellaForkVersion, s.CapellaForkEpoch},
+		{ForkDeneb, s.DenebForkVersion, s.DenebForkEpoch},
+		{ForkElectra, s.ElectraForkVersion, s.ElectraForkEpoch},
+	}
+
+	schedule := []forkEntry{
+		{ForkPhase0, s.GenesisForkVersion, s.GenesisEpoch},
+	}
+	for _, fork := range forks {
+		if fork.version != (Domain{}) {
+			schedule = append(schedule, fork)
+		}
+	}
+	return schedule
+}
+
+// ForkNameAtEpoch returns the name of the fork active at the given epoch
+func (s *Spec) ForkNameAtEpoch(epoch uint64) ForkName {
+	name := ForkPhase0
+	for _, fork := range s.forkSchedule() {
+		if epoch >= fork.epoch {
+			name = fork.name
+		}
+	}
+	return name
+}
+
+// ForkNameAtSlot returns the name of the fork active at the given slot
+func (s *Spec) ForkNameAtSlot(slot uint64) (ForkName, error) {
+	if s.SlotsPerEpoch == 0 {
+		return "", fmt.Errorf("slots per epoch not set")
+	}
+	return s.ForkNameAtEpoch(slot / s.SlotsPerEpoch), nil
+}
+
+// ForkNameFromVersion returns the name of the fork with the given fork version
+func (s *Spec) ForkNameFromVersion(version Domain) (ForkName, error) {
+	// iterate in reverse order so that a version shared by several
+	// forks (i.e. a devnet that starts at a later fork) resolves to the latest one
+	schedule := s.forkSchedule()
+	for i := len(schedule) - 1; i >= 0; i-- {
+		if schedule[i].version == version {
+			return schedule[i].name, nil
+		}
+	}
+	return "", fmt.Errorf("fork version %x not found", version)
+}
+
+type forkTypes struct {
+	signedBeaconBlock  func() SignedBeaconBlock
+	beaconState        func() BeaconState
+	signedBlindedBlock func() SignedBlindedBlock
+}
+
+var registry = map[ForkName]*forkTypes{
+	ForkPhase0: {
+		signedBeaconBlock: func() SignedBeaconBlock { return new(SignedBeaconBlockPhase0) },
+		beaconState:       func() BeaconState { return new(BeaconStatePhase0) },
+	},
+	ForkAltair: {
+		signedBeaconBlock: func() SignedBeaconBlock { return new(SignedBeaconBlockAltair) },
+		beaconState:       func() BeaconState { return new(BeaconStateAltair) },
+	},
+	ForkBellatrix: {
+		signedBeaconBlock:  func() SignedBeaconBlock { return new(SignedBeaconBlockBellatrix) },
+		beaconState:        func() BeaconState { return new(BeaconStateBellatrix) },
+		signedBlindedBlock: func() SignedBlindedBlock { return new(SignedBlindedBeaconBlock) },
+	},
+	ForkCapella: {
+		signedBeaconBlock:  func() SignedBeaconBlock { return new(SignedBeaconBlockCapella) },
+		beaconState:        func() BeaconState { return new(BeaconStateCapella) },
+		signedBlindedBlock: func() SignedBlindedBlock { return new(SignedBlindedBeaconBlockCapella) },
+	},
+	ForkDeneb: {
+		signedBeaconBlock:  func() SignedBeaconBlock { return new(SignedBeaconBlockDeneb) },
+		beaconState:        func() BeaconState { return new(BeaconStateDeneb) },
+		signedBlindedBlock: func() SignedBlindedBlock { return new(SignedBlindedBeaconBlockDeneb) },
+	},
+	ForkElectra: {
+		signedBeaconBlock:  func() SignedBeaconBlock { return new(SignedBeaconBlockElectra) },
+		beaconState:        func() BeaconState { return new(BeaconStateElectra) },
+		signedBlindedBlock: func() SignedBlindedBlock { return new(SignedBlindedBeaconBlockElectra) },
+	},
+}
+
+func getForkTypes(fork ForkName) (*forkTypes, error) {
+	types, ok := registry[fork]
+	if !ok {
+		return nil, fmt.Errorf("fork '%s' not found", fork)
+	}
+	return types, nil
+}
+
+// NewSignedBeaconBlock returns an empty signed beacon block for the given fork
+func NewSignedBeaconBlock(fork ForkName) (SignedBeaconBlock, error) {
+	types, err := getForkTypes(fork)
+	if err != nil {
+		return nil, err
+	}
+	return types.signedBeaconBlock(), nil
+}
+
+// NewBeaconState returns an empty beacon state for the given fork
+func NewBeaconState(fork ForkName) (BeaconState, error) {
+	types, err := getForkTypes(fork)
+	if err != nil {
+		return nil, err
+	}
+	return types.beaconState(), nil
+}
+
+// NewSignedBlindedBlock returns an empty signed blinded beacon block for the given fork
+func NewSignedBlindedBlock(fork ForkName) (SignedBlindedBlock, error) {
+	types, err := getForkTypes(fork)
+	if err != nil {
+		return nil, err
+	}
+	if types.signedBlindedBlock == nil {
+		return nil, fmt.Errorf("fork '%s' does not have blinded blocks", fork)
+	}
+	return types.signedBlindedBlock(), nil
+}
+
+// DecodeSignedBeaconBlock decodes an ssz signed beacon block using
+// the type of the fork active at the given slot
+func DecodeSignedBeaconBlock(spec *Spec, slot uint64, buf []byte) (SignedBeaconBlock, error) {
+	fork, err := spec.ForkNameAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	block, err := NewSignedBeaconBlock(fork)
+	if err != nil {
+		return nil, err
+	}
+	if err := block.UnmarshalSSZ(buf); err != nil {
+		return nil, fmt.Errorf("failed to decode %s block: %v", fork, err)
+	}
+	return block, nil
+}
+
+// DecodeBeaconState decodes an ssz beacon state using
+// the type of the fork active at the given slot
+func DecodeBeaconState(spec *Spec, slot uint64, buf []byte) (BeaconState, error) {
+	fork, err := spec.ForkNameAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	state, err := NewBeaconState(fork)
+	if err != nil {
+		return nil, err
+	}
+	if err := state.UnmarshalSSZ(buf); err != nil {
+		return nil, fmt.Errorf("failed to decode %s state: %v", fork, err)
+	}
+	return state, nil
+}
+
+// DecodeBlindedBlock decodes an ssz signed blinded beacon block using
+// the type of the fork active at the given slot
+func DecodeBlindedBlock(spec *Spec, slot uint64, buf []byte) (SignedBlindedBlock, error) {
+	fork, err := spec.ForkNameAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	block, err := NewSignedBlindedBlock(fork)
+	if err != nil {
+		return nil, err
+	}
+	if err := block.UnmarshalSSZ(buf); err != nil {
+		return nil, fmt.Errorf("failed to decode %s blinded block: %v", fork, err)
+	}
+	return block, nil
+}
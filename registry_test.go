@@ -0,0 +1,137 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const farFutureEpoch = 18446744073709551615
+
+func testRegistrySpec() *Spec {
+	return &Spec{
+		SlotsPerEpoch:        32,
+		GenesisForkVersion:   Domain{0, 0, 0, 0},
+		AltairForkVersion:    Domain{1, 0, 0, 0},
+		AltairForkEpoch:      10,
+		BellatrixForkVersion: Domain{2, 0, 0, 0},
+		BellatrixForkEpoch:   20,
+		CapellaForkVersion:   Domain{3, 0, 0, 0},
+		CapellaForkEpoch:     30,
+		DenebForkVersion:     Domain{4, 0, 0, 0},
+		DenebForkEpoch:       40,
+		ElectraForkVersion:   Domain{5, 0, 0, 0},
+		ElectraForkEpoch:     farFutureEpoch,
+	}
+}
+
+func TestRegistry_ForkName(t *testing.T) {
+	spec := testRegistrySpec()
+
+	cases := []struct {
+		epoch uint64
+		fork  ForkName
+	}{
+		{0, ForkPhase0},
+		{9, ForkPhase0},
+		{10, ForkAltair},
+		{25, ForkBellatrix},
+		{30, ForkCapella},
+		{1000, ForkDeneb},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.fork, spec.ForkNameAtEpoch(c.epoch))
+	}
+
+	fork, err := spec.ForkNameFromVersion(Domain{3, 0, 0, 0})
+	require.NoError(t, err)
+	assert.Equal(t, ForkCapella, fork)
+
+	_, err = spec.ForkNameFromVersion(Domain{9, 9, 9, 9})
+	assert.Error(t, err)
+}
+
+func TestRegistry_Decode(t *testing.T) {
+	spec := testRegistrySpec()
+
+	block := &SignedBeaconBlockCapella{
+		Block: &BeaconBlockCapella{
+			Slot: 30 * 32,
+			Body: &BeaconBlockBodyCapella{},
+		},
+	}
+	buf, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	found, err := DecodeSignedBeaconBlock(spec, block.Block.Slot, buf)
+	require.NoError(t, err)
+	assert.IsType(t, &SignedBeaconBlockCapella{}, found)
+	assert.Equal(t, block.Block.Slot, found.(*SignedBeaconBlockCapella).Block.Slot)
+
+	// the encoding is not valid for a phase0 block
+	_, err = DecodeSignedBeaconBlock(spec, 0, buf)
+	assert.Error(t, err)
+
+	state := &BeaconStateAltair{
+		Slot:      10 * 32,
+		Slashings: make([]uint64, 8192),
+	}
+	buf, err = state.MarshalSSZ()
+	require.NoError(t, err)
+
+	foundState, err := DecodeBeaconState(spec, state.Slot, buf)
+	require.NoError(t, err)
+	assert.IsType(t, &BeaconStateAltair{}, foundState)
+
+	blinded := &SignedBlindedBeaconBlock{
+		Block: &BlindedBeaconBlock{
+			Slot: 20 * 32,
+		},
+	}
+	buf, err = blinded.MarshalSSZ()
+	require.NoError(t, err)
+
+	foundBlinded, err := DecodeBlindedBlock(spec, blinded.Block.Slot, buf)
+	require.NoError(t, err)
+	assert.IsType(t, &SignedBlindedBeaconBlock{}, foundBlinded)
+
+	// phase0 does not have blinded blocks
+	_, err = DecodeBlindedBlock(spec, 0, buf)
+	assert.Error(t, err)
+
+	blindedCapella := &SignedBlindedBeaconBlockCapella{
+		Block: &BlindedBeaconBlockCapella{
+			Slot: 30 * 32,
+		},
+	}
+	buf, err = blindedCapella.MarshalSSZ()
+	require.NoError(t, err)
+
+	foundBlinded, err = DecodeBlindedBlock(spec, blindedCapella.Block.Slot, buf)
+	require.NoError(t, err)
+	assert.IsType(t, &SignedBlindedBeaconBlockCapella{}, foundBlinded)
+}
+
+func TestRegistry_PartialSpec(t *testing.T) {
+	// the spec of a node that does not know about electra
+	spec := testRegistrySpec()
+	spec.ElectraForkVersion = Domain{}
+	spec.ElectraForkEpoch = 0
+
+	assert.Equal(t, ForkPhase0, spec.ForkNameAtEpoch(5))
+	assert.Equal(t, ForkAltair, spec.ForkNameAtEpoch(10))
+	assert.Equal(t, ForkDeneb, spec.ForkNameAtEpoch(300000))
+
+	assert.Equal(t, Domain{0, 0, 0, 0}, spec.ForkVersionAtEpoch(5))
+	assert.Equal(t, Domain{4, 0, 0, 0}, spec.ForkVersionAtEpoch(300000))
+	assert.Equal(t, uint64(farFutureEpoch), spec.NextForkEpoch(40))
+
+	fork, err := spec.ForkNameFromVersion(Domain{0, 0, 0, 0})
+	require.NoError(t, err)
+	assert.Equal(t, ForkPhase0, fork)
+
+	// none of the forks after genesis are set
+	spec = &Spec{SlotsPerEpoch: 32}
+	assert.Equal(t, ForkPhase0, spec.ForkNameAtEpoch(300000))
+}
@@ -0,0 +1,244 @@
+package consensus
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// sszKind is the kind of an ssz type
+type sszKind int
+
+const (
+	// sszBasic is an uint or bool value
+	sszBasic sszKind = iota
+
+	// sszBytes is a fixed size vector of bytes
+	sszBytes
+
+	// sszByteList is a variable size list of bytes
+	sszByteList
+
+	// sszBitlist is a variable size list of bits
+	sszBitlist
+
+	// sszVector is a fixed size vector of non byte elements
+	sszVector
+
+	// sszList is a variable size list of non byte elements
+	sszList
+
+	// sszContainer is a struct
+	sszContainer
+)
+
+// sszType is the ssz schema of a go type as described by the
+// sszgen tags (ssz-size, ssz-max and ssz).
+type sszType struct {
+	kind sszKind
+
+	// size is the size in bytes for basic types and
+	// the number of elements for vectors
+	size uint64
+
+	// limit is the maximum number of elements for lists
+	limit uint64
+
+	// elem is the type of the elements of vectors and lists
+	elem *sszType
+
+	// fields are the fields of a container
+	fields []*sszField
+}
+
+type sszField struct {
+	name  string
+	index int
+	typ   *sszType
+}
+
+var sszTypeCache sync.Map
+
+// sszTypeOf returns the ssz schema of the given go type
+func sszTypeOf(typ reflect.Type) (*sszType, error) {
+	if res, ok := sszTypeCache.Load(typ); ok {
+		return res.(*sszType), nil
+	}
+	res, err := parseSSZType(typ, nil, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	sszTypeCache.Store(typ, res)
+	return res, nil
+}
+
+func parseSSZType(typ reflect.Type, sizes, maxes []string, bitlist bool) (*sszType, error) {
+	// pop the first dimension of the tags
+	var size, max string
+	if len(sizes) != 0 {
+		size, sizes = sizes[0], sizes[1:]
+	}
+	if len(maxes) != 0 {
+		max, maxes = maxes[0], maxes[1:]
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return parseSSZType(typ.Elem(), append([]string{size}, sizes...), append([]string{max}, maxes...), bitlist)
+
+	case reflect.Bool, reflect.Uint8:
+		return &sszType{kind: sszBasic, size: 1}, nil
+
+	case reflect.Uint16:
+		return &sszType{kind: sszBasic, size: 2}, nil
+
+	case reflect.Uint32:
+		return &sszType{kind: sszBasic, size: 4}, nil
+
+	case reflect.Uint64:
+		return &sszType{kind: sszBasic, size: 8}, nil
+
+	case reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return &sszType{kind: sszBytes, size: uint64(typ.Len())}, nil
+		}
+		elem, err := parseSSZType(typ.Elem(), sizes, maxes, false)
+		if err != nil {
+			return nil, err
+		}
+		return &sszType{kind: sszVector, size: uint64(typ.Len()), elem: elem}, nil
+
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			if bitlist {
+				limit, err := parseTagNum(max)
+				if err != nil {
+					return nil, err
+				}
+				return &sszType{kind: sszBitlist, limit: limit}, nil
+			}
+			if size != "" && size != "?" {
+				num, err := parseTagNum(size)
+				if err != nil {
+					return nil, err
+				}
+				return &sszType{kind: sszBytes, size: num}, nil
+			}
+			limit, err := parseTagNum(max)
+			if err != nil {
+				return nil, err
+			}
+			return &sszType{kind: sszByteList, limit: limit}, nil
+		}
+
+		elem, err := parseSSZType(typ.Elem(), sizes, maxes, false)
+		if err != nil {
+			return nil, err
+		}
+		if size != "" && size != "?" {
+			num, err := parseTagNum(size)
+			if err != nil {
+				return nil, err
+			}
+			return &sszType{kind: sszVector, size: num, elem: elem}, nil
+		}
+		limit, err := parseTagNum(max)
+		if err != nil {
+			return nil, err
+		}
+		return &sszType{kind: sszList, limit: limit, elem: elem}, nil
+
+	case reflect.Struct:
+		res := &sszType{kind: sszContainer}
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if field.Tag.Get("ssz") == "-" {
+				continue
+			}
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "" {
+				name = field.Name
+			}
+
+			fieldSizes, fieldMaxes := splitTag(field.Tag.Get("ssz-size")), splitTag(field.Tag.Get("ssz-max"))
+			fieldTyp, err := parseSSZType(field.Type, fieldSizes, fieldMaxes, field.Tag.Get("ssz") == "bitlist")
+			if err != nil {
+				return nil, fmt.Errorf("field '%s': %v", field.Name, err)
+			}
+			res.fields = append(res.fields, &sszField{name: name, index: i, typ: fieldTyp})
+		}
+		return res, nil
+
+	default:
+		return nil, fmt.Errorf("type %s not supported", typ)
+	}
+}
+
+func splitTag(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
+
+func parseTagNum(tag string) (uint64, error) {
+	if tag == "" || tag == "?" {
+		return 0, fmt.Errorf("size not found")
+	}
+	return strconv.ParseUint(tag, 10, 64)
+}
+
+// isList returns whether the type has a length mixin
+func (s *sszType) isList() bool {
+	return s.kind == sszList || s.kind == sszByteList || s.kind == sszBitlist
+}
+
+// chunkCount returns the number of leaves of the merkle tree of the type
+// (without the length mixin)
+func (s *sszType) chunkCount() uint64 {
+	switch s.kind {
+	case sszBytes:
+		return (s.size + 31) / 32
+	case sszByteList:
+		return (s.limit + 31) / 32
+	case sszBitlist:
+		return (s.limit + 255) / 256
+	case sszVector:
+		if s.elem.kind == sszBasic {
+			return (s.size*s.elem.size + 31) / 32
+		}
+		return s.size
+	case sszList:
+		if s.elem.kind == sszBasic {
+			return (s.limit*s.elem.size + 31) / 32
+		}
+		return s.limit
+	case sszContainer:
+		return uint64(len(s.fields))
+	default:
+		return 1
+	}
+}
+
+// field returns the field of a container by name
+func (s *sszType) field(name string) (*sszField, int, bool) {
+	for indx, f := range s.fields {
+		if f.name == name {
+			return f, indx, true
+		}
+	}
+	return nil, 0, false
+}
+
+func nextPowerOfTwo(v uint64) uint64 {
+	if v <= 1 {
+		return 1
+	}
+	res := uint64(1)
+	for res < v {
+		res <<= 1
+	}
+	return res
+}
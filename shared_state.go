@@ -0,0 +1,356 @@
+package consensus
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// sharedChunkSize is the number of elements in each chunk of a shared list
+const sharedChunkSize = 512
+
+type sharedChunk[T comparable] struct {
+	// owner is the list that can modify the chunk in place
+	owner *byte
+	elems []T
+}
+
+// sharedList is a list split in chunks that are shared between
+// the copies of the list. A chunk is cloned the first time that
+// a copy modifies it.
+type sharedList[T comparable] struct {
+	owner  *byte
+	chunks []*sharedChunk[T]
+	length int
+}
+
+func newSharedList[T comparable](elems []T) *sharedList[T] {
+	l := &sharedList[T]{owner: new(byte)}
+	l.update(elems)
+	return l
+}
+
+func (l *sharedList[T]) len() int {
+	return l.length
+}
+
+func (l *sharedList[T]) get(i int) T {
+	return l.chunks[i/sharedChunkSize].elems[i%sharedChunkSize]
+}
+
+func (l *sharedList[T]) set(i int, val T) {
+	if i >= l.length {
+		panic(fmt.Sprintf("index %d out of range with length %d", i, l.length))
+	}
+	l.writable(i / sharedChunkSize).elems[i%sharedChunkSize] = val
+}
+
+func (l *sharedList[T]) append(val T) {
+	if l.length%sharedChunkSize == 0 {
+		l.chunks = append(l.chunks, &sharedChunk[T]{owner: l.owner, elems: make([]T, 0, sharedChunkSize)})
+	}
+	chunk := l.writable(len(l.chunks) - 1)
+	chunk.elems = append(chunk.elems, val)
+	l.length++
+}
+
+// writable returns the chunk at the given index and clones it
+// if it is shared with other lists
+func (l *sharedList[T]) writable(indx int) *sharedChunk[T] {
+	chunk := l.chunks[indx]
+	if chunk.owner != l.owner {
+		elems := make([]T, len(chunk.elems), sharedChunkSize)
+		copy(elems, chunk.elems)
+		chunk = &sharedChunk[T]{owner: l.owner, elems: elems}
+		l.chunks[indx] = chunk
+	}
+	return chunk
+}
+
+// copy returns a copy of the list that shares all the chunks
+func (l *sharedList[T]) copy() *sharedList[T] {
+	// after the copy, none of the lists owns the chunks
+	l.owner = new(byte)
+
+	chunks := make([]*sharedChunk[T], len(l.chunks))
+	copy(chunks, l.chunks)
+	return &sharedList[T]{owner: new(byte), chunks: chunks, length: l.length}
+}
+
+// slice returns the elements of the list
+func (l *sharedList[T]) slice() []T {
+	res := make([]T, 0, l.length)
+	for _, chunk := range l.chunks {
+		res = append(res, chunk.elems...)
+	}
+	return res
+}
+
+// update replaces the elements of the list. The chunks whose
+// elements did not change are kept (and shared).
+func (l *sharedList[T]) update(elems []T) {
+	num := (len(elems) + sharedChunkSize - 1) / sharedChunkSize
+
+	chunks := make([]*sharedChunk[T], num)
+	for i := range chunks {
+		end := (i + 1) * sharedChunkSize
+		if end > len(elems) {
+			end = len(elems)
+		}
+		vals := elems[i*sharedChunkSize : end]
+
+		if i < len(l.chunks) && equalElems(l.chunks[i].elems, vals) {
+			chunks[i] = l.chunks[i]
+			continue
+		}
+		chunk := &sharedChunk[T]{owner: l.owner, elems: make([]T, len(vals), sharedChunkSize)}
+		copy(chunk.elems, vals)
+		chunks[i] = chunk
+	}
+	l.chunks = chunks
+	l.length = len(elems)
+}
+
+func equalElems[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SharedBeaconState is a beacon state whose copies share the memory of
+// the validators, the balances, the participation and inactivity lists
+// and the block, state and randao vectors. The shared memory is split in
+// chunks and a chunk is only cloned when a copy modifies it.
+// The state of any fork can be converted to and from a SharedBeaconState.
+// It is not safe for concurrent use, including Copy.
+type SharedBeaconState struct {
+	// base are the fields of the state that are not in the shared lists.
+	// It is immutable and shared between the copies, Update replaces it
+	// and State returns a deep copy.
+	base BeaconState
+
+	blockRoots  *sharedList[[32]byte]
+	stateRoots  *sharedList[[32]byte]
+	randaoMixes *sharedList[[32]byte]
+	validators  *sharedList[Validator]
+	balances    *sharedList[uint64]
+
+	// only after Altair
+	previousEpochParticipation *sharedList[byte]
+	currentEpochParticipation  *sharedList[byte]
+	inactivityScores           *sharedList[uint64]
+}
+
+// NewSharedBeaconState creates a shared beacon state from a copy of the state
+func NewSharedBeaconState(state BeaconState) (*SharedBeaconState, error) {
+	s := &SharedBeaconState{}
+	if err := s.Update(state); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Update replaces the content of the shared state with a copy of the
+// state (i.e. the state after a state transition). The chunks that did
+// not change are still shared with the other copies.
+func (s *SharedBeaconState) Update(state BeaconState) error {
+	if isNil(state) {
+		return fmt.Errorf("empty state")
+	}
+	if s.base != nil && reflect.TypeOf(s.base) != reflect.TypeOf(state) {
+		// reset the shared lists if the fork changes
+		*s = SharedBeaconState{}
+	}
+
+	obj := reflect.ValueOf(state).Elem()
+
+	validatorsField := obj.FieldByName("Validators")
+	validators := make([]Validator, validatorsField.Len())
+	for i := range validators {
+		if val := validatorsField.Index(i); !val.IsNil() {
+			validators[i] = *val.Interface().(*Validator)
+		}
+	}
+
+	updateList(&s.blockRoots, bytes32Elems(obj.FieldByName("BlockRoots")))
+	updateList(&s.stateRoots, bytes32Elems(obj.FieldByName("StateRoots")))
+	updateList(&s.randaoMixes, bytes32Elems(obj.FieldByName("RandaoMixes")))
+	updateList(&s.validators, validators)
+	updateList(&s.balances, obj.FieldByName("Balances").Interface().([]uint64))
+
+	if field := obj.FieldByName("InactivityScores"); field.IsValid() {
+		updateList(&s.previousEpochParticipation, obj.FieldByName("PreviousEpochParticipation").Bytes())
+		updateList(&s.currentEpochParticipation, obj.FieldByName("CurrentEpochParticipation").Bytes())
+		updateList(&s.inactivityScores, field.Interface().([]uint64))
+	}
+
+	// copy the rest of the fields without the shared ones
+	base := reflect.New(obj.Type())
+	for i := 0; i < obj.NumField(); i++ {
+		if isSharedField(obj.Type().Field(i).Name) {
+			continue
+		}
+		base.Elem().Field(i).Set(obj.Field(i))
+	}
+	s.base = copyState(base.Interface().(BeaconState))
+	return nil
+}
+
+// copyState returns a deep copy of the state
+func copyState(state BeaconState) BeaconState {
+	switch obj := state.(type) {
+	case *BeaconStatePhase0:
+		return obj.Copy()
+	case *BeaconStateAltair:
+		return obj.Copy()
+	case *BeaconStateBellatrix:
+		return obj.Copy()
+	case *BeaconStateCapella:
+		return obj.Copy()
+	case *BeaconStateDeneb:
+		return obj.Copy()
+	case *BeaconStateElectra:
+		return obj.Copy()
+	default:
+		panic(errUnknownType(state))
+	}
+}
+
+func updateList[T comparable](l **sharedList[T], elems []T) {
+	if *l == nil {
+		*l = newSharedList(elems)
+	} else {
+		(*l).update(elems)
+	}
+}
+
+func bytes32Elems(v reflect.Value) [][32]byte {
+	res := make([][32]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(res), v)
+	return res
+}
+
+func isSharedField(name string) bool {
+	switch name {
+	case "BlockRoots", "StateRoots", "RandaoMixes", "Validators", "Balances",
+		"PreviousEpochParticipation", "CurrentEpochParticipation", "InactivityScores":
+		return true
+	default:
+		return false
+	}
+}
+
+// Copy returns a copy of the state that shares the memory with the original state
+func (s *SharedBeaconState) Copy() *SharedBeaconState {
+	res := &SharedBeaconState{
+		base:        s.base,
+		blockRoots:  s.blockRoots.copy(),
+		stateRoots:  s.stateRoots.copy(),
+		randaoMixes: s.randaoMixes.copy(),
+		validators:  s.validators.copy(),
+		balances:    s.balances.copy(),
+	}
+	if s.inactivityScores != nil {
+		res.previousEpochParticipation = s.previousEpochParticipation.copy()
+		res.currentEpochParticipation = s.currentEpochParticipation.copy()
+		res.inactivityScores = s.inactivityScores.copy()
+	}
+	return res
+}
+
+// State returns the beacon state. The returned state does not share
+// any memory with the shared state and it can be modified.
+func (s *SharedBeaconState) State() BeaconState {
+	obj := reflect.ValueOf(copyState(s.base)).Elem()
+
+	reflect.Copy(obj.FieldByName("BlockRoots"), reflect.ValueOf(s.blockRoots.slice()))
+	reflect.Copy(obj.FieldByName("StateRoots"), reflect.ValueOf(s.stateRoots.slice()))
+	reflect.Copy(obj.FieldByName("RandaoMixes"), reflect.ValueOf(s.randaoMixes.slice()))
+
+	validators := s.validators.slice()
+	validatorsPtr := make([]*Validator, len(validators))
+	for i := range validators {
+		validatorsPtr[i] = &validators[i]
+	}
+	obj.FieldByName("Validators").Set(reflect.ValueOf(validatorsPtr))
+	obj.FieldByName("Balances").Set(reflect.ValueOf(s.balances.slice()))
+
+	if s.inactivityScores != nil {
+		obj.FieldByName("PreviousEpochParticipation").SetBytes(s.previousEpochParticipation.slice())
+		obj.FieldByName("CurrentEpochParticipation").SetBytes(s.currentEpochParticipation.slice())
+		obj.FieldByName("InactivityScores").Set(reflect.ValueOf(s.inactivityScores.slice()))
+	}
+	return obj.Addr().Interface().(BeaconState)
+}
+
+// NumValidators returns the number of validators
+func (s *SharedBeaconState) NumValidators() int {
+	return s.validators.len()
+}
+
+// Validator returns a copy of the validator at the given index
+func (s *SharedBeaconState) Validator(i int) Validator {
+	return s.validators.get(i)
+}
+
+// SetValidator replaces the validator at the given index
+func (s *SharedBeaconState) SetValidator(i int, val Validator) {
+	s.validators.set(i, val)
+}
+
+// AppendValidator adds a new validator with the given balance
+func (s *SharedBeaconState) AppendValidator(val Validator, balance uint64) {
+	s.validators.append(val)
+	s.balances.append(balance)
+
+	if s.inactivityScores != nil {
+		s.previousEpochParticipation.append(0)
+		s.currentEpochParticipation.append(0)
+		s.inactivityScores.append(0)
+	}
+}
+
+// Balance returns the balance of the validator at the given index
+func (s *SharedBeaconState) Balance(i int) uint64 {
+	return s.balances.get(i)
+}
+
+// SetBalance sets the balance of the validator at the given index
+func (s *SharedBeaconState) SetBalance(i int, balance uint64) {
+	s.balances.set(i, balance)
+}
+
+// BlockRoot returns the block root at the given index of the block roots vector
+func (s *SharedBeaconState) BlockRoot(i int) [32]byte {
+	return s.blockRoots.get(i)
+}
+
+// SetBlockRoot sets the block root at the given index of the block roots vector
+func (s *SharedBeaconState) SetBlockRoot(i int, root [32]byte) {
+	s.blockRoots.set(i, root)
+}
+
+// StateRoot returns the state root at the given index of the state roots vector
+func (s *SharedBeaconState) StateRoot(i int) [32]byte {
+	return s.stateRoots.get(i)
+}
+
+// SetStateRoot sets the state root at the given index of the state roots vector
+func (s *SharedBeaconState) SetStateRoot(i int, root [32]byte) {
+	s.stateRoots.set(i, root)
+}
+
+// RandaoMix returns the randao mix at the given index of the randao mixes vector
+func (s *SharedBeaconState) RandaoMix(i int) [32]byte {
+	return s.randaoMixes.get(i)
+}
+
+// SetRandaoMix sets the randao mix at the given index of the randao mixes vector
+func (s *SharedBeaconState) SetRandaoMix(i int, mix [32]byte) {
+	s.randaoMixes.set(i, mix)
+}
@@ -0,0 +1,145 @@
+package consensus
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSharedState(num int) *BeaconStateAltair {
+	state := &BeaconStateAltair{
+		Slot:                 10,
+		Slashings:            make([]uint64, 8192),
+		Fork:                 &Fork{Epoch: 1},
+		FinalizedCheckpoint:  &Checkpoint{Epoch: 2},
+		CurrentSyncCommittee: &SyncCommittee{},
+		NextSyncCommittee:    &SyncCommittee{},
+	}
+	for i := 0; i < num; i++ {
+		state.Validators = append(state.Validators, &Validator{EffectiveBalance: uint64(i)})
+		state.Balances = append(state.Balances, uint64(i))
+		state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, 1)
+		state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, 2)
+		state.InactivityScores = append(state.InactivityScores, 3)
+	}
+	state.RandaoMixes[100] = [32]byte{0x1}
+	return state
+}
+
+func stateRoot(t *testing.T, state BeaconState) [32]byte {
+	t.Helper()
+
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+func TestSharedBeaconState_Copy(t *testing.T) {
+	state := testSharedState(1500)
+	root := stateRoot(t, state)
+
+	shared, err := NewSharedBeaconState(state)
+	require.NoError(t, err)
+
+	// the state is copied on creation
+	state.Balances[0] = 100
+	assert.Equal(t, uint64(0), shared.Balance(0))
+	assert.Equal(t, root, stateRoot(t, shared.State()))
+
+	cpy := shared.Copy()
+
+	val := cpy.Validator(600)
+	val.Slashed = true
+	cpy.SetValidator(600, val)
+	cpy.SetBalance(1, 10)
+	cpy.SetRandaoMix(100, [32]byte{0x2})
+	cpy.AppendValidator(Validator{EffectiveBalance: 1}, 1)
+
+	// the original state does not change
+	assert.Equal(t, root, stateRoot(t, shared.State()))
+	assert.False(t, shared.Validator(600).Slashed)
+	assert.Equal(t, 1500, shared.NumValidators())
+
+	found := cpy.State().(*BeaconStateAltair)
+	assert.True(t, found.Validators[600].Slashed)
+	assert.Equal(t, uint64(10), found.Balances[1])
+	assert.Equal(t, [32]byte{0x2}, found.RandaoMixes[100])
+	assert.Len(t, found.Validators, 1501)
+	assert.Len(t, found.InactivityScores, 1501)
+	assert.Len(t, found.CurrentEpochParticipation, 1501)
+
+	// only the modified chunks are cloned
+	assert.Same(t, shared.validators.chunks[0], cpy.validators.chunks[0])
+	assert.NotSame(t, shared.validators.chunks[1], cpy.validators.chunks[1])
+	assert.NotSame(t, shared.balances.chunks[0], cpy.balances.chunks[0])
+	assert.Same(t, shared.balances.chunks[1], cpy.balances.chunks[1])
+	assert.Same(t, shared.blockRoots.chunks[0], cpy.blockRoots.chunks[0])
+
+	// the state returned does not share memory
+	found.Validators[0].Slashed = true
+	found.Fork.Epoch = 10
+	assert.False(t, cpy.Validator(0).Slashed)
+	assert.Equal(t, uint64(1), cpy.State().(*BeaconStateAltair).Fork.Epoch)
+}
+
+func TestSharedBeaconState_Update(t *testing.T) {
+	shared, err := NewSharedBeaconState(testSharedState(2000))
+	require.NoError(t, err)
+
+	// modify a plain copy of the state (i.e. a state transition)
+	post := shared.State().(*BeaconStateAltair)
+	post.Slot++
+	post.Balances[1999] = 5
+	post.BlockRoots[0] = [32]byte{0x1}
+
+	cpy := shared.Copy()
+	require.NoError(t, cpy.Update(post))
+	assert.Equal(t, stateRoot(t, post), stateRoot(t, cpy.State()))
+
+	assert.Same(t, shared.balances.chunks[0], cpy.balances.chunks[0])
+	assert.NotSame(t, shared.balances.chunks[3], cpy.balances.chunks[3])
+	assert.NotSame(t, shared.blockRoots.chunks[0], cpy.blockRoots.chunks[0])
+	assert.Same(t, shared.validators.chunks[0], cpy.validators.chunks[0])
+
+	// update with a state of a different fork
+	phase0 := &BeaconStatePhase0{
+		Slashings:  make([]uint64, 8192),
+		Validators: []*Validator{{EffectiveBalance: 1}},
+		Balances:   []uint64{1},
+	}
+	require.NoError(t, cpy.Update(phase0))
+	assert.Equal(t, stateRoot(t, phase0), stateRoot(t, cpy.State()))
+	assert.IsType(t, &BeaconStatePhase0{}, cpy.State())
+}
+
+func TestSharedBeaconState_CopyAllocs(t *testing.T) {
+	state := &BeaconStateDeneb{}
+	for i := 0; i < 1000; i++ {
+		state.Validators = append(state.Validators, &Validator{EffectiveBalance: uint64(i)})
+		state.Balances = append(state.Balances, uint64(i))
+		state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, 1)
+		state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, 2)
+		state.InactivityScores = append(state.InactivityScores, 3)
+	}
+	shared, err := NewSharedBeaconState(state)
+	require.NoError(t, err)
+
+	// the copies only allocate the lists of chunks and not the
+	// block roots, state roots and randao mixes vectors (2.6MB)
+	allocs := testing.AllocsPerRun(10, func() {
+		shared.Copy()
+	})
+	assert.LessOrEqual(t, allocs, float64(40))
+
+	const num = 10
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < num; i++ {
+		shared.Copy()
+	}
+	runtime.ReadMemStats(&after)
+	assert.Less(t, (after.TotalAlloc-before.TotalAlloc)/num, uint64(16*1024))
+}
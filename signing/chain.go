@@ -0,0 +1,225 @@
+package signing
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ssz "github.com/ferranbt/fastssz"
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/http"
+)
+
+// Chain computes the signing roots of the validator messages of a chain. The domain
+// of each message uses the version of the fork active at the epoch of the message.
+type Chain struct {
+	spec                  *consensus.Spec
+	genesisValidatorsRoot consensus.Root
+}
+
+// NewChain creates a chain with the spec and the genesis validators root of the network
+func NewChain(spec *consensus.Spec, genesisValidatorsRoot consensus.Root) *Chain {
+	return &Chain{
+		spec:                  spec,
+		genesisValidatorsRoot: genesisValidatorsRoot,
+	}
+}
+
+// Domain returns the domain of the domain type at the given epoch
+func (c *Chain) Domain(domainType consensus.Domain, epoch uint64) ([32]byte, error) {
+	return consensus.ComputeDomain(domainType, c.spec.ForkVersionAtEpoch(epoch), c.genesisValidatorsRoot)
+}
+
+func (c *Chain) epochAtSlot(slot uint64) (uint64, error) {
+	if c.spec.SlotsPerEpoch == 0 {
+		return 0, fmt.Errorf("slots per epoch not set")
+	}
+	return slot / c.spec.SlotsPerEpoch, nil
+}
+
+func (c *Chain) signingRoot(domainType consensus.Domain, epoch uint64, obj ssz.HashRoot) ([32]byte, error) {
+	domain, err := c.Domain(domainType, epoch)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return consensus.ComputeSigningRoot(domain, obj)
+}
+
+func (c *Chain) signingRootAtSlot(domainType consensus.Domain, slot uint64, obj ssz.HashRoot) ([32]byte, error) {
+	epoch, err := c.epochAtSlot(slot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return c.signingRoot(domainType, epoch, obj)
+}
+
+// BlockSigningRoot returns the signing root of a beacon block
+func (c *Chain) BlockSigningRoot(block consensus.BeaconBlock) ([32]byte, error) {
+	slot, err := (&consensus.VersionedBeaconBlock{BeaconBlock: block}).Slot()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return c.signingRootAtSlot(consensus.DomainBeaconProposerType, slot, block)
+}
+
+// BlindedBlockSigningRoot returns the signing root of a blinded beacon block.
+// It is the same as the signing root of the unblinded block.
+func (c *Chain) BlindedBlockSigningRoot(block consensus.BlindedBlock) ([32]byte, error) {
+	var slot uint64
+
+	switch obj := block.(type) {
+	case *consensus.BlindedBeaconBlock:
+		slot = obj.Slot
+	case *consensus.BlindedBeaconBlockCapella:
+		slot = obj.Slot
+	case *consensus.BlindedBeaconBlockDeneb:
+		slot = obj.Slot
+	case *consensus.BlindedBeaconBlockElectra:
+		slot = obj.Slot
+	default:
+		return [32]byte{}, fmt.Errorf("unknown blinded block type %T", block)
+	}
+	return c.signingRootAtSlot(consensus.DomainBeaconProposerType, slot, block)
+}
+
+// BlockHeaderSigningRoot returns the signing root of a beacon block header
+func (c *Chain) BlockHeaderSigningRoot(header *consensus.BeaconBlockHeader) ([32]byte, error) {
+	return c.signingRootAtSlot(consensus.DomainBeaconProposerType, header.Slot, header)
+}
+
+// RandaoSigningRoot returns the signing root of the randao reveal of an epoch
+func (c *Chain) RandaoSigningRoot(epoch uint64) ([32]byte, error) {
+	return c.signingRoot(consensus.DomainRandaoType, epoch, sszUint64(epoch))
+}
+
+// AttestationDataSigningRoot returns the signing root of the attestation data
+func (c *Chain) AttestationDataSigningRoot(data *consensus.AttestationData) ([32]byte, error) {
+	if data.Target == nil {
+		return [32]byte{}, fmt.Errorf("attestation target not set")
+	}
+	return c.signingRoot(consensus.DomainBeaconAttesterType, data.Target.Epoch, data)
+}
+
+// SelectionProofSigningRoot returns the signing root of the selection proof
+// of an aggregator of the attestations at the given slot
+func (c *Chain) SelectionProofSigningRoot(slot uint64) ([32]byte, error) {
+	return c.signingRootAtSlot(consensus.DomainSelectionProofType, slot, sszUint64(slot))
+}
+
+// AggregateAndProofSigningRoot returns the signing root of an aggregate and proof
+func (c *Chain) AggregateAndProofSigningRoot(msg *consensus.AggregateAndProof) ([32]byte, error) {
+	if msg.Aggregate == nil || msg.Aggregate.Data == nil {
+		return [32]byte{}, fmt.Errorf("aggregate data not set")
+	}
+	return c.signingRootAtSlot(consensus.DomainAggregateAndProofType, msg.Aggregate.Data.Slot, msg)
+}
+
+// AggregateAndProofElectraSigningRoot returns the signing root of an Electra aggregate and proof
+func (c *Chain) AggregateAndProofElectraSigningRoot(msg *consensus.AggregateAndProofElectra) ([32]byte, error) {
+	if msg.Aggregate == nil || msg.Aggregate.Data == nil {
+		return [32]byte{}, fmt.Errorf("aggregate data not set")
+	}
+	return c.signingRootAtSlot(consensus.DomainAggregateAndProofType, msg.Aggregate.Data.Slot, msg)
+}
+
+// SyncCommitteeMessageSigningRoot returns the signing root of the sync committee
+// message for the block root at the given slot
+func (c *Chain) SyncCommitteeMessageSigningRoot(slot uint64, blockRoot consensus.Root) ([32]byte, error) {
+	return c.signingRootAtSlot(consensus.DomainSyncCommitteeType, slot, sszRoot(blockRoot))
+}
+
+// SyncCommitteeSelectionProofSigningRoot returns the signing root of the selection
+// proof of an aggregator of the sync committee subnet at the given slot
+func (c *Chain) SyncCommitteeSelectionProofSigningRoot(slot, subcommitteeIndex uint64) ([32]byte, error) {
+	data := &consensus.SyncAggregatorSelectionData{
+		Slot:              slot,
+		SubCommitteeIndex: subcommitteeIndex,
+	}
+	return c.signingRootAtSlot(consensus.DomainSyncCommitteeSelectionProof, slot, data)
+}
+
+// ContributionAndProofSigningRoot returns the signing root of a contribution and proof
+func (c *Chain) ContributionAndProofSigningRoot(msg *consensus.ContributionAndProof) ([32]byte, error) {
+	if msg.Contribution == nil {
+		return [32]byte{}, fmt.Errorf("contribution not set")
+	}
+	return c.signingRootAtSlot(consensus.DomainContributionAndProof, msg.Contribution.Slot, msg)
+}
+
+// VoluntaryExitSigningRoot returns the signing root of a voluntary exit. If Deneb is scheduled
+// (EIP-7044), the voluntary exits are signed with the Capella fork version regardless of their
+// epoch so that they do not expire. Otherwise, it uses the fork version at the exit epoch.
+func (c *Chain) VoluntaryExitSigningRoot(exit *consensus.VoluntaryExit) ([32]byte, error) {
+	forkVersion := c.spec.ForkVersionAtEpoch(exit.Epoch)
+	if c.spec.DenebForkVersion != (consensus.Domain{}) && c.spec.DenebForkEpoch != consensus.FarFutureEpoch {
+		forkVersion = c.spec.CapellaForkVersion
+	}
+
+	domain, err := consensus.ComputeDomain(consensus.DomainVoluntaryExitType, forkVersion, c.genesisValidatorsRoot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return consensus.ComputeSigningRoot(domain, exit)
+}
+
+// BLSToExecutionChangeSigningRoot returns the signing root of a bls to execution change.
+// The domain always uses the genesis fork version.
+func (c *Chain) BLSToExecutionChangeSigningRoot(msg *consensus.BLSToExecutionChange) ([32]byte, error) {
+	domain, err := consensus.ComputeDomain(consensus.DomainBLSToExecutionChange, c.spec.GenesisForkVersion, c.genesisValidatorsRoot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return consensus.ComputeSigningRoot(domain, msg)
+}
+
+// ValidatorRegistrationSigningRoot returns the signing root of a validator registration for
+// the builder network. The domain uses the genesis fork version and an empty validators root.
+func (c *Chain) ValidatorRegistrationSigningRoot(msg *http.RegisterValidatorRequest) ([32]byte, error) {
+	return BuilderSigningRoot(c.spec, msg)
+}
+
+// BuilderSigningRoot returns the signing root of a message of the builder api
+func BuilderSigningRoot(spec *consensus.Spec, obj ssz.HashRoot) ([32]byte, error) {
+	domain, err := consensus.ComputeDomain(consensus.DomainApplicationBuilder, spec.GenesisForkVersion, consensus.Root{})
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return consensus.ComputeSigningRoot(domain, obj)
+}
+
+// sszUint64 is an uint64 signed as an ssz object
+type sszUint64 uint64
+
+func (s sszUint64) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:], uint64(s))
+	return root, nil
+}
+
+func (s sszUint64) HashTreeRootWith(hh ssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutUint64(uint64(s))
+	hh.Merkleize(indx)
+	return nil
+}
+
+func (s sszUint64) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// sszRoot is a root signed as an ssz object
+type sszRoot consensus.Root
+
+func (s sszRoot) HashTreeRoot() ([32]byte, error) {
+	return s, nil
+}
+
+func (s sszRoot) HashTreeRootWith(hh ssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(s[:])
+	hh.Merkleize(indx)
+	return nil
+}
+
+func (s sszRoot) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
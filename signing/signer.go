@@ -0,0 +1,104 @@
+package signing
+
+import (
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/go-eth-consensus/http"
+)
+
+// Signer signs the validator messages with a bls key
+type Signer struct {
+	key   *bls.Key
+	chain *Chain
+}
+
+// NewSigner creates a signer for the key in the chain with the spec and the genesis validators root
+func NewSigner(key *bls.Key, spec *consensus.Spec, genesisValidatorsRoot consensus.Root) *Signer {
+	return &Signer{
+		key:   key,
+		chain: NewChain(spec, genesisValidatorsRoot),
+	}
+}
+
+// Chain returns the chain used to compute the signing roots
+func (s *Signer) Chain() *Chain {
+	return s.chain
+}
+
+func (s *Signer) sign(root [32]byte, err error) (consensus.Signature, error) {
+	if err != nil {
+		return consensus.Signature{}, err
+	}
+	return s.key.Sign(root)
+}
+
+// SignBlock signs a beacon block
+func (s *Signer) SignBlock(block consensus.BeaconBlock) (consensus.Signature, error) {
+	return s.sign(s.chain.BlockSigningRoot(block))
+}
+
+// SignBlindedBlock signs a blinded beacon block
+func (s *Signer) SignBlindedBlock(block consensus.BlindedBlock) (consensus.Signature, error) {
+	return s.sign(s.chain.BlindedBlockSigningRoot(block))
+}
+
+// SignBlockHeader signs a beacon block header
+func (s *Signer) SignBlockHeader(header *consensus.BeaconBlockHeader) (consensus.Signature, error) {
+	return s.sign(s.chain.BlockHeaderSigningRoot(header))
+}
+
+// SignRandaoReveal signs the randao reveal of an epoch
+func (s *Signer) SignRandaoReveal(epoch uint64) (consensus.Signature, error) {
+	return s.sign(s.chain.RandaoSigningRoot(epoch))
+}
+
+// SignAttestationData signs the attestation data
+func (s *Signer) SignAttestationData(data *consensus.AttestationData) (consensus.Signature, error) {
+	return s.sign(s.chain.AttestationDataSigningRoot(data))
+}
+
+// SignSelectionProof signs the selection proof of an attestation aggregator at the given slot
+func (s *Signer) SignSelectionProof(slot uint64) (consensus.Signature, error) {
+	return s.sign(s.chain.SelectionProofSigningRoot(slot))
+}
+
+// SignAggregateAndProof signs an aggregate and proof
+func (s *Signer) SignAggregateAndProof(msg *consensus.AggregateAndProof) (consensus.Signature, error) {
+	return s.sign(s.chain.AggregateAndProofSigningRoot(msg))
+}
+
+// SignAggregateAndProofElectra signs an Electra aggregate and proof
+func (s *Signer) SignAggregateAndProofElectra(msg *consensus.AggregateAndProofElectra) (consensus.Signature, error) {
+	return s.sign(s.chain.AggregateAndProofElectraSigningRoot(msg))
+}
+
+// SignSyncCommitteeMessage signs the block root at the given slot for the sync committee
+func (s *Signer) SignSyncCommitteeMessage(slot uint64, blockRoot consensus.Root) (consensus.Signature, error) {
+	return s.sign(s.chain.SyncCommitteeMessageSigningRoot(slot, blockRoot))
+}
+
+// SignSyncCommitteeSelectionProof signs the selection proof of a sync committee aggregator
+func (s *Signer) SignSyncCommitteeSelectionProof(slot, subcommitteeIndex uint64) (consensus.Signature, error) {
+	return s.sign(s.chain.SyncCommitteeSelectionProofSigningRoot(slot, subcommitteeIndex))
+}
+
+// SignContributionAndProof signs a contribution and proof
+func (s *Signer) SignContributionAndProof(msg *consensus.ContributionAndProof) (consensus.Signature, error) {
+	return s.sign(s.chain.ContributionAndProofSigningRoot(msg))
+}
+
+// SignVoluntaryExit signs a voluntary exit
+func (s *Signer) SignVoluntaryExit(exit *consensus.VoluntaryExit) (consensus.Signature, error) {
+	return s.sign(s.chain.VoluntaryExitSigningRoot(exit))
+}
+
+// SignBLSToExecutionChange signs a bls to execution change. The key is the
+// withdrawal key of the validator.
+func (s *Signer) SignBLSToExecutionChange(msg *consensus.BLSToExecutionChange) (consensus.Signature, error) {
+	return s.sign(s.chain.BLSToExecutionChangeSigningRoot(msg))
+}
+
+// SignValidatorRegistration signs a validator registration for the builder network
+func (s *Signer) SignValidatorRegistration(msg *http.RegisterValidatorRequest) (consensus.Signature, error) {
+	return s.sign(s.chain.ValidatorRegistrationSigningRoot(msg))
+}
@@ -0,0 +1,179 @@
+package signing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/go-eth-consensus/http"
+	"github.com/umbracle/go-eth-consensus/spec"
+)
+
+func testSigner(t *testing.T) (*Signer, *bls.Key) {
+	t.Helper()
+
+	mainnet, err := spec.NetworkSpec("mainnet")
+	require.NoError(t, err)
+
+	key := bls.NewRandomKey()
+	return NewSigner(key, mainnet, consensus.Root{0x1}), key
+}
+
+func verify(t *testing.T, key *bls.Key, root [32]byte, sig consensus.Signature) {
+	t.Helper()
+
+	pub := new(bls.PublicKey)
+	pubKey := key.PubKey()
+	require.NoError(t, pub.Deserialize(pubKey[:]))
+
+	signature := new(bls.Signature)
+	require.NoError(t, signature.Deserialize(sig[:]))
+
+	ok, err := signature.VerifyByte(pub, root[:])
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestSigner_Messages(t *testing.T) {
+	signer, key := testSigner(t)
+	chain := signer.Chain()
+
+	slot := uint64(100)
+	block := &consensus.BeaconBlockPhase0{
+		Slot: slot,
+		Body: &consensus.BeaconBlockBodyPhase0{Eth1Data: &consensus.Eth1Data{}},
+	}
+	data := &consensus.AttestationData{
+		Slot:   slot,
+		Source: &consensus.Checkpoint{},
+		Target: &consensus.Checkpoint{Epoch: 3},
+	}
+
+	cases := []struct {
+		name string
+		sign func() (consensus.Signature, error)
+		root func() ([32]byte, error)
+	}{
+		{
+			"block",
+			func() (consensus.Signature, error) {
+				return signer.SignBlock(block)
+			},
+			func() ([32]byte, error) {
+				return chain.BlockSigningRoot(block)
+			},
+		},
+		{
+			"randao",
+			func() (consensus.Signature, error) { return signer.SignRandaoReveal(3) },
+			func() ([32]byte, error) { return chain.RandaoSigningRoot(3) },
+		},
+		{
+			"attestation data",
+			func() (consensus.Signature, error) { return signer.SignAttestationData(data) },
+			func() ([32]byte, error) { return chain.AttestationDataSigningRoot(data) },
+		},
+		{
+			"selection proof",
+			func() (consensus.Signature, error) { return signer.SignSelectionProof(slot) },
+			func() ([32]byte, error) { return chain.SelectionProofSigningRoot(slot) },
+		},
+		{
+			"sync committee message",
+			func() (consensus.Signature, error) { return signer.SignSyncCommitteeMessage(slot, consensus.Root{0x2}) },
+			func() ([32]byte, error) { return chain.SyncCommitteeMessageSigningRoot(slot, consensus.Root{0x2}) },
+		},
+		{
+			"voluntary exit",
+			func() (consensus.Signature, error) {
+				return signer.SignVoluntaryExit(&consensus.VoluntaryExit{Epoch: 1})
+			},
+			func() ([32]byte, error) {
+				return chain.VoluntaryExitSigningRoot(&consensus.VoluntaryExit{Epoch: 1})
+			},
+		},
+		{
+			"validator registration",
+			func() (consensus.Signature, error) {
+				return signer.SignValidatorRegistration(&http.RegisterValidatorRequest{GasLimit: 1})
+			},
+			func() ([32]byte, error) {
+				return chain.ValidatorRegistrationSigningRoot(&http.RegisterValidatorRequest{GasLimit: 1})
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sig, err := c.sign()
+			require.NoError(t, err)
+
+			root, err := c.root()
+			require.NoError(t, err)
+
+			verify(t, key, root, sig)
+		})
+	}
+}
+
+func TestChain_Domain(t *testing.T) {
+	signer, _ := testSigner(t)
+	chain := signer.Chain()
+	mainnet := chain.spec
+
+	domain := func(domainType consensus.Domain, forkVersion consensus.Domain, gvr consensus.Root) [32]byte {
+		d, err := consensus.ComputeDomain(domainType, forkVersion, gvr)
+		require.NoError(t, err)
+		return d
+	}
+	signingRoot := func(domain [32]byte, obj interface{ HashTreeRoot() ([32]byte, error) }) [32]byte {
+		root, err := obj.HashTreeRoot()
+		require.NoError(t, err)
+		signingData := &consensus.SigningData{ObjectRoot: root, Domain: domain}
+		root, err = signingData.HashTreeRoot()
+		require.NoError(t, err)
+		return root
+	}
+	gvr := consensus.Root{0x1}
+
+	// the block header uses the fork version at the epoch of its slot
+	header := &consensus.BeaconBlockHeader{Slot: mainnet.CapellaForkEpoch * mainnet.SlotsPerEpoch}
+	root, err := chain.BlockHeaderSigningRoot(header)
+	require.NoError(t, err)
+	require.Equal(t, signingRoot(domain(consensus.DomainBeaconProposerType, mainnet.CapellaForkVersion, gvr), header), root)
+
+	// the voluntary exits are pinned to the Capella fork version if Deneb is scheduled
+	for _, epoch := range []uint64{mainnet.AltairForkEpoch, mainnet.DenebForkEpoch, mainnet.ElectraForkEpoch + 1} {
+		exit := &consensus.VoluntaryExit{Epoch: epoch}
+		root, err = chain.VoluntaryExitSigningRoot(exit)
+		require.NoError(t, err)
+		require.Equal(t, signingRoot(domain(consensus.DomainVoluntaryExitType, mainnet.CapellaForkVersion, gvr), exit), root)
+	}
+
+	// otherwise, the voluntary exits use the fork version at their epoch
+	noDeneb := *mainnet
+	noDeneb.DenebForkEpoch = consensus.FarFutureEpoch
+	noDeneb.ElectraForkEpoch = consensus.FarFutureEpoch
+	exit := &consensus.VoluntaryExit{Epoch: mainnet.AltairForkEpoch}
+	root, err = NewChain(&noDeneb, gvr).VoluntaryExitSigningRoot(exit)
+	require.NoError(t, err)
+	require.Equal(t, signingRoot(domain(consensus.DomainVoluntaryExitType, mainnet.AltairForkVersion, gvr), exit), root)
+
+	// the bls to execution changes use the genesis fork version
+	change := &consensus.BLSToExecutionChange{ValidatorIndex: 1}
+	root, err = chain.BLSToExecutionChangeSigningRoot(change)
+	require.NoError(t, err)
+	require.Equal(t, signingRoot(domain(consensus.DomainBLSToExecutionChange, mainnet.GenesisForkVersion, gvr), change), root)
+
+	// the validator registrations use the genesis fork version and an empty root
+	reg := &http.RegisterValidatorRequest{GasLimit: 1}
+	root, err = chain.ValidatorRegistrationSigningRoot(reg)
+	require.NoError(t, err)
+	require.Equal(t, signingRoot(domain(consensus.DomainApplicationBuilder, mainnet.GenesisForkVersion, consensus.Root{}), reg), root)
+
+	// the randao reveal signs the epoch as an uint64
+	root, err = chain.RandaoSigningRoot(5)
+	require.NoError(t, err)
+	require.Equal(t, signingRoot(domain(consensus.DomainRandaoType, mainnet.GenesisForkVersion, gvr), sszUint64(5)), root)
+}
@@ -0,0 +1,284 @@
+package signing
+
+import (
+	"fmt"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/go-eth-consensus/http"
+)
+
+// SignatureError is returned when the signature of a message
+// does not match the public key and the signing root
+type SignatureError struct {
+	// Message is the name of the signed message
+	Message string
+
+	// Pubkey is the public key used to verify the signature
+	Pubkey [48]byte
+
+	// Root is the signing root of the message
+	Root [32]byte
+}
+
+func (e *SignatureError) Error() string {
+	return fmt.Sprintf("invalid %s signature for pubkey 0x%x", e.Message, e.Pubkey)
+}
+
+// PubkeyMismatchError is returned when the public key of a signed message
+// is not the expected one
+type PubkeyMismatchError struct {
+	// Message is the name of the signed message
+	Message string
+
+	// Expected is the expected public key
+	Expected [48]byte
+
+	// Found is the public key of the message
+	Found [48]byte
+}
+
+func (e *PubkeyMismatchError) Error() string {
+	return fmt.Sprintf("%s pubkey 0x%x does not match 0x%x", e.Message, e.Found, e.Expected)
+}
+
+func verifySignature(message string, pubkey [48]byte, root [32]byte, signature consensus.Signature) error {
+	pub := &bls.PublicKey{}
+	if err := pub.Deserialize(pubkey[:]); err != nil {
+		return fmt.Errorf("invalid %s pubkey: %v", message, err)
+	}
+
+	sig := &bls.Signature{}
+	if err := sig.Deserialize(signature[:]); err != nil {
+		return fmt.Errorf("invalid %s signature: %v", message, err)
+	}
+
+	ok, err := sig.VerifyByte(pub, root[:])
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &SignatureError{Message: message, Pubkey: pubkey, Root: root}
+	}
+	return nil
+}
+
+func verifyRoot(message string, pubkey [48]byte, signature consensus.Signature, root [32]byte, err error) error {
+	if err != nil {
+		return err
+	}
+	return verifySignature(message, pubkey, root, signature)
+}
+
+// VerifyBlock verifies the signature of the proposer of a signed beacon block
+func (c *Chain) VerifyBlock(pubkey [48]byte, signed consensus.SignedBeaconBlock) error {
+	var (
+		block     consensus.BeaconBlock
+		signature consensus.Signature
+	)
+
+	switch obj := signed.(type) {
+	case *consensus.SignedBeaconBlockPhase0:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	case *consensus.SignedBeaconBlockAltair:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	case *consensus.SignedBeaconBlockBellatrix:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	case *consensus.SignedBeaconBlockCapella:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	case *consensus.SignedBeaconBlockDeneb:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	case *consensus.SignedBeaconBlockElectra:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	default:
+		return fmt.Errorf("unknown signed block type %T", signed)
+	}
+	if block == nil {
+		return fmt.Errorf("signed block without block")
+	}
+
+	root, err := c.BlockSigningRoot(block)
+	return verifyRoot("block", pubkey, signature, root, err)
+}
+
+// VerifyBlindedBlock verifies the signature of the proposer of a signed blinded beacon block
+func (c *Chain) VerifyBlindedBlock(pubkey [48]byte, signed consensus.SignedBlindedBlock) error {
+	var (
+		block     consensus.BlindedBlock
+		signature consensus.Signature
+	)
+
+	switch obj := signed.(type) {
+	case *consensus.SignedBlindedBeaconBlock:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	case *consensus.SignedBlindedBeaconBlockCapella:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	case *consensus.SignedBlindedBeaconBlockDeneb:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	case *consensus.SignedBlindedBeaconBlockElectra:
+		if obj.Block != nil {
+			block = obj.Block
+		}
+		signature = obj.Signature
+	default:
+		return fmt.Errorf("unknown signed blinded block type %T", signed)
+	}
+	if block == nil {
+		return fmt.Errorf("signed blinded block without block")
+	}
+
+	root, err := c.BlindedBlockSigningRoot(block)
+	return verifyRoot("blinded block", pubkey, signature, root, err)
+}
+
+// VerifyBlockHeader verifies the signature of the proposer of a signed beacon block header
+func (c *Chain) VerifyBlockHeader(pubkey [48]byte, signed *consensus.SignedBeaconBlockHeader) error {
+	if signed.Header == nil {
+		return fmt.Errorf("signed header without header")
+	}
+	root, err := c.BlockHeaderSigningRoot(signed.Header)
+	return verifyRoot("block header", pubkey, signed.Signature, root, err)
+}
+
+// VerifyAggregateAndProof verifies both the selection proof and the signature of the aggregator.
+// The signature of the aggregate depends on the committee and it is not verified.
+func (c *Chain) VerifyAggregateAndProof(pubkey [48]byte, signed *consensus.SignedAggregateAndProof) error {
+	if signed.Message == nil {
+		return fmt.Errorf("signed aggregate and proof without message")
+	}
+	root, err := c.AggregateAndProofSigningRoot(signed.Message)
+	if err != nil {
+		return err
+	}
+	if err := c.verifySelectionProof(pubkey, signed.Message.Aggregate.Data.Slot, signed.Message.SelectionProof); err != nil {
+		return err
+	}
+	return verifySignature("aggregate and proof", pubkey, root, signed.Signature)
+}
+
+// VerifyAggregateAndProofElectra verifies both the selection proof and the signature of the
+// aggregator of an Electra aggregate and proof
+func (c *Chain) VerifyAggregateAndProofElectra(pubkey [48]byte, signed *consensus.SignedAggregateAndProofElectra) error {
+	if signed.Message == nil {
+		return fmt.Errorf("signed aggregate and proof without message")
+	}
+	root, err := c.AggregateAndProofElectraSigningRoot(signed.Message)
+	if err != nil {
+		return err
+	}
+	if err := c.verifySelectionProof(pubkey, signed.Message.Aggregate.Data.Slot, signed.Message.SelectionProof); err != nil {
+		return err
+	}
+	return verifySignature("aggregate and proof", pubkey, root, signed.Signature)
+}
+
+func (c *Chain) verifySelectionProof(pubkey [48]byte, slot uint64, proof consensus.Signature) error {
+	root, err := c.SelectionProofSigningRoot(slot)
+	return verifyRoot("selection proof", pubkey, proof, root, err)
+}
+
+// VerifyContributionAndProof verifies both the selection proof and the signature of the aggregator.
+// The signature of the contribution depends on the sync committee and it is not verified.
+func (c *Chain) VerifyContributionAndProof(pubkey [48]byte, signed *consensus.SignedContributionAndProof) error {
+	if signed.Message == nil {
+		return fmt.Errorf("signed contribution and proof without message")
+	}
+	root, err := c.ContributionAndProofSigningRoot(signed.Message)
+	if err != nil {
+		return err
+	}
+
+	contribution := signed.Message.Contribution
+	proofRoot, err := c.SyncCommitteeSelectionProofSigningRoot(contribution.Slot, contribution.SubcommitteeIndex)
+	if err := verifyRoot("sync committee selection proof", pubkey, signed.Message.SelectionProof, proofRoot, err); err != nil {
+		return err
+	}
+	return verifySignature("contribution and proof", pubkey, root, signed.Signature)
+}
+
+// VerifyVoluntaryExit verifies the signature of a signed voluntary exit
+func (c *Chain) VerifyVoluntaryExit(pubkey [48]byte, signed *consensus.SignedVoluntaryExit) error {
+	if signed.Exit == nil {
+		return fmt.Errorf("signed voluntary exit without exit")
+	}
+	root, err := c.VoluntaryExitSigningRoot(signed.Exit)
+	return verifyRoot("voluntary exit", pubkey, signed.Signature, root, err)
+}
+
+// VerifyBLSToExecutionChange verifies the signature of a signed bls to execution change
+// with the withdrawal public key of the message
+func (c *Chain) VerifyBLSToExecutionChange(signed *consensus.SignedBLSToExecutionChange) error {
+	if signed.Message == nil {
+		return fmt.Errorf("signed bls to execution change without message")
+	}
+	root, err := c.BLSToExecutionChangeSigningRoot(signed.Message)
+	return verifyRoot("bls to execution change", signed.Message.FromBLSPubKey, signed.Signature, root, err)
+}
+
+// VerifyValidatorRegistration verifies the signature of a signed validator
+// registration with the public key of the registered validator
+func (c *Chain) VerifyValidatorRegistration(signed *http.SignedValidatorRegistration) error {
+	if signed.Message == nil {
+		return fmt.Errorf("signed validator registration without message")
+	}
+	root, err := c.ValidatorRegistrationSigningRoot(signed.Message)
+	return verifyRoot("validator registration", signed.Message.Pubkey, signed.Signature, root, err)
+}
+
+// VerifyBuilderBid verifies the signature of a signed builder bid. The public key
+// of the bid must be the public key of the builder (i.e. the relay).
+func (c *Chain) VerifyBuilderBid(pubkey [48]byte, bid *http.VersionedSignedBuilderBid) error {
+	bidPubkey, err := bid.Pubkey()
+	if err != nil {
+		return err
+	}
+	if bidPubkey != pubkey {
+		return &PubkeyMismatchError{Message: "builder bid", Expected: pubkey, Found: bidPubkey}
+	}
+
+	var root [32]byte
+	var signature consensus.Signature
+
+	switch {
+	case bid.Bellatrix != nil:
+		root, err = BuilderSigningRoot(c.spec, bid.Bellatrix.Message)
+		signature = bid.Bellatrix.Signature
+	case bid.Capella != nil:
+		root, err = BuilderSigningRoot(c.spec, bid.Capella.Message)
+		signature = bid.Capella.Signature
+	case bid.Deneb != nil:
+		root, err = BuilderSigningRoot(c.spec, bid.Deneb.Message)
+		signature = bid.Deneb.Signature
+	case bid.Electra != nil:
+		root, err = BuilderSigningRoot(c.spec, bid.Electra.Message)
+		signature = bid.Electra.Signature
+	}
+	return verifyRoot("builder bid", pubkey, signature, root, err)
+}
@@ -0,0 +1,167 @@
+package signing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+	"github.com/umbracle/go-eth-consensus/http"
+)
+
+func requireSignatureError(t *testing.T, err error) {
+	t.Helper()
+
+	var sigErr *SignatureError
+	require.True(t, errors.As(err, &sigErr), err)
+}
+
+func TestVerify_Block(t *testing.T) {
+	signer, key := testSigner(t)
+	chain := signer.Chain()
+
+	block := &consensus.BeaconBlockPhase0{
+		Slot: 10,
+		Body: &consensus.BeaconBlockBodyPhase0{Eth1Data: &consensus.Eth1Data{}},
+	}
+	sig, err := signer.SignBlock(block)
+	require.NoError(t, err)
+
+	signed := &consensus.SignedBeaconBlockPhase0{Block: block, Signature: sig}
+	require.NoError(t, chain.VerifyBlock(key.PubKey(), signed))
+
+	// signed by another key
+	requireSignatureError(t, chain.VerifyBlock(bls.NewRandomKey().PubKey(), signed))
+
+	// the block changed after signing
+	block.Slot = 11
+	requireSignatureError(t, chain.VerifyBlock(key.PubKey(), signed))
+
+	require.Error(t, chain.VerifyBlock(key.PubKey(), &consensus.SignedBeaconBlockPhase0{}))
+}
+
+func TestVerify_AggregateAndProof(t *testing.T) {
+	signer, key := testSigner(t)
+	chain := signer.Chain()
+
+	msg := &consensus.AggregateAndProof{
+		Index: 1,
+		Aggregate: &consensus.Attestation{
+			AggregationBits: []byte{0x1},
+			Data: &consensus.AttestationData{
+				Slot:   33,
+				Source: &consensus.Checkpoint{},
+				Target: &consensus.Checkpoint{Epoch: 1},
+			},
+		},
+	}
+
+	// the selection proof is for another slot
+	var err error
+	msg.SelectionProof, err = signer.SignSelectionProof(32)
+	require.NoError(t, err)
+	sig, err := signer.SignAggregateAndProof(msg)
+	require.NoError(t, err)
+	requireSignatureError(t, chain.VerifyAggregateAndProof(key.PubKey(), &consensus.SignedAggregateAndProof{Message: msg, Signature: sig}))
+
+	msg.SelectionProof, err = signer.SignSelectionProof(33)
+	require.NoError(t, err)
+	sig, err = signer.SignAggregateAndProof(msg)
+	require.NoError(t, err)
+	require.NoError(t, chain.VerifyAggregateAndProof(key.PubKey(), &consensus.SignedAggregateAndProof{Message: msg, Signature: sig}))
+}
+
+func TestVerify_ContributionAndProof(t *testing.T) {
+	signer, key := testSigner(t)
+	chain := signer.Chain()
+
+	msg := &consensus.ContributionAndProof{
+		AggregatorIndex: 1,
+		Contribution: &consensus.SyncCommitteeContribution{
+			Slot:              64,
+			SubcommitteeIndex: 2,
+			AggregationBits:   make([]byte, 16),
+		},
+	}
+
+	var err error
+	msg.SelectionProof, err = signer.SignSyncCommitteeSelectionProof(64, 2)
+	require.NoError(t, err)
+	sig, err := signer.SignContributionAndProof(msg)
+	require.NoError(t, err)
+	require.NoError(t, chain.VerifyContributionAndProof(key.PubKey(), &consensus.SignedContributionAndProof{Message: msg, Signature: sig}))
+
+	// the selection proof is for another subcommittee
+	msg.Contribution.SubcommitteeIndex = 3
+	requireSignatureError(t, chain.VerifyContributionAndProof(key.PubKey(), &consensus.SignedContributionAndProof{Message: msg, Signature: sig}))
+}
+
+func TestVerify_VoluntaryExit(t *testing.T) {
+	signer, key := testSigner(t)
+	chain := signer.Chain()
+
+	exit := &consensus.VoluntaryExit{Epoch: chain.spec.ElectraForkEpoch, ValidatorIndex: 1}
+	sig, err := signer.SignVoluntaryExit(exit)
+	require.NoError(t, err)
+	require.NoError(t, chain.VerifyVoluntaryExit(key.PubKey(), &consensus.SignedVoluntaryExit{Exit: exit, Signature: sig}))
+
+	// an exit signed with the Electra fork version is not valid
+	domain, err := consensus.ComputeDomain(consensus.DomainVoluntaryExitType, chain.spec.ElectraForkVersion, chain.genesisValidatorsRoot)
+	require.NoError(t, err)
+	root, err := consensus.ComputeSigningRoot(domain, exit)
+	require.NoError(t, err)
+	sig, err = key.Sign(root)
+	require.NoError(t, err)
+	requireSignatureError(t, chain.VerifyVoluntaryExit(key.PubKey(), &consensus.SignedVoluntaryExit{Exit: exit, Signature: sig}))
+}
+
+func TestVerify_BLSToExecutionChange(t *testing.T) {
+	signer, key := testSigner(t)
+	chain := signer.Chain()
+
+	msg := &consensus.BLSToExecutionChange{ValidatorIndex: 1, FromBLSPubKey: key.PubKey()}
+	sig, err := signer.SignBLSToExecutionChange(msg)
+	require.NoError(t, err)
+	require.NoError(t, chain.VerifyBLSToExecutionChange(&consensus.SignedBLSToExecutionChange{Message: msg, Signature: sig}))
+
+	msg.FromBLSPubKey = bls.NewRandomKey().PubKey()
+	requireSignatureError(t, chain.VerifyBLSToExecutionChange(&consensus.SignedBLSToExecutionChange{Message: msg, Signature: sig}))
+}
+
+func TestVerify_Builder(t *testing.T) {
+	signer, key := testSigner(t)
+	chain := signer.Chain()
+
+	reg := &http.RegisterValidatorRequest{GasLimit: 30000000, Timestamp: 1, Pubkey: key.PubKey()}
+	sig, err := signer.SignValidatorRegistration(reg)
+	require.NoError(t, err)
+	require.NoError(t, chain.VerifyValidatorRegistration(&http.SignedValidatorRegistration{Message: reg, Signature: sig}))
+
+	reg.GasLimit = 1
+	requireSignatureError(t, chain.VerifyValidatorRegistration(&http.SignedValidatorRegistration{Message: reg, Signature: sig}))
+
+	bid := &http.BuilderBid{
+		Header: &consensus.ExecutionPayloadHeader{},
+		Pubkey: key.PubKey(),
+	}
+	root, err := BuilderSigningRoot(chain.spec, bid)
+	require.NoError(t, err)
+	sig, err = key.Sign(root)
+	require.NoError(t, err)
+
+	versioned := &http.VersionedSignedBuilderBid{
+		Version:   consensus.ForkBellatrix,
+		Bellatrix: &http.SignedBuilderBid{Message: bid, Signature: sig},
+	}
+	require.NoError(t, chain.VerifyBuilderBid(key.PubKey(), versioned))
+
+	// the bid is not from the expected builder
+	other := bls.NewRandomKey().PubKey()
+	err = chain.VerifyBuilderBid(other, versioned)
+
+	var mismatchErr *PubkeyMismatchError
+	require.True(t, errors.As(err, &mismatchErr))
+	require.Equal(t, other, mismatchErr.Expected)
+	require.Equal(t, key.PubKey(), mismatchErr.Found)
+}
@@ -1,5 +1,14 @@
 package consensus
 
+import (
+	"encoding"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
 type Spec struct {
 	// GenesisSlot represents the first canonical slot number of the beacon chain.
 	GenesisSlot uint64 `json:"GENESIS_SLOT"`
@@ -53,6 +62,12 @@ type Spec struct {
 	ChurnLimitQuotient    uint64 `json:"CHURN_LIMIT_QUOTIENT"`
 	MinPerEpochChurnLimit uint64 `json:"MIN_PER_EPOCH_CHURN_LIMIT"`
 
+	// MinActivationBalance is the minimum balance required to activate a validator (since Electra).
+	MinActivationBalance uint64 `json:"MIN_ACTIVATION_BALANCE"`
+
+	MinPerEpochChurnLimitElectra        uint64 `json:"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"`
+	MaxPerEpochActivationExitChurnLimit uint64 `json:"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT"`
+
 	// TargetAggregatorsPerCommittee defines the number of aggregators inside one committee.
 	TargetAggregatorsPerCommittee uint64 `json:"TARGET_AGGREGATORS_PER_COMMITTEE"`
 
@@ -64,4 +79,165 @@ type Spec struct {
 
 	BellatrixForkVersion Domain `json:"BELLATRIX_FORK_VERSION"`
 	BellatrixForkEpoch   uint64 `json:"BELLATRIX_FORK_EPOCH"`
+
+	CapellaForkVersion Domain `json:"CAPELLA_FORK_VERSION"`
+	CapellaForkEpoch   uint64 `json:"CAPELLA_FORK_EPOCH"`
+
+	DenebForkVersion Domain `json:"DENEB_FORK_VERSION"`
+	DenebForkEpoch   uint64 `json:"DENEB_FORK_EPOCH"`
+
+	ElectraForkVersion Domain `json:"ELECTRA_FORK_VERSION"`
+	ElectraForkEpoch   uint64 `json:"ELECTRA_FORK_EPOCH"`
+
+	// PresetBase is the name of the preset of the network (i.e. mainnet or minimal).
+	PresetBase string `json:"PRESET_BASE"`
+
+	// ConfigName is the name of the network.
+	ConfigName string `json:"CONFIG_NAME"`
+
+	// phase0 preset
+	MaxValidatorsPerCommittee uint64 `json:"MAX_VALIDATORS_PER_COMMITTEE"`
+	ShuffleRoundCount         uint64 `json:"SHUFFLE_ROUND_COUNT"`
+	MinDepositAmount          uint64 `json:"MIN_DEPOSIT_AMOUNT"`
+	HistoricalRootsLimit      uint64 `json:"HISTORICAL_ROOTS_LIMIT"`
+	ValidatorRegistryLimit    uint64 `json:"VALIDATOR_REGISTRY_LIMIT"`
+	MaxProposerSlashings      uint64 `json:"MAX_PROPOSER_SLASHINGS"`
+	MaxAttesterSlashings      uint64 `json:"MAX_ATTESTER_SLASHINGS"`
+	MaxAttestations           uint64 `json:"MAX_ATTESTATIONS"`
+	MaxDeposits               uint64 `json:"MAX_DEPOSITS"`
+	MaxVoluntaryExits         uint64 `json:"MAX_VOLUNTARY_EXITS"`
+
+	// altair preset
+	InactivityPenaltyQuotientAltair      uint64 `json:"INACTIVITY_PENALTY_QUOTIENT_ALTAIR"`
+	MinSlashingPenaltyQuotientAltair     uint64 `json:"MIN_SLASHING_PENALTY_QUOTIENT_ALTAIR"`
+	ProportionalSlashingMultiplierAltair uint64 `json:"PROPORTIONAL_SLASHING_MULTIPLIER_ALTAIR"`
+	EpochsPerSyncCommitteePeriod         uint64 `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+	MinSyncCommitteeParticipants         uint64 `json:"MIN_SYNC_COMMITTEE_PARTICIPANTS"`
+	UpdateTimeout                        uint64 `json:"UPDATE_TIMEOUT"`
+
+	// bellatrix preset
+	InactivityPenaltyQuotientBellatrix      uint64 `json:"INACTIVITY_PENALTY_QUOTIENT_BELLATRIX"`
+	MinSlashingPenaltyQuotientBellatrix     uint64 `json:"MIN_SLASHING_PENALTY_QUOTIENT_BELLATRIX"`
+	ProportionalSlashingMultiplierBellatrix uint64 `json:"PROPORTIONAL_SLASHING_MULTIPLIER_BELLATRIX"`
+	MaxBytesPerTransaction                  uint64 `json:"MAX_BYTES_PER_TRANSACTION"`
+	MaxTransactionsPerPayload               uint64 `json:"MAX_TRANSACTIONS_PER_PAYLOAD"`
+	BytesPerLogsBloom                       uint64 `json:"BYTES_PER_LOGS_BLOOM"`
+	MaxExtraDataBytes                       uint64 `json:"MAX_EXTRA_DATA_BYTES"`
+
+	// capella preset
+	MaxBlsToExecutionChanges         uint64 `json:"MAX_BLS_TO_EXECUTION_CHANGES"`
+	MaxWithdrawalsPerPayload         uint64 `json:"MAX_WITHDRAWALS_PER_PAYLOAD"`
+	MaxValidatorsPerWithdrawalsSweep uint64 `json:"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP"`
+
+	// deneb preset
+	FieldElementsPerBlob             uint64 `json:"FIELD_ELEMENTS_PER_BLOB"`
+	MaxBlobCommitmentsPerBlock       uint64 `json:"MAX_BLOB_COMMITMENTS_PER_BLOCK"`
+	KzgCommitmentInclusionProofDepth uint64 `json:"KZG_COMMITMENT_INCLUSION_PROOF_DEPTH"`
+
+	// electra preset
+	MaxEffectiveBalanceElectra            uint64 `json:"MAX_EFFECTIVE_BALANCE_ELECTRA"`
+	MinSlashingPenaltyQuotientElectra     uint64 `json:"MIN_SLASHING_PENALTY_QUOTIENT_ELECTRA"`
+	WhistleblowerRewardQuotientElectra    uint64 `json:"WHISTLEBLOWER_REWARD_QUOTIENT_ELECTRA"`
+	PendingDepositsLimit                  uint64 `json:"PENDING_DEPOSITS_LIMIT"`
+	PendingPartialWithdrawalsLimit        uint64 `json:"PENDING_PARTIAL_WITHDRAWALS_LIMIT"`
+	PendingConsolidationsLimit            uint64 `json:"PENDING_CONSOLIDATIONS_LIMIT"`
+	MaxAttesterSlashingsElectra           uint64 `json:"MAX_ATTESTER_SLASHINGS_ELECTRA"`
+	MaxAttestationsElectra                uint64 `json:"MAX_ATTESTATIONS_ELECTRA"`
+	MaxDepositRequestsPerPayload          uint64 `json:"MAX_DEPOSIT_REQUESTS_PER_PAYLOAD"`
+	MaxWithdrawalRequestsPerPayload       uint64 `json:"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD"`
+	MaxConsolidationRequestsPerPayload    uint64 `json:"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD"`
+	MaxPendingPartialsPerWithdrawalsSweep uint64 `json:"MAX_PENDING_PARTIALS_PER_WITHDRAWALS_SWEEP"`
+	MaxPendingDepositsPerEpoch            uint64 `json:"MAX_PENDING_DEPOSITS_PER_EPOCH"`
+
+	// TerminalTotalDifficulty is the total difficulty of the last proof of work block.
+	TerminalTotalDifficulty          Uint256  `json:"TERMINAL_TOTAL_DIFFICULTY"`
+	TerminalBlockHash                [32]byte `json:"TERMINAL_BLOCK_HASH"`
+	TerminalBlockHashActivationEpoch uint64   `json:"TERMINAL_BLOCK_HASH_ACTIVATION_EPOCH"`
+
+	// genesis
+	MinGenesisActiveValidatorCount uint64 `json:"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"`
+	MinGenesisTime                 uint64 `json:"MIN_GENESIS_TIME"`
+	GenesisDelay                   uint64 `json:"GENESIS_DELAY"`
+
+	// time parameters
+	SecondsPerEth1Block uint64 `json:"SECONDS_PER_ETH1_BLOCK"`
+	Eth1FollowDistance  uint64 `json:"ETH1_FOLLOW_DISTANCE"`
+
+	// validator cycle
+	InactivityScoreBias             uint64 `json:"INACTIVITY_SCORE_BIAS"`
+	InactivityScoreRecoveryRate     uint64 `json:"INACTIVITY_SCORE_RECOVERY_RATE"`
+	MaxPerEpochActivationChurnLimit uint64 `json:"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"`
+
+	// fork choice
+	ProposerScoreBoost uint64 `json:"PROPOSER_SCORE_BOOST"`
+
+	// blobs
+	MaxBlobsPerBlock                 uint64 `json:"MAX_BLOBS_PER_BLOCK"`
+	MaxBlobsPerBlockElectra          uint64 `json:"MAX_BLOBS_PER_BLOCK_ELECTRA"`
+	MaxRequestBlobSidecars           uint64 `json:"MAX_REQUEST_BLOB_SIDECARS"`
+	MaxRequestBlobSidecarsElectra    uint64 `json:"MAX_REQUEST_BLOB_SIDECARS_ELECTRA"`
+	BlobSidecarSubnetCount           uint64 `json:"BLOB_SIDECAR_SUBNET_COUNT"`
+	BlobSidecarSubnetCountElectra    uint64 `json:"BLOB_SIDECAR_SUBNET_COUNT_ELECTRA"`
+	MinEpochsForBlobSidecarsRequests uint64 `json:"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS"`
+
+	// deposit contract
+	DepositChainID         uint64   `json:"DEPOSIT_CHAIN_ID"`
+	DepositNetworkID       uint64   `json:"DEPOSIT_NETWORK_ID"`
+	DepositContractAddress [20]byte `json:"DEPOSIT_CONTRACT_ADDRESS"`
+}
+
+// Merge sets the fields of the spec from the config values indexed by their
+// name (i.e. SECONDS_PER_SLOT). The values are encoded as strings like in the
+// yaml configs or in the beacon api. Unknown names are ignored.
+func (s *Spec) Merge(values map[string]string) error {
+	v := reflect.ValueOf(s).Elem()
+	typ := v.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		name := typ.Field(i).Tag.Get("json")
+
+		val, ok := values[name]
+		if !ok {
+			continue
+		}
+		if err := setSpecField(v.Field(i), val); err != nil {
+			return fmt.Errorf("failed to decode '%s': %v", name, err)
+		}
+	}
+	return nil
+}
+
+func setSpecField(field reflect.Value, val string) error {
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(val))
+	}
+
+	switch field.Kind() {
+	case reflect.Uint64:
+		num, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(num)
+
+	case reflect.String:
+		field.SetString(val)
+
+	case reflect.Array:
+		if !strings.HasPrefix(val, "0x") {
+			return fmt.Errorf("0x prefix not found")
+		}
+		buf, err := hex.DecodeString(val[2:])
+		if err != nil {
+			return err
+		}
+		if len(buf) != field.Len() {
+			return fmt.Errorf("incorrect length: expected %d but found %d", field.Len(), len(buf))
+		}
+		reflect.Copy(field, reflect.ValueOf(buf))
+
+	default:
+		return fmt.Errorf("type %s not supported", field.Type())
+	}
+	return nil
 }
@@ -0,0 +1,132 @@
+package spec
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed presets configs
+var embedFS embed.FS
+
+// Networks is the list of networks with an embedded config
+var Networks = []string{"mainnet", "sepolia", "holesky"}
+
+// NetworkSpec returns the spec of one of the embedded networks (see Networks)
+func NetworkSpec(network string) (*consensus.Spec, error) {
+	data, err := embedFS.ReadFile(path.Join("configs", network+".yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("network '%s' not found", network)
+	}
+	return loadSpec(nil, data)
+}
+
+// PresetSpec returns the spec with only the values of one of the
+// embedded presets (mainnet or minimal)
+func PresetSpec(preset string) (*consensus.Spec, error) {
+	presetFS, err := embeddedPreset(preset)
+	if err != nil {
+		return nil, err
+	}
+	spec := &consensus.Spec{}
+	if err := mergePreset(spec, presetFS); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// LoadSpec loads the spec from the yaml files of a preset directory (one file per fork)
+// and the yaml config file of a network. If the preset directory is empty, it uses the
+// embedded preset referenced in the config by PRESET_BASE.
+func LoadSpec(presetDir string, configFile string) (*consensus.Spec, error) {
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var presetFS fs.FS
+	if presetDir != "" {
+		presetFS = os.DirFS(presetDir)
+	}
+	return loadSpec(presetFS, data)
+}
+
+func loadSpec(presetFS fs.FS, config []byte) (*consensus.Spec, error) {
+	values, err := decodeValues(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode config: %v", err)
+	}
+
+	if presetFS == nil {
+		preset, ok := values["PRESET_BASE"]
+		if !ok {
+			return nil, fmt.Errorf("PRESET_BASE not found in config")
+		}
+		if presetFS, err = embeddedPreset(preset); err != nil {
+			return nil, err
+		}
+	}
+
+	spec := &consensus.Spec{}
+	if err := mergePreset(spec, presetFS); err != nil {
+		return nil, err
+	}
+	// the values of the config have precedence over the ones in the preset
+	if err := spec.Merge(values); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func embeddedPreset(preset string) (fs.FS, error) {
+	presetFS, err := fs.Sub(embedFS, path.Join("presets", preset))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fs.Stat(presetFS, "phase0.yaml"); err != nil {
+		return nil, fmt.Errorf("preset '%s' not found", preset)
+	}
+	return presetFS, nil
+}
+
+func mergePreset(spec *consensus.Spec, presetFS fs.FS) error {
+	files, err := fs.Glob(presetFS, "*.yaml")
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no preset files found")
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		data, err := fs.ReadFile(presetFS, file)
+		if err != nil {
+			return err
+		}
+		values, err := decodeValues(data)
+		if err != nil {
+			return fmt.Errorf("failed to decode preset '%s': %v", file, err)
+		}
+		if err := spec.Merge(values); err != nil {
+			return fmt.Errorf("failed to merge preset '%s': %v", file, err)
+		}
+	}
+	return nil
+}
+
+// decodeValues decodes the values of a yaml config as strings to
+// keep the big numbers (i.e. TERMINAL_TOTAL_DIFFICULTY) and the hex values.
+func decodeValues(data []byte) (map[string]string, error) {
+	var values map[string]string
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,116 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	consensus "github.com/umbracle/go-eth-consensus"
+)
+
+func TestNetworkSpec_Mainnet(t *testing.T) {
+	found, err := NetworkSpec("mainnet")
+	require.NoError(t, err)
+
+	// the values of the mainnet spec match the ones loaded from the configs
+	expected := reflect.ValueOf(Spec).Elem()
+	for i := 0; i < expected.NumField(); i++ {
+		name := expected.Type().Field(i).Name
+		if name == "BaseRewardsPerEpoch" {
+			// constant not included in the presets
+			continue
+		}
+		if expected.Field(i).IsZero() {
+			continue
+		}
+		assert.Equal(t, expected.Field(i).Interface(), reflect.ValueOf(found).Elem().Field(i).Interface(), name)
+	}
+
+	assert.Equal(t, "mainnet", found.ConfigName)
+	assert.Equal(t, uint64(2048), found.MaxValidatorsPerCommittee)
+	assert.Equal(t, uint64(50331648), found.InactivityPenaltyQuotientAltair)
+	assert.Equal(t, uint64(16777216), found.InactivityPenaltyQuotientBellatrix)
+	assert.Equal(t, uint64(2048000000000), found.MaxEffectiveBalanceElectra)
+	assert.Equal(t, uint64(18446744073709551615), found.TerminalBlockHashActivationEpoch)
+
+	ttd, err := found.TerminalTotalDifficulty.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "58750000000000000000000", string(ttd))
+}
+
+func TestNetworkSpec_All(t *testing.T) {
+	for _, network := range Networks {
+		spec, err := NetworkSpec(network)
+		require.NoError(t, err)
+
+		assert.Equal(t, network, spec.ConfigName)
+		assert.Equal(t, uint64(32), spec.SlotsPerEpoch)
+		assert.NotZero(t, spec.DepositChainID)
+		assert.NotZero(t, spec.ElectraForkEpoch)
+	}
+
+	sepolia, err := NetworkSpec("sepolia")
+	require.NoError(t, err)
+	assert.Equal(t, consensus.Domain{0x90, 0x00, 0x00, 0x69}, sepolia.GenesisForkVersion)
+	assert.Equal(t, uint64(11155111), sepolia.DepositChainID)
+	assert.Equal(t, byte(0x7f), sepolia.DepositContractAddress[0])
+
+	_, err = NetworkSpec("goerli")
+	require.Error(t, err)
+}
+
+func TestPresetSpec(t *testing.T) {
+	minimal, err := PresetSpec("minimal")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), minimal.SlotsPerEpoch)
+	assert.Equal(t, uint64(32), minimal.SyncCommitteeSize)
+	assert.Equal(t, uint64(4), minimal.MaxWithdrawalsPerPayload)
+	assert.Zero(t, minimal.SecondsPerSlot)
+
+	_, err = PresetSpec("unknown")
+	require.Error(t, err)
+}
+
+func TestLoadSpec(t *testing.T) {
+	dir := t.TempDir()
+
+	// numbers encoded as strings like in the beacon api
+	config := `
+PRESET_BASE: 'minimal'
+CONFIG_NAME: 'devnet'
+SECONDS_PER_SLOT: '6'
+GENESIS_FORK_VERSION: 0x00000001
+ALTAIR_FORK_EPOCH: "0"
+TERMINAL_TOTAL_DIFFICULTY: 0
+UNKNOWN_FIELD: 10
+`
+	configFile := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte(config), 0644))
+
+	// embedded preset from PRESET_BASE
+	spec, err := LoadSpec("", configFile)
+	require.NoError(t, err)
+	assert.Equal(t, "devnet", spec.ConfigName)
+	assert.Equal(t, uint64(6), spec.SecondsPerSlot)
+	assert.Equal(t, uint64(8), spec.SlotsPerEpoch)
+	assert.Equal(t, consensus.Domain{0, 0, 0, 1}, spec.GenesisForkVersion)
+
+	// preset from a directory
+	spec, err = LoadSpec("./presets/mainnet", configFile)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(32), spec.SlotsPerEpoch)
+	assert.Equal(t, uint64(6), spec.SecondsPerSlot)
+
+	// invalid value
+	require.NoError(t, os.WriteFile(configFile, []byte("PRESET_BASE: minimal\nSECONDS_PER_SLOT: abc"), 0644))
+	_, err = LoadSpec("", configFile)
+	require.Error(t, err)
+
+	// unknown preset
+	require.NoError(t, os.WriteFile(configFile, []byte("PRESET_BASE: other"), 0644))
+	_, err = LoadSpec("", configFile)
+	require.Error(t, err)
+}
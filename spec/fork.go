@@ -0,0 +1,496 @@
+package spec
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+const (
+	timelySourceFlagIndex = 0
+	timelyTargetFlagIndex = 1
+	timelyHeadFlagIndex   = 2
+
+	unsetDepositRequestsStartIndex = 18446744073709551615 // 2**64-1
+)
+
+// g2PointAtInfinity is the compressed representation of the infinity point in G2
+var g2PointAtInfinity = consensus.Signature{0xc0}
+
+// UpgradeToAltair upgrades a phase0 state to an altair state.
+// The post state reuses the memory of the pre state.
+func UpgradeToAltair(pre *consensus.BeaconStatePhase0) (*consensus.BeaconStateAltair, error) {
+	epoch := getCurrentEpoch(pre)
+
+	post := &consensus.BeaconStateAltair{
+		GenesisTime:           pre.GenesisTime,
+		GenesisValidatorsRoot: pre.GenesisValidatorsRoot,
+		Slot:                  pre.Slot,
+		Fork: &consensus.Fork{
+			PreviousVersion: pre.Fork.CurrentVersion,
+			CurrentVersion:  Spec.AltairForkVersion,
+			Epoch:           epoch,
+		},
+		LatestBlockHeader:           pre.LatestBlockHeader,
+		BlockRoots:                  pre.BlockRoots,
+		StateRoots:                  pre.StateRoots,
+		HistoricalRoots:             pre.HistoricalRoots,
+		Eth1Data:                    pre.Eth1Data,
+		Eth1DataVotes:               pre.Eth1DataVotes,
+		Eth1DepositIndex:            pre.Eth1DepositIndex,
+		Validators:                  pre.Validators,
+		Balances:                    pre.Balances,
+		RandaoMixes:                 pre.RandaoMixes,
+		Slashings:                   pre.Slashings,
+		PreviousEpochParticipation:  make([]byte, len(pre.Validators)),
+		CurrentEpochParticipation:   make([]byte, len(pre.Validators)),
+		JustificationBits:           pre.JustificationBits,
+		PreviousJustifiedCheckpoint: pre.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  pre.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         pre.FinalizedCheckpoint,
+		InactivityScores:            make([]uint64, len(pre.Validators)),
+	}
+
+	// fill in previous epoch participation from the pre state's pending attestations.
+	// The fields used to compute the participation are the same in the pre and post states.
+	for _, attestation := range pre.PreviousEpochAttestations {
+		flagIndices, err := getAttestationParticipationFlagIndices(pre, attestation.Data, attestation.InclusionDelay)
+		if err != nil {
+			return nil, err
+		}
+		indices, err := getAttestingIndices(pre, attestation.Data, attestation.AggregationBits)
+		if err != nil {
+			return nil, err
+		}
+		for _, index := range indices {
+			for _, flagIndex := range flagIndices {
+				post.PreviousEpochParticipation[index] |= 1 << flagIndex
+			}
+		}
+	}
+
+	// fill in sync committees.
+	// Note, the same committee is used for the current and next period at the fork
+	syncCommittee, err := getNextSyncCommittee(pre)
+	if err != nil {
+		return nil, err
+	}
+	post.CurrentSyncCommittee = syncCommittee
+
+	nextSyncCommittee := *syncCommittee
+	post.NextSyncCommittee = &nextSyncCommittee
+
+	return post, nil
+}
+
+func getAttestationParticipationFlagIndices(state *consensus.BeaconStatePhase0, data *consensus.AttestationData, inclusionDelay uint64) ([]uint64, error) {
+	var justifiedCheckpoint *consensus.Checkpoint
+	if data.Target.Epoch == getCurrentEpoch(state) {
+		justifiedCheckpoint = state.CurrentJustifiedCheckpoint
+	} else {
+		justifiedCheckpoint = state.PreviousJustifiedCheckpoint
+	}
+
+	isMatchingSource := data.Source.Epoch == justifiedCheckpoint.Epoch && data.Source.Root == justifiedCheckpoint.Root
+	if !isMatchingSource {
+		return nil, fmt.Errorf("attestation source does not match justified checkpoint")
+	}
+	isMatchingTarget := data.Target.Root == getBlockRoot(state, data.Target.Epoch)
+	isMatchingHead := isMatchingTarget && data.BeaconBlockHash == getBlockRootAtSlot(state, data.Slot)
+
+	flagIndices := []uint64{}
+	if inclusionDelay <= integerSquareRoot(Spec.SlotsPerEpoch) {
+		flagIndices = append(flagIndices, timelySourceFlagIndex)
+	}
+	if isMatchingTarget && inclusionDelay <= Spec.SlotsPerEpoch {
+		flagIndices = append(flagIndices, timelyTargetFlagIndex)
+	}
+	if isMatchingHead && inclusionDelay == Spec.MinAttestationInclusionDelay {
+		flagIndices = append(flagIndices, timelyHeadFlagIndex)
+	}
+	return flagIndices, nil
+}
+
+func getNextSyncCommitteeIndices(state *consensus.BeaconStatePhase0) ([]uint64, error) {
+	const maxRandomByte = 1<<8 - 1
+
+	epoch := getCurrentEpoch(state) + 1
+
+	activeValidatorIndices := getActiveValidatorIndices(state, epoch)
+	activeValidatorCount := uint64(len(activeValidatorIndices))
+	if activeValidatorCount == 0 {
+		return nil, fmt.Errorf("no active validators")
+	}
+	seed := getSeed(state, epoch, consensus.DomainSyncCommitteeType)
+
+	buf := make([]byte, 40)
+	copy(buf[:32], seed[:])
+
+	syncCommitteeIndices := []uint64{}
+	for i := uint64(0); uint64(len(syncCommitteeIndices)) < Spec.SyncCommitteeSize; i++ {
+		shuffledIndex := computeShuffleIndex(i%activeValidatorCount, activeValidatorCount, seed)
+		candidateIndex := activeValidatorIndices[shuffledIndex]
+
+		binary.LittleEndian.PutUint64(buf[32:], i/32)
+		randomByte := sha256.Sum256(buf)
+
+		effectiveBalance := state.Validators[candidateIndex].EffectiveBalance
+		if effectiveBalance*maxRandomByte >= Spec.MaxEffectiveBalance*uint64(randomByte[i%32]) {
+			syncCommitteeIndices = append(syncCommitteeIndices, candidateIndex)
+		}
+	}
+	return syncCommitteeIndices, nil
+}
+
+func getNextSyncCommittee(state *consensus.BeaconStatePhase0) (*consensus.SyncCommittee, error) {
+	indices, err := getNextSyncCommitteeIndices(state)
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) != len(consensus.SyncCommittee{}.PubKeys) {
+		return nil, fmt.Errorf("sync committee size %d does not match %d", len(indices), len(consensus.SyncCommittee{}.PubKeys))
+	}
+
+	committee := &consensus.SyncCommittee{}
+	pubs := make([]*bls.PublicKey, len(indices))
+
+	for i, index := range indices {
+		pubKey := state.Validators[index].Pubkey
+
+		pub := new(bls.PublicKey)
+		if err := pub.Deserialize(pubKey[:]); err != nil {
+			return nil, fmt.Errorf("failed to decode validator %d public key: %v", index, err)
+		}
+		committee.PubKeys[i] = pubKey
+		pubs[i] = pub
+	}
+
+	committee.AggregatePubKey = bls.AggregatePublicKeys(pubs).Serialize()
+	return committee, nil
+}
+
+// UpgradeToBellatrix upgrades an altair state to a bellatrix state.
+// The post state reuses the memory of the pre state.
+func UpgradeToBellatrix(pre *consensus.BeaconStateAltair) (*consensus.BeaconStateBellatrix, error) {
+	epoch := pre.Slot / Spec.SlotsPerEpoch
+
+	historicalRoots := make([][]byte, len(pre.HistoricalRoots))
+	for i := range pre.HistoricalRoots {
+		historicalRoots[i] = pre.HistoricalRoots[i][:]
+	}
+
+	post := &consensus.BeaconStateBellatrix{
+		GenesisTime:           pre.GenesisTime,
+		GenesisValidatorsRoot: pre.GenesisValidatorsRoot,
+		Slot:                  pre.Slot,
+		Fork: &consensus.Fork{
+			PreviousVersion: pre.Fork.CurrentVersion,
+			CurrentVersion:  Spec.BellatrixForkVersion,
+			Epoch:           epoch,
+		},
+		LatestBlockHeader:            pre.LatestBlockHeader,
+		BlockRoots:                   pre.BlockRoots,
+		StateRoots:                   pre.StateRoots,
+		HistoricalRoots:              historicalRoots,
+		Eth1Data:                     pre.Eth1Data,
+		Eth1DataVotes:                pre.Eth1DataVotes,
+		Eth1DepositIndex:             pre.Eth1DepositIndex,
+		Validators:                   pre.Validators,
+		Balances:                     pre.Balances,
+		RandaoMixes:                  pre.RandaoMixes,
+		Slashings:                    pre.Slashings,
+		PreviousEpochParticipation:   pre.PreviousEpochParticipation,
+		CurrentEpochParticipation:    pre.CurrentEpochParticipation,
+		JustificationBits:            pre.JustificationBits,
+		PreviousJustifiedCheckpoint:  pre.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:   pre.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:          pre.FinalizedCheckpoint,
+		InactivityScores:             pre.InactivityScores,
+		CurrentSyncCommittee:         pre.CurrentSyncCommittee,
+		NextSyncCommittee:            pre.NextSyncCommittee,
+		LatestExecutionPayloadHeader: &consensus.ExecutionPayloadHeader{},
+	}
+	return post, nil
+}
+
+// UpgradeToCapella upgrades a bellatrix state to a capella state.
+// The post state reuses the memory of the pre state.
+func UpgradeToCapella(pre *consensus.BeaconStateBellatrix) (*consensus.BeaconStateCapella, error) {
+	epoch := pre.Slot / Spec.SlotsPerEpoch
+
+	preHeader := pre.LatestExecutionPayloadHeader
+	if preHeader == nil {
+		preHeader = &consensus.ExecutionPayloadHeader{}
+	}
+	header := &consensus.ExecutionPayloadHeaderCapella{
+		ParentHash:       preHeader.ParentHash,
+		FeeRecipient:     preHeader.FeeRecipient,
+		StateRoot:        preHeader.StateRoot,
+		ReceiptsRoot:     preHeader.ReceiptsRoot,
+		LogsBloom:        preHeader.LogsBloom,
+		PrevRandao:       preHeader.PrevRandao,
+		BlockNumber:      preHeader.BlockNumber,
+		GasLimit:         preHeader.GasLimit,
+		GasUsed:          preHeader.GasUsed,
+		Timestamp:        preHeader.Timestamp,
+		ExtraData:        preHeader.ExtraData,
+		BaseFeePerGas:    preHeader.BaseFeePerGas,
+		BlockHash:        preHeader.BlockHash,
+		TransactionsRoot: preHeader.TransactionsRoot,
+	}
+
+	post := &consensus.BeaconStateCapella{
+		GenesisTime:           pre.GenesisTime,
+		GenesisValidatorsRoot: pre.GenesisValidatorsRoot,
+		Slot:                  pre.Slot,
+		Fork: &consensus.Fork{
+			PreviousVersion: pre.Fork.CurrentVersion,
+			CurrentVersion:  Spec.CapellaForkVersion,
+			Epoch:           epoch,
+		},
+		LatestBlockHeader:            pre.LatestBlockHeader,
+		BlockRoots:                   pre.BlockRoots,
+		StateRoots:                   pre.StateRoots,
+		HistoricalRoots:              pre.HistoricalRoots,
+		Eth1Data:                     pre.Eth1Data,
+		Eth1DataVotes:                pre.Eth1DataVotes,
+		Eth1DepositIndex:             pre.Eth1DepositIndex,
+		Validators:                   pre.Validators,
+		Balances:                     pre.Balances,
+		RandaoMixes:                  pre.RandaoMixes,
+		Slashings:                    pre.Slashings,
+		PreviousEpochParticipation:   pre.PreviousEpochParticipation,
+		CurrentEpochParticipation:    pre.CurrentEpochParticipation,
+		JustificationBits:            pre.JustificationBits,
+		PreviousJustifiedCheckpoint:  pre.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:   pre.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:          pre.FinalizedCheckpoint,
+		InactivityScores:             pre.InactivityScores,
+		CurrentSyncCommittee:         pre.CurrentSyncCommittee,
+		NextSyncCommittee:            pre.NextSyncCommittee,
+		LatestExecutionPayloadHeader: header,
+		NextWithdrawalIndex:          0,
+		NextWithdrawalValidatorIndex: 0,
+		HistoricalSummaries:          []*consensus.HistoricalSummary{},
+	}
+	return post, nil
+}
+
+// UpgradeToDeneb upgrades a capella state to a deneb state.
+// The post state reuses the memory of the pre state.
+func UpgradeToDeneb(pre *consensus.BeaconStateCapella) (*consensus.BeaconStateDeneb, error) {
+	epoch := pre.Slot / Spec.SlotsPerEpoch
+
+	preHeader := pre.LatestExecutionPayloadHeader
+	if preHeader == nil {
+		preHeader = &consensus.ExecutionPayloadHeaderCapella{}
+	}
+	header := &consensus.ExecutionPayloadHeaderDeneb{
+		ParentHash:       preHeader.ParentHash,
+		FeeRecipient:     preHeader.FeeRecipient,
+		StateRoot:        preHeader.StateRoot,
+		ReceiptsRoot:     preHeader.ReceiptsRoot,
+		LogsBloom:        preHeader.LogsBloom,
+		PrevRandao:       preHeader.PrevRandao,
+		BlockNumber:      preHeader.BlockNumber,
+		GasLimit:         preHeader.GasLimit,
+		GasUsed:          preHeader.GasUsed,
+		Timestamp:        preHeader.Timestamp,
+		ExtraData:        preHeader.ExtraData,
+		BaseFeePerGas:    preHeader.BaseFeePerGas,
+		BlockHash:        preHeader.BlockHash,
+		TransactionsRoot: preHeader.TransactionsRoot,
+		WithdrawalRoot:   preHeader.WithdrawalRoot,
+		BlobGasUsed:      0,
+		ExcessBlobGas:    0,
+	}
+
+	post := &consensus.BeaconStateDeneb{
+		GenesisTime:           pre.GenesisTime,
+		GenesisValidatorsRoot: pre.GenesisValidatorsRoot,
+		Slot:                  pre.Slot,
+		Fork: &consensus.Fork{
+			PreviousVersion: pre.Fork.CurrentVersion,
+			CurrentVersion:  Spec.DenebForkVersion,
+			Epoch:           epoch,
+		},
+		LatestBlockHeader:            pre.LatestBlockHeader,
+		BlockRoots:                   pre.BlockRoots,
+		StateRoots:                   pre.StateRoots,
+		HistoricalRoots:              pre.HistoricalRoots,
+		Eth1Data:                     pre.Eth1Data,
+		Eth1DataVotes:                pre.Eth1DataVotes,
+		Eth1DepositIndex:             pre.Eth1DepositIndex,
+		Validators:                   pre.Validators,
+		Balances:                     pre.Balances,
+		RandaoMixes:                  pre.RandaoMixes,
+		Slashings:                    pre.Slashings,
+		PreviousEpochParticipation:   pre.PreviousEpochParticipation,
+		CurrentEpochParticipation:    pre.CurrentEpochParticipation,
+		JustificationBits:            pre.JustificationBits,
+		PreviousJustifiedCheckpoint:  pre.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:   pre.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:          pre.FinalizedCheckpoint,
+		InactivityScores:             pre.InactivityScores,
+		CurrentSyncCommittee:         pre.CurrentSyncCommittee,
+		NextSyncCommittee:            pre.NextSyncCommittee,
+		LatestExecutionPayloadHeader: header,
+		NextWithdrawalIndex:          pre.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex: pre.NextWithdrawalValidatorIndex,
+		HistoricalSummaries:          pre.HistoricalSummaries,
+	}
+	return post, nil
+}
+
+// UpgradeToElectra upgrades a deneb state to an electra state.
+// The post state reuses the memory of the pre state.
+func UpgradeToElectra(pre *consensus.BeaconStateDeneb) (*consensus.BeaconStateElectra, error) {
+	epoch := pre.Slot / Spec.SlotsPerEpoch
+
+	// the earliest exit epoch is the one after the latest scheduled exit
+	// and never before the activation exit epoch of the current epoch
+	earliestExitEpoch := computeActivationExitEpoch(epoch)
+	for _, val := range pre.Validators {
+		if val.ExitEpoch != farFutureEpoch {
+			earliestExitEpoch = max(earliestExitEpoch, val.ExitEpoch)
+		}
+	}
+	earliestExitEpoch++
+
+	post := &consensus.BeaconStateElectra{
+		GenesisTime:           pre.GenesisTime,
+		GenesisValidatorsRoot: pre.GenesisValidatorsRoot,
+		Slot:                  pre.Slot,
+		Fork: &consensus.Fork{
+			PreviousVersion: pre.Fork.CurrentVersion,
+			CurrentVersion:  Spec.ElectraForkVersion,
+			Epoch:           epoch,
+		},
+		LatestBlockHeader:             pre.LatestBlockHeader,
+		BlockRoots:                    pre.BlockRoots,
+		StateRoots:                    pre.StateRoots,
+		HistoricalRoots:               pre.HistoricalRoots,
+		Eth1Data:                      pre.Eth1Data,
+		Eth1DataVotes:                 pre.Eth1DataVotes,
+		Eth1DepositIndex:              pre.Eth1DepositIndex,
+		Validators:                    pre.Validators,
+		Balances:                      pre.Balances,
+		RandaoMixes:                   pre.RandaoMixes,
+		Slashings:                     pre.Slashings,
+		PreviousEpochParticipation:    pre.PreviousEpochParticipation,
+		CurrentEpochParticipation:     pre.CurrentEpochParticipation,
+		JustificationBits:             pre.JustificationBits,
+		PreviousJustifiedCheckpoint:   pre.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:    pre.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:           pre.FinalizedCheckpoint,
+		InactivityScores:              pre.InactivityScores,
+		CurrentSyncCommittee:          pre.CurrentSyncCommittee,
+		NextSyncCommittee:             pre.NextSyncCommittee,
+		LatestExecutionPayloadHeader:  pre.LatestExecutionPayloadHeader,
+		NextWithdrawalIndex:           pre.NextWithdrawalIndex,
+		NextWithdrawalValidatorIndex:  pre.NextWithdrawalValidatorIndex,
+		HistoricalSummaries:           pre.HistoricalSummaries,
+		DepositRequestsStartIndex:     unsetDepositRequestsStartIndex,
+		DepositBalanceToConsume:       0,
+		ExitBalanceToConsume:          0,
+		EarliestExitEpoch:             earliestExitEpoch,
+		ConsolidationBalanceToConsume: 0,
+		EarliestConsolidationEpoch:    computeActivationExitEpoch(epoch),
+		PendingDeposits:               []*consensus.PendingDeposit{},
+		PendingPartialWithdrawals:     []*consensus.PendingPartialWithdrawal{},
+		PendingConsolidations:         []*consensus.PendingConsolidation{},
+	}
+
+	post.ExitBalanceToConsume = getActivationExitChurnLimit(post.Validators, epoch)
+	post.ConsolidationBalanceToConsume = getConsolidationChurnLimit(post.Validators, epoch)
+
+	// add validators that are not yet active to the pending deposits queue
+	preActivation := []uint64{}
+	for index, val := range post.Validators {
+		if val.ActivationEpoch == farFutureEpoch {
+			preActivation = append(preActivation, uint64(index))
+		}
+	}
+	sort.SliceStable(preActivation, func(i, j int) bool {
+		valI, valJ := post.Validators[preActivation[i]], post.Validators[preActivation[j]]
+		if valI.ActivationEligibilityEpoch != valJ.ActivationEligibilityEpoch {
+			return valI.ActivationEligibilityEpoch < valJ.ActivationEligibilityEpoch
+		}
+		return preActivation[i] < preActivation[j]
+	})
+
+	for _, index := range preActivation {
+		balance := post.Balances[index]
+		post.Balances[index] = 0
+
+		val := post.Validators[index]
+		val.EffectiveBalance = 0
+		val.ActivationEligibilityEpoch = farFutureEpoch
+
+		post.PendingDeposits = append(post.PendingDeposits, &consensus.PendingDeposit{
+			Pubkey:                val.Pubkey,
+			WithdrawalCredentials: val.WithdrawalCredentials,
+			Amount:                balance,
+			Signature:             g2PointAtInfinity,
+			Slot:                  Spec.GenesisSlot,
+		})
+	}
+
+	// ensure early adopters of compounding credentials go through the activation churn
+	for index, val := range post.Validators {
+		if hasCompoundingWithdrawalCredential(val) {
+			queueExcessActiveBalance(post, uint64(index))
+		}
+	}
+
+	return post, nil
+}
+
+func hasCompoundingWithdrawalCredential(val *consensus.Validator) bool {
+	const compoundingWithdrawalPrefix = 0x02
+	return val.WithdrawalCredentials[0] == compoundingWithdrawalPrefix
+}
+
+func queueExcessActiveBalance(state *consensus.BeaconStateElectra, index uint64) {
+	balance := state.Balances[index]
+	if balance <= Spec.MinActivationBalance {
+		return
+	}
+
+	excessBalance := balance - Spec.MinActivationBalance
+	state.Balances[index] = Spec.MinActivationBalance
+
+	val := state.Validators[index]
+	state.PendingDeposits = append(state.PendingDeposits, &consensus.PendingDeposit{
+		Pubkey:                val.Pubkey,
+		WithdrawalCredentials: val.WithdrawalCredentials,
+		Amount:                excessBalance,
+		Signature:             g2PointAtInfinity,
+		Slot:                  Spec.GenesisSlot,
+	})
+}
+
+func getBalanceChurnLimit(validators []*consensus.Validator, epoch uint64) uint64 {
+	totalActiveBalance := uint64(0)
+	for _, val := range validators {
+		if isActiveValidator(val, epoch) {
+			totalActiveBalance += val.EffectiveBalance
+		}
+	}
+	totalActiveBalance = max(totalActiveBalance, Spec.EffectiveBalanceIncrement)
+
+	churn := max(Spec.MinPerEpochChurnLimitElectra, totalActiveBalance/Spec.ChurnLimitQuotient)
+	return churn - churn%Spec.EffectiveBalanceIncrement
+}
+
+func getActivationExitChurnLimit(validators []*consensus.Validator, epoch uint64) uint64 {
+	return min(Spec.MaxPerEpochActivationExitChurnLimit, getBalanceChurnLimit(validators, epoch))
+}
+
+func getConsolidationChurnLimit(validators []*consensus.Validator, epoch uint64) uint64 {
+	return getBalanceChurnLimit(validators, epoch) - getActivationExitChurnLimit(validators, epoch)
+}
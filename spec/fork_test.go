@@ -0,0 +1,181 @@
+package spec
+
+import (
+	"reflect"
+	"testing"
+
+	consensus "github.com/umbracle/go-eth-consensus"
+	"github.com/umbracle/go-eth-consensus/bls"
+)
+
+func TestUpgradeToAltair(t *testing.T) {
+	listTestData(t, "mainnet/altair/fork/fork/*/*", func(th *testHandler) {
+		var pre consensus.BeaconStatePhase0
+		var post consensus.BeaconStateAltair
+
+		th.decodeFile("pre", &pre)
+		th.decodeFile("post", &post)
+
+		res, err := UpgradeToAltair(&pre)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, &post) {
+			t.Fatal("bad")
+		}
+	})
+}
+
+func TestUpgradeToBellatrix(t *testing.T) {
+	listTestData(t, "mainnet/bellatrix/fork/fork/*/*", func(th *testHandler) {
+		var pre consensus.BeaconStateAltair
+		var post consensus.BeaconStateBellatrix
+
+		th.decodeFile("pre", &pre)
+		th.decodeFile("post", &post)
+
+		res, err := UpgradeToBellatrix(&pre)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, &post) {
+			t.Fatal("bad")
+		}
+	})
+}
+
+func TestUpgradeToCapella(t *testing.T) {
+	listTestData(t, "mainnet/capella/fork/fork/*/*", func(th *testHandler) {
+		var pre consensus.BeaconStateBellatrix
+		var post consensus.BeaconStateCapella
+
+		th.decodeFile("pre", &pre)
+		th.decodeFile("post", &post)
+
+		res, err := UpgradeToCapella(&pre)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, &post) {
+			t.Fatal("bad")
+		}
+	})
+}
+
+func TestUpgradeToDeneb(t *testing.T) {
+	listTestData(t, "mainnet/deneb/fork/fork/*/*", func(th *testHandler) {
+		var pre consensus.BeaconStateCapella
+		var post consensus.BeaconStateDeneb
+
+		th.decodeFile("pre", &pre)
+		th.decodeFile("post", &post)
+
+		res, err := UpgradeToDeneb(&pre)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, &post) {
+			t.Fatal("bad")
+		}
+	})
+}
+
+func TestUpgradeToElectra(t *testing.T) {
+	listTestData(t, "mainnet/electra/fork/fork/*/*", func(th *testHandler) {
+		var pre consensus.BeaconStateDeneb
+		var post consensus.BeaconStateElectra
+
+		th.decodeFile("pre", &pre)
+		th.decodeFile("post", &post)
+
+		res, err := UpgradeToElectra(&pre)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, &post) {
+			t.Fatal("bad")
+		}
+	})
+}
+
+func TestUpgradeToAltair_Synthetic(t *testing.T) {
+	pre := &consensus.BeaconStatePhase0{
+		Slot: 10 * Spec.SlotsPerEpoch,
+		Fork: &consensus.Fork{
+			CurrentVersion: Spec.GenesisForkVersion,
+		},
+		Slashings:                   make([]uint64, Spec.EpochsPerSlashingsVector),
+		PreviousJustifiedCheckpoint: &consensus.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &consensus.Checkpoint{},
+		FinalizedCheckpoint:         &consensus.Checkpoint{},
+	}
+	for i := 0; i < 8; i++ {
+		val := &consensus.Validator{
+			Pubkey:           bls.RandomKey().GetPublicKey().Serialize(),
+			EffectiveBalance: Spec.MaxEffectiveBalance,
+			ExitEpoch:        farFutureEpoch,
+		}
+		pre.Validators = append(pre.Validators, val)
+		pre.Balances = append(pre.Balances, Spec.MaxEffectiveBalance)
+	}
+
+	post, err := UpgradeToAltair(pre)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.Fork.PreviousVersion != pre.Fork.CurrentVersion || post.Fork.CurrentVersion != Spec.AltairForkVersion || post.Fork.Epoch != 10 {
+		t.Fatal("bad fork")
+	}
+	if len(post.PreviousEpochParticipation) != 8 || len(post.InactivityScores) != 8 {
+		t.Fatal("bad participation")
+	}
+	if *post.CurrentSyncCommittee != *post.NextSyncCommittee {
+		t.Fatal("sync committees should match")
+	}
+	if _, err := post.HashTreeRoot(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpgradeToElectra_Synthetic(t *testing.T) {
+	pre := &consensus.BeaconStateDeneb{
+		Slot: 100 * Spec.SlotsPerEpoch,
+		Fork: &consensus.Fork{
+			CurrentVersion: Spec.DenebForkVersion,
+		},
+		Validators: []*consensus.Validator{
+			// active validator with an exit scheduled
+			{EffectiveBalance: Spec.MaxEffectiveBalance, ExitEpoch: 50},
+			// pending validators
+			{ActivationEligibilityEpoch: 20, ActivationEpoch: farFutureEpoch, ExitEpoch: farFutureEpoch},
+			{ActivationEligibilityEpoch: 10, ActivationEpoch: farFutureEpoch, ExitEpoch: farFutureEpoch},
+			// compounding validator with excess balance
+			{EffectiveBalance: Spec.MaxEffectiveBalance, ExitEpoch: farFutureEpoch, WithdrawalCredentials: [32]byte{0x02}},
+		},
+		Balances: []uint64{Spec.MaxEffectiveBalance, 1, 2, Spec.MinActivationBalance + 5},
+	}
+
+	post, err := UpgradeToElectra(pre)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// max(compute_activation_exit_epoch(100), 50) + 1
+	if post.EarliestExitEpoch != 106 {
+		t.Fatalf("bad earliest exit epoch %d", post.EarliestExitEpoch)
+	}
+	if post.ExitBalanceToConsume != Spec.MinPerEpochChurnLimitElectra || post.ConsolidationBalanceToConsume != 0 {
+		t.Fatal("bad churn")
+	}
+
+	amounts := []uint64{}
+	for _, deposit := range post.PendingDeposits {
+		amounts = append(amounts, deposit.Amount)
+	}
+	// pending validators are sorted by activation eligibility epoch
+	if !reflect.DeepEqual(amounts, []uint64{2, 1, 5}) {
+		t.Fatalf("bad pending deposits %v", amounts)
+	}
+	if !reflect.DeepEqual(post.Balances, []uint64{Spec.MaxEffectiveBalance, 0, 0, Spec.MinActivationBalance}) {
+		t.Fatalf("bad balances %v", post.Balances)
+	}
+}
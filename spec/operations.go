@@ -334,7 +334,7 @@ func ProcessDeposit(state *consensus.BeaconStatePhase0, depositObj *consensus.De
 	if err != nil {
 		return err
 	}
-	if !isValidMerkleBranch(depositRoot, depositObj.Proof, depositContractTreeDepth+1, state.Eth1DepositIndex, state.Eth1Data.DepositRoot) {
+	if !consensus.VerifyMerkleBranch(depositRoot, depositObj.Proof[:], depositContractTreeDepth+1, state.Eth1DepositIndex, state.Eth1Data.DepositRoot) {
 		return fmt.Errorf("bad merkle root")
 	}
 
@@ -387,20 +387,6 @@ func isInValidatorSet(state *consensus.BeaconStatePhase0, pubKey [48]byte) (uint
 	return 0, false
 }
 
-func isValidMerkleBranch(leaf [32]byte, proof [33][32]byte, depth, index uint64, root [32]byte) bool {
-	value := leaf
-
-	for i := uint64(0); i < depth; i++ {
-		if (index>>i)&1 == 1 {
-			value = sha256.Sum256(append(proof[i][:], value[:]...))
-		} else {
-			value = sha256.Sum256(append(value[:], proof[i][:]...))
-		}
-	}
-
-	return bytes.Equal(value[:], root[:])
-}
-
 func isSlashableValidator(validator *consensus.Validator, epoch uint64) bool {
 	return !validator.Slashed && validator.ActivationEpoch <= epoch && epoch < validator.WithdrawableEpoch
 }
@@ -32,4 +32,33 @@ var Spec = &consensus.Spec{
 	HysteresisUpwardMultiplier:       5,
 	EjectionBalance:                  16000000000, // Gwei(2**4 * 10**9)
 	InactivityPenaltyQuotient:        67108864,    // Gwei(2**26)
+	SyncCommitteeSize:                512,
+	MaxValidatorsPerCommittee:        2048,
+	ShuffleRoundCount:                90,
+	MinDepositAmount:                 1000000000,
+	HistoricalRootsLimit:             16777216,
+	ValidatorRegistryLimit:           1099511627776,
+	MaxProposerSlashings:             16,
+	MaxAttesterSlashings:             2,
+	MaxAttestations:                  128,
+	MaxDeposits:                      16,
+	MaxVoluntaryExits:                16,
+
+	// electra
+	MinActivationBalance:                32000000000,  // Gwei(2**5 * 10**9)
+	MinPerEpochChurnLimitElectra:        128000000000, // Gwei(2**7 * 10**9)
+	MaxPerEpochActivationExitChurnLimit: 256000000000, // Gwei(2**8 * 10**9)
+
+	// fork schedule
+	GenesisForkVersion:   consensus.Domain{0x00, 0x00, 0x00, 0x00},
+	AltairForkVersion:    consensus.Domain{0x01, 0x00, 0x00, 0x00},
+	AltairForkEpoch:      74240,
+	BellatrixForkVersion: consensus.Domain{0x02, 0x00, 0x00, 0x00},
+	BellatrixForkEpoch:   144896,
+	CapellaForkVersion:   consensus.Domain{0x03, 0x00, 0x00, 0x00},
+	CapellaForkEpoch:     194048,
+	DenebForkVersion:     consensus.Domain{0x04, 0x00, 0x00, 0x00},
+	DenebForkEpoch:       269568,
+	ElectraForkVersion:   consensus.Domain{0x05, 0x00, 0x00, 0x00},
+	ElectraForkEpoch:     364032,
 }
@@ -11,7 +11,7 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-//go:embed presets/phase0.yaml
+//go:embed presets/mainnet/phase0.yaml
 var mainnetPresetPhase0 []byte
 
 func TestPresetMainnet(t *testing.T) {
@@ -0,0 +1,43 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_Merge(t *testing.T) {
+	// values as returned by the beacon api
+	values := map[string]string{
+		"SECONDS_PER_SLOT":          "12",
+		"ALTAIR_FORK_VERSION":       "0x01000000",
+		"PRESET_BASE":               "mainnet",
+		"TERMINAL_TOTAL_DIFFICULTY": "58750000000000000000000",
+		"DEPOSIT_CONTRACT_ADDRESS":  "0x00000000219ab540356cBB839Cbe05303d7705Fa",
+		"DOMAIN_DEPOSIT":            "0x03000000",
+	}
+
+	spec := &Spec{SlotsPerEpoch: 32}
+	require.NoError(t, spec.Merge(values))
+
+	assert.Equal(t, uint64(12), spec.SecondsPerSlot)
+	assert.Equal(t, uint64(32), spec.SlotsPerEpoch)
+	assert.Equal(t, Domain{0x1, 0x0, 0x0, 0x0}, spec.AltairForkVersion)
+	assert.Equal(t, "mainnet", spec.PresetBase)
+	assert.Equal(t, byte(0xfa), spec.DepositContractAddress[19])
+
+	ttd, err := spec.TerminalTotalDifficulty.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "58750000000000000000000", string(ttd))
+
+	cases := []map[string]string{
+		{"SECONDS_PER_SLOT": "-1"},
+		{"SECONDS_PER_SLOT": "0x1"},
+		{"ALTAIR_FORK_VERSION": "0x010000"},
+		{"DEPOSIT_CONTRACT_ADDRESS": "00000000219ab540356cBB839Cbe05303d7705Fa"},
+	}
+	for _, c := range cases {
+		assert.Error(t, spec.Merge(c), c)
+	}
+}
@@ -0,0 +1,416 @@
+package consensus
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"reflect"
+)
+
+// StateHasher computes the hash tree root of beacon states and caches
+// the roots of every field, of every element in the lists of containers
+// (i.e. validators) and of the intermediate nodes of the lists and vectors
+// (i.e. balances or block roots) between calls. Only the leaves that changed
+// since the previous call (for example, after a state transition mutated the
+// state in place) and their ancestors are rehashed.
+// A StateHasher is not safe for concurrent use.
+type StateHasher struct {
+	typ    reflect.Type
+	schema *sszType
+	fields []*fieldHasher
+}
+
+// NewStateHasher returns an empty state hasher
+func NewStateHasher() *StateHasher {
+	return &StateHasher{}
+}
+
+// Reset clears the cached roots
+func (s *StateHasher) Reset() {
+	s.typ = nil
+	s.schema = nil
+	s.fields = nil
+}
+
+// HashTreeRoot returns the hash tree root of the state. The caches
+// are reset if the fork of the state changes.
+func (s *StateHasher) HashTreeRoot(state BeaconState) ([32]byte, error) {
+	if isNil(state) {
+		return [32]byte{}, fmt.Errorf("empty state")
+	}
+
+	typ := reflect.TypeOf(state)
+	if typ != s.typ {
+		schema, err := sszTypeOf(typ)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		s.typ = typ
+		s.schema = schema
+		s.fields = make([]*fieldHasher, len(schema.fields))
+		for indx, field := range schema.fields {
+			s.fields[indx] = &fieldHasher{typ: field.typ}
+		}
+	}
+
+	obj := reflect.ValueOf(state).Elem()
+
+	roots := make([][32]byte, len(s.fields))
+	for indx, field := range s.schema.fields {
+		root, err := s.fields[indx].hash(obj.Field(field.index))
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("field '%s': %v", field.name, err)
+		}
+		roots[indx] = root
+	}
+	return merkleizeLeaves(roots, uint64(len(roots))), nil
+}
+
+// fieldHasher computes and caches the root of a field of the state
+type fieldHasher struct {
+	typ *sszType
+
+	// tree is the merkle tree of lists and vectors
+	tree merkleTree
+
+	// elems are the cached roots of the elements of a list
+	// or vector of containers
+	elems []cachedRoot
+
+	// obj is the cached root of a container field
+	obj cachedRoot
+
+	// buf is a scratch buffer to marshal the containers
+	buf []byte
+}
+
+func (f *fieldHasher) hash(v reflect.Value) ([32]byte, error) {
+	typ := f.typ
+
+	switch typ.kind {
+	case sszBasic:
+		var leaf [32]byte
+		if v.Kind() == reflect.Bool {
+			if v.Bool() {
+				leaf[0] = 1
+			}
+		} else {
+			binary.LittleEndian.PutUint64(leaf[:8], v.Uint())
+		}
+		return leaf, nil
+
+	case sszBytes:
+		buf := valueBytes(v)
+		if uint64(len(buf)) != typ.size {
+			return [32]byte{}, fmt.Errorf("incorrect size %d, expected %d", len(buf), typ.size)
+		}
+		return merkleizeLeaves(packBytes(buf), typ.chunkCount()), nil
+
+	case sszByteList:
+		buf := valueBytes(v)
+		if uint64(len(buf)) > typ.limit {
+			return [32]byte{}, fmt.Errorf("list too big %d, limit %d", len(buf), typ.limit)
+		}
+		root := f.tree.update(packBytes(buf), typ.chunkCount())
+		return mixInLength(root, uint64(len(buf))), nil
+
+	case sszVector, sszList:
+		num := uint64(v.Len())
+		if typ.kind == sszVector && num != typ.size {
+			return [32]byte{}, fmt.Errorf("incorrect size %d, expected %d", num, typ.size)
+		}
+		if typ.kind == sszList && num > typ.limit {
+			return [32]byte{}, fmt.Errorf("list too big %d, limit %d", num, typ.limit)
+		}
+
+		leaves, err := f.leaves(v)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		root := f.tree.update(leaves, typ.chunkCount())
+		if typ.kind == sszList {
+			root = mixInLength(root, num)
+		}
+		return root, nil
+
+	case sszContainer:
+		return f.obj.hash(v, &f.buf)
+
+	default:
+		return [32]byte{}, fmt.Errorf("type not supported")
+	}
+}
+
+// leaves returns the leaves of the merkle tree of a list or a vector
+func (f *fieldHasher) leaves(v reflect.Value) ([][32]byte, error) {
+	elem := f.typ.elem
+	num := v.Len()
+
+	switch {
+	case elem.kind == sszBasic:
+		// pack the basic values
+		var buf []byte
+		if vals, ok := v.Interface().([]uint64); ok {
+			buf = make([]byte, 8*len(vals))
+			for i, val := range vals {
+				binary.LittleEndian.PutUint64(buf[8*i:], val)
+			}
+		} else {
+			buf = make([]byte, 0, uint64(num)*elem.size)
+			for i := 0; i < num; i++ {
+				item := v.Index(i)
+				if item.Kind() == reflect.Bool {
+					if item.Bool() {
+						buf = append(buf, 1)
+					} else {
+						buf = append(buf, 0)
+					}
+				} else {
+					var tmp [8]byte
+					binary.LittleEndian.PutUint64(tmp[:], item.Uint())
+					buf = append(buf, tmp[:elem.size]...)
+				}
+			}
+		}
+		return packBytes(buf), nil
+
+	case elem.kind == sszBytes && elem.size == 32:
+		// the elements are the leaves
+		leaves := make([][32]byte, num)
+		if v.Type().Elem().Kind() == reflect.Array {
+			reflect.Copy(reflect.ValueOf(leaves), v)
+		} else {
+			for i := 0; i < num; i++ {
+				buf := v.Index(i).Bytes()
+				if len(buf) != 32 {
+					return nil, fmt.Errorf("incorrect size %d at index %d, expected 32", len(buf), i)
+				}
+				copy(leaves[i][:], buf)
+			}
+		}
+		return leaves, nil
+
+	case elem.kind == sszBytes:
+		leaves := make([][32]byte, num)
+		for i := 0; i < num; i++ {
+			buf := valueBytes(v.Index(i))
+			if uint64(len(buf)) != elem.size {
+				return nil, fmt.Errorf("incorrect size %d at index %d, expected %d", len(buf), i, elem.size)
+			}
+			leaves[i] = merkleizeLeaves(packBytes(buf), elem.chunkCount())
+		}
+		return leaves, nil
+
+	case elem.kind == sszContainer:
+		if len(f.elems) > num {
+			f.elems = f.elems[:num]
+		}
+		for len(f.elems) < num {
+			f.elems = append(f.elems, cachedRoot{})
+		}
+
+		leaves := make([][32]byte, num)
+		for i := 0; i < num; i++ {
+			root, err := f.elems[i].hash(v.Index(i), &f.buf)
+			if err != nil {
+				return nil, fmt.Errorf("index %d: %v", i, err)
+			}
+			leaves[i] = root
+		}
+		return leaves, nil
+
+	default:
+		return nil, fmt.Errorf("elements not supported")
+	}
+}
+
+// cachedRoot is the root of a container and its ssz encoding.
+// The root is only computed again if the encoding changes.
+type cachedRoot struct {
+	enc  []byte
+	root [32]byte
+}
+
+type cachedObject interface {
+	MarshalSSZTo(buf []byte) ([]byte, error)
+	HashTreeRoot() ([32]byte, error)
+}
+
+func (c *cachedRoot) hash(v reflect.Value, scratch *[]byte) ([32]byte, error) {
+	if v.Kind() != reflect.Ptr {
+		v = v.Addr()
+	} else if v.IsNil() {
+		// like the generated code, nil containers are hashed as empty objects
+		v = reflect.New(v.Type().Elem())
+	}
+	obj, ok := v.Interface().(cachedObject)
+	if !ok {
+		return [32]byte{}, fmt.Errorf("type %s is not an ssz object", v.Type())
+	}
+
+	enc, err := obj.MarshalSSZTo((*scratch)[:0])
+	if err != nil {
+		return [32]byte{}, err
+	}
+	*scratch = enc
+
+	if c.enc != nil && bytes.Equal(c.enc, enc) {
+		return c.root, nil
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	c.enc = append(c.enc[:0], enc...)
+	c.root = root
+	return root, nil
+}
+
+// merkleTree is a merkle tree that stores all the intermediate layers
+// and only recomputes the branches of the leaves that change
+type merkleTree struct {
+	// layers[0] are the leaves and the last layer has a single node
+	layers [][][32]byte
+}
+
+// update replaces the leaves of the tree and returns the root
+// of the tree with the given limit of leaves
+func (m *merkleTree) update(leaves [][32]byte, limit uint64) [32]byte {
+	depth := treeDepth(limit)
+	if len(leaves) == 0 {
+		m.layers = nil
+		return zeroHashes[depth]
+	}
+
+	if len(m.layers) == 0 || len(leaves) < len(m.layers[0]) {
+		// rebuild the whole tree
+		m.layers = [][][32]byte{leaves}
+		for layer := leaves; len(layer) > 1; {
+			next := make([][32]byte, (len(layer)+1)/2)
+			for i := range next {
+				next[i] = hashChildren(layer, 2*i, len(m.layers)-1)
+			}
+			m.layers = append(m.layers, next)
+			layer = next
+		}
+	} else {
+		// find the leaves that changed
+		prev := m.layers[0]
+		dirty := []int{}
+		for i := range leaves {
+			if i >= len(prev) || leaves[i] != prev[i] {
+				dirty = append(dirty, i)
+			}
+		}
+		m.layers[0] = leaves
+
+		for level := 1; len(m.layers[level-1]) > 1; level++ {
+			size := (len(m.layers[level-1]) + 1) / 2
+			if level == len(m.layers) {
+				m.layers = append(m.layers, nil)
+			}
+			layer := m.layers[level]
+			oldSize := len(layer)
+			for len(layer) < size {
+				layer = append(layer, [32]byte{})
+			}
+			m.layers[level] = layer
+
+			parents := []int{}
+			for _, i := range dirty {
+				if parent := i / 2; len(parents) == 0 || parents[len(parents)-1] != parent {
+					parents = append(parents, parent)
+				}
+			}
+			// the new nodes of the layer are always computed
+			for i := oldSize; i < size; i++ {
+				if len(parents) == 0 || parents[len(parents)-1] < i {
+					parents = append(parents, i)
+				}
+			}
+			for _, i := range parents {
+				layer[i] = hashChildren(m.layers[level-1], 2*i, level-1)
+			}
+			dirty = parents
+		}
+		m.layers = m.layers[:topLayer(m.layers)+1]
+	}
+
+	// hash the top of the tree with the zero branches up to the depth
+	height := uint64(len(m.layers) - 1)
+	root := m.layers[height][0]
+	for ; height < depth; height++ {
+		root = hashPair(root, zeroHashes[height])
+	}
+	return root
+}
+
+func topLayer(layers [][][32]byte) int {
+	for i, layer := range layers {
+		if len(layer) == 1 {
+			return i
+		}
+	}
+	return len(layers) - 1
+}
+
+func hashChildren(layer [][32]byte, left int, height int) [32]byte {
+	if left+1 < len(layer) {
+		return hashPair(layer[left], layer[left+1])
+	}
+	return hashPair(layer[left], zeroHashes[height])
+}
+
+// zeroHashes are the roots of the empty trees of each depth
+var zeroHashes [65][32]byte
+
+func init() {
+	for i := 1; i < len(zeroHashes); i++ {
+		zeroHashes[i] = hashPair(zeroHashes[i-1], zeroHashes[i-1])
+	}
+}
+
+func hashPair(a, b [32]byte) [32]byte {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return sha256.Sum256(buf[:])
+}
+
+func treeDepth(limit uint64) uint64 {
+	return uint64(bits.Len64(nextPowerOfTwo(limit) - 1))
+}
+
+func mixInLength(root [32]byte, num uint64) [32]byte {
+	var length [32]byte
+	binary.LittleEndian.PutUint64(length[:8], num)
+	return hashPair(root, length)
+}
+
+// merkleizeLeaves returns the root of the tree with the given leaves
+// and the given limit of leaves
+func merkleizeLeaves(leaves [][32]byte, limit uint64) [32]byte {
+	var tree merkleTree
+	return tree.update(leaves, limit)
+}
+
+// packBytes splits the buffer in 32 bytes chunks padded with zeros
+func packBytes(buf []byte) [][32]byte {
+	leaves := make([][32]byte, (len(buf)+31)/32)
+	for i := range leaves {
+		copy(leaves[i][:], buf[32*i:])
+	}
+	return leaves
+}
+
+// valueBytes returns the bytes of a byte slice or array
+func valueBytes(v reflect.Value) []byte {
+	if v.Kind() == reflect.Slice {
+		return v.Bytes()
+	}
+	buf := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(buf), v)
+	return buf
+}
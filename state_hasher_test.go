@@ -0,0 +1,109 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateHasher(t *testing.T) {
+	hasher := NewStateHasher()
+
+	checkRoot := func(state BeaconState) {
+		t.Helper()
+
+		expected, err := state.HashTreeRoot()
+		require.NoError(t, err)
+
+		found, err := hasher.HashTreeRoot(state)
+		require.NoError(t, err)
+		require.Equal(t, expected, found)
+	}
+
+	state := &BeaconStatePhase0{
+		Slot:      10,
+		Slashings: make([]uint64, 8192),
+	}
+	checkRoot(state)
+
+	// append validators in several steps to grow the tree
+	for _, num := range []int{1, 2, 3, 5, 17} {
+		for i := 0; i < num; i++ {
+			state.Validators = append(state.Validators, &Validator{EffectiveBalance: uint64(i)})
+			state.Balances = append(state.Balances, uint64(i))
+		}
+		checkRoot(state)
+	}
+
+	// mutate the state in place
+	state.Balances[3] = 100
+	state.Validators[10].Slashed = true
+	state.RandaoMixes[1000] = [32]byte{0x1}
+	state.BlockRoots[8191] = [32]byte{0x2}
+	state.Slashings[5] = 10
+	state.Fork = &Fork{Epoch: 1}
+	checkRoot(state)
+
+	// nothing changed
+	checkRoot(state)
+
+	// shrink the lists
+	state.Eth1DataVotes = []*Eth1Data{{DepositCount: 1}, {DepositCount: 2}, {DepositCount: 3}}
+	checkRoot(state)
+
+	state.Eth1DataVotes = []*Eth1Data{}
+	state.Validators = state.Validators[:4]
+	state.Balances = state.Balances[:4]
+	checkRoot(state)
+
+	// the hasher is reset with a state of a different fork
+	electra := &BeaconStateElectra{
+		Slashings:                    make([]uint64, 8192),
+		HistoricalRoots:              [][]byte{make([]byte, 32)},
+		PreviousEpochParticipation:   []byte{0x1, 0x2},
+		InactivityScores:             []uint64{1, 2, 3},
+		PendingDeposits:              []*PendingDeposit{{Amount: 1}},
+		LatestExecutionPayloadHeader: &ExecutionPayloadHeaderDeneb{},
+	}
+	checkRoot(electra)
+
+	electra.PreviousEpochParticipation = append(electra.PreviousEpochParticipation, make([]byte, 100)...)
+	electra.PreviousEpochParticipation[50] = 1
+	electra.InactivityScores[1] = 5
+	electra.HistoricalRoots = append(electra.HistoricalRoots, make([]byte, 32))
+	electra.PendingDeposits = append(electra.PendingDeposits, &PendingDeposit{Amount: 2})
+	electra.LatestExecutionPayloadHeader = &ExecutionPayloadHeaderDeneb{BlockNumber: 1}
+	checkRoot(electra)
+
+	electra.PendingDeposits = nil
+	checkRoot(electra)
+}
+
+func BenchmarkStateHasher(b *testing.B) {
+	state := &BeaconStateCapella{
+		Slashings:                    make([]uint64, 8192),
+		LatestExecutionPayloadHeader: &ExecutionPayloadHeaderCapella{},
+	}
+	for i := 0; i < 100000; i++ {
+		state.Validators = append(state.Validators, &Validator{EffectiveBalance: uint64(i)})
+		state.Balances = append(state.Balances, uint64(i))
+		state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, 1)
+		state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, 1)
+		state.InactivityScores = append(state.InactivityScores, 0)
+	}
+
+	hasher := NewStateHasher()
+	if _, err := hasher.HashTreeRoot(state); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state.Slot++
+		state.Balances[i%len(state.Balances)]++
+
+		if _, err := hasher.HashTreeRoot(state); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
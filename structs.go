@@ -38,8 +38,8 @@ type DepositData struct {
 }
 
 type Deposit struct {
-	Proof [33][32]byte `ssz-size:"33,32"`
-	Data  *DepositData
+	Proof [33][32]byte `json:"proof" ssz-size:"33,32"`
+	Data  *DepositData `json:"data"`
 }
 
 type DepositMessage struct {
@@ -190,6 +190,12 @@ type ForkData struct {
 	GenesisValidatorsRoot Root    `json:"genesis_validators_root" ssz-size:"32"`
 }
 
+type ENRForkID struct {
+	ForkDigest      [4]byte `json:"fork_digest" ssz-size:"4"`
+	NextForkVersion [4]byte `json:"next_fork_version" ssz-size:"4"`
+	NextForkEpoch   uint64  `json:"next_fork_epoch"`
+}
+
 type SigningData struct {
 	ObjectRoot Root     `json:"object_root" ssz-size:"32"`
 	Domain     [32]byte `json:"domain" ssz-size:"32"`
@@ -579,6 +585,33 @@ type BeaconBlockBodyCapella struct {
 	BlsToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes" ssz-max:"16"`
 }
 
+type SignedBlindedBeaconBlockCapella struct {
+	Block     *BlindedBeaconBlockCapella `json:"message"`
+	Signature Signature                  `json:"signature" ssz-size:"96"`
+}
+
+type BlindedBeaconBlockCapella struct {
+	Slot          uint64                         `json:"slot"`
+	ProposerIndex uint64                         `json:"proposer_index"`
+	ParentRoot    Root                           `json:"parent_root" ssz-size:"32"`
+	StateRoot     Root                           `json:"state_root" ssz-size:"32"`
+	Body          *BlindedBeaconBlockBodyCapella `json:"body"`
+}
+
+type BlindedBeaconBlockBodyCapella struct {
+	RandaoReveal           Signature                      `json:"randao_reveal" ssz-size:"96"`
+	Eth1Data               *Eth1Data                      `json:"eth1_data"`
+	Graffiti               [32]byte                       `json:"graffiti" ssz-size:"32"`
+	ProposerSlashings      []*ProposerSlashing            `json:"proposer_slashings" ssz-max:"16"`
+	AttesterSlashings      []*AttesterSlashing            `json:"attester_slashings" ssz-max:"2"`
+	Attestations           []*Attestation                 `json:"attestations" ssz-max:"128"`
+	Deposits               []*Deposit                     `json:"deposits" ssz-max:"16"`
+	VoluntaryExits         []*SignedVoluntaryExit         `json:"voluntary_exits" ssz-max:"16"`
+	SyncAggregate          *SyncAggregate                 `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeaderCapella `json:"execution_payload_header"`
+	BlsToExecutionChanges  []*SignedBLSToExecutionChange  `json:"bls_to_execution_changes" ssz-max:"16"`
+}
+
 type LightClientHeaderCapella struct {
 	Header          *BeaconBlockHeader             `json:"beacon"`
 	Execution       *ExecutionPayloadHeaderCapella `json:"execution"`
@@ -614,3 +647,398 @@ type LightClientUpdateCapella struct {
 	SyncAggregate           *SyncAggregate            `json:"sync_aggregate"`
 	SignatureSlot           uint64                    `json:"signature_slot"`
 }
+
+// Deneb types
+
+type ExecutionPayloadDeneb struct {
+	ParentHash    [32]byte      `ssz-size:"32" json:"parent_hash"`
+	FeeRecipient  [20]byte      `ssz-size:"20" json:"fee_recipient"`
+	StateRoot     [32]byte      `ssz-size:"32" json:"state_root"`
+	ReceiptsRoot  [32]byte      `ssz-size:"32" json:"receipts_root"`
+	LogsBloom     [256]byte     `ssz-size:"256" json:"logs_bloom"`
+	PrevRandao    [32]byte      `ssz-size:"32" json:"prev_randao"`
+	BlockNumber   uint64        `json:"block_number"`
+	GasLimit      uint64        `json:"gas_limit"`
+	GasUsed       uint64        `json:"gas_used"`
+	Timestamp     uint64        `json:"timestamp"`
+	ExtraData     []byte        `ssz-max:"32" json:"extra_data"`
+	BaseFeePerGas Uint256       `ssz-size:"32" json:"base_fee_per_gas"`
+	BlockHash     [32]byte      `ssz-size:"32" json:"block_hash"`
+	Transactions  [][]byte      `ssz-max:"1048576,1073741824" ssz-size:"?,?" json:"transactions"`
+	Withdrawals   []*Withdrawal `json:"withdrawals" ssz-max:"16"`
+	BlobGasUsed   uint64        `json:"blob_gas_used"`
+	ExcessBlobGas uint64        `json:"excess_blob_gas"`
+}
+
+type ExecutionPayloadHeaderDeneb struct {
+	ParentHash       [32]byte  `json:"parent_hash" ssz-size:"32"`
+	FeeRecipient     [20]byte  `json:"fee_recipient" ssz-size:"20"`
+	StateRoot        [32]byte  `json:"state_root" ssz-size:"32"`
+	ReceiptsRoot     [32]byte  `json:"receipts_root" ssz-size:"32"`
+	LogsBloom        [256]byte `json:"logs_bloom" ssz-size:"256"`
+	PrevRandao       [32]byte  `json:"prev_randao" ssz-size:"32"`
+	BlockNumber      uint64    `json:"block_number"`
+	GasLimit         uint64    `json:"gas_limit"`
+	GasUsed          uint64    `json:"gas_used"`
+	Timestamp        uint64    `json:"timestamp"`
+	ExtraData        []byte    `json:"extra_data" ssz-max:"32"`
+	BaseFeePerGas    Uint256   `json:"base_fee_per_gas" ssz-size:"32"`
+	BlockHash        [32]byte  `json:"block_hash" ssz-size:"32"`
+	TransactionsRoot [32]byte  `json:"transactions_root" ssz-size:"32"`
+	WithdrawalRoot   [32]byte  `json:"withdrawals_root" ssz-size:"32"`
+	BlobGasUsed      uint64    `json:"blob_gas_used"`
+	ExcessBlobGas    uint64    `json:"excess_blob_gas"`
+}
+
+type BeaconStateDeneb struct {
+	GenesisTime                  uint64                       `json:"genesis_time"`
+	GenesisValidatorsRoot        [32]byte                     `json:"genesis_validators_root" ssz-size:"32"`
+	Slot                         uint64                       `json:"slot"`
+	Fork                         *Fork                        `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeader           `json:"latest_block_header"`
+	BlockRoots                   [8192][32]byte               `json:"block_roots" ssz-size:"8192,32"`
+	StateRoots                   [8192][32]byte               `json:"state_roots" ssz-size:"8192,32"`
+	HistoricalRoots              [][]byte                     `json:"historical_roots" ssz-max:"16777216" ssz-size:"?,32"`
+	Eth1Data                     *Eth1Data                    `json:"eth1_data"`
+	Eth1DataVotes                []*Eth1Data                  `json:"eth1_data_votes" ssz-max:"2048"`
+	Eth1DepositIndex             uint64                       `json:"eth1_deposit_index"`
+	Validators                   []*Validator                 `json:"validators" ssz-max:"1099511627776"`
+	Balances                     []uint64                     `json:"balances" ssz-max:"1099511627776"`
+	RandaoMixes                  [65536][32]byte              `json:"randao_mixes" ssz-size:"65536,32"`
+	Slashings                    []uint64                     `json:"slashings" ssz-size:"8192"`
+	PreviousEpochParticipation   []byte                       `json:"previous_epoch_participation" ssz-max:"1099511627776"`
+	CurrentEpochParticipation    []byte                       `json:"current_epoch_participation" ssz-max:"1099511627776"`
+	JustificationBits            [1]byte                      `json:"justification_bits" ssz-size:"1"`
+	PreviousJustifiedCheckpoint  *Checkpoint                  `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *Checkpoint                  `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *Checkpoint                  `json:"finalized_checkpoint"`
+	InactivityScores             []uint64                     `json:"inactivity_scores" ssz-max:"1099511627776"`
+	CurrentSyncCommittee         *SyncCommittee               `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommittee               `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeaderDeneb `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex          uint64                       `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex uint64                       `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries          []*HistoricalSummary         `json:"historical_summaries" ssz-max:"16777216"`
+}
+
+type SignedBeaconBlockDeneb struct {
+	Block     *BeaconBlockDeneb `json:"message"`
+	Signature Signature         `json:"signature" ssz-size:"96"`
+}
+
+type BeaconBlockDeneb struct {
+	Slot          uint64                `json:"slot"`
+	ProposerIndex uint64                `json:"proposer_index"`
+	ParentRoot    Root                  `json:"parent_root" ssz-size:"32"`
+	StateRoot     Root                  `json:"state_root" ssz-size:"32"`
+	Body          *BeaconBlockBodyDeneb `json:"body"`
+}
+
+type BeaconBlockBodyDeneb struct {
+	RandaoReveal          Signature                     `json:"randao_reveal" ssz-size:"96"`
+	Eth1Data              *Eth1Data                     `json:"eth1_data"`
+	Graffiti              [32]byte                      `json:"graffiti" ssz-size:"32"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings" ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings" ssz-max:"2"`
+	Attestations          []*Attestation                `json:"attestations" ssz-max:"128"`
+	Deposits              []*Deposit                    `json:"deposits" ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits" ssz-max:"16"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayloadDeneb        `json:"execution_payload"`
+	BlsToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes" ssz-max:"16"`
+	BlobKzgCommitments    [][48]byte                    `json:"blob_kzg_commitments" ssz-max:"4096" ssz-size:"?,48"`
+}
+
+type BlobSidecar struct {
+	Index                       uint64                   `json:"index"`
+	Blob                        [131072]byte             `json:"blob" ssz-size:"131072"`
+	KzgCommitment               [48]byte                 `json:"kzg_commitment" ssz-size:"48"`
+	KzgProof                    [48]byte                 `json:"kzg_proof" ssz-size:"48"`
+	SignedBlockHeader           *SignedBeaconBlockHeader `json:"signed_block_header"`
+	KzgCommitmentInclusionProof [17][32]byte             `json:"kzg_commitment_inclusion_proof" ssz-size:"17,32"`
+}
+
+type BlobIdentifier struct {
+	BlockRoot Root   `json:"block_root" ssz-size:"32"`
+	Index     uint64 `json:"index"`
+}
+
+type SignedBlindedBeaconBlockDeneb struct {
+	Block     *BlindedBeaconBlockDeneb `json:"message"`
+	Signature Signature                `json:"signature" ssz-size:"96"`
+}
+
+type BlindedBeaconBlockDeneb struct {
+	Slot          uint64                       `json:"slot"`
+	ProposerIndex uint64                       `json:"proposer_index"`
+	ParentRoot    Root                         `json:"parent_root" ssz-size:"32"`
+	StateRoot     Root                         `json:"state_root" ssz-size:"32"`
+	Body          *BlindedBeaconBlockBodyDeneb `json:"body"`
+}
+
+type BlindedBeaconBlockBodyDeneb struct {
+	RandaoReveal           Signature                     `json:"randao_reveal" ssz-size:"96"`
+	Eth1Data               *Eth1Data                     `json:"eth1_data"`
+	Graffiti               [32]byte                      `json:"graffiti" ssz-size:"32"`
+	ProposerSlashings      []*ProposerSlashing           `json:"proposer_slashings" ssz-max:"16"`
+	AttesterSlashings      []*AttesterSlashing           `json:"attester_slashings" ssz-max:"2"`
+	Attestations           []*Attestation                `json:"attestations" ssz-max:"128"`
+	Deposits               []*Deposit                    `json:"deposits" ssz-max:"16"`
+	VoluntaryExits         []*SignedVoluntaryExit        `json:"voluntary_exits" ssz-max:"16"`
+	SyncAggregate          *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeaderDeneb  `json:"execution_payload_header"`
+	BlsToExecutionChanges  []*SignedBLSToExecutionChange `json:"bls_to_execution_changes" ssz-max:"16"`
+	BlobKzgCommitments     [][48]byte                    `json:"blob_kzg_commitments" ssz-max:"4096" ssz-size:"?,48"`
+}
+
+type BlobsBundle struct {
+	Commitments [][48]byte     `json:"commitments" ssz-max:"4096" ssz-size:"?,48"`
+	Proofs      [][48]byte     `json:"proofs" ssz-max:"4096" ssz-size:"?,48"`
+	Blobs       [][131072]byte `json:"blobs" ssz-max:"4096" ssz-size:"?,131072"`
+}
+
+type ExecutionPayloadAndBlobsBundle struct {
+	ExecutionPayload *ExecutionPayloadDeneb `json:"execution_payload"`
+	BlobsBundle      *BlobsBundle           `json:"blobs_bundle"`
+}
+
+type LightClientHeaderDeneb struct {
+	Header          *BeaconBlockHeader           `json:"beacon"`
+	Execution       *ExecutionPayloadHeaderDeneb `json:"execution"`
+	ExecutionBranch [4][32]byte                  `json:"execution_branch" ssz-size:"4,32"`
+}
+
+type LightClientBootstrapDeneb struct {
+	Header                     *LightClientHeaderDeneb `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee          `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch [][32]byte              `json:"current_sync_committee_branch" ssz-size:"5,32"`
+}
+
+type LightClientFinalityUpdateDeneb struct {
+	AttestedHeader  *LightClientHeaderDeneb `json:"attested_header"`
+	FinalizedHeader *LightClientHeaderDeneb `json:"finalized_header"`
+	FinalityBranch  [][32]byte              `json:"finality_branch" ssz-size:"6,32"`
+	SyncAggregate   *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot   uint64                  `json:"signature_slot"`
+}
+
+type LightClientOptimisticUpdateDeneb struct {
+	AttestedHeader *LightClientHeaderDeneb `json:"attested_header"`
+	SyncAggregate  *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot  uint64                  `json:"signature_slot"`
+}
+
+type LightClientUpdateDeneb struct {
+	AttestedHeader          *LightClientHeaderDeneb `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee          `json:"next_sync_committee"`
+	NextSyncCommitteeBranch [][32]byte              `json:"next_sync_committee_branch" ssz-size:"5,32"`
+	FinalizedHeader         *LightClientHeaderDeneb `json:"finalized_header"`
+	FinalityBranch          [][32]byte              `json:"finality_branch" ssz-size:"6,32"`
+	SyncAggregate           *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot           uint64                  `json:"signature_slot"`
+}
+
+// Electra types
+
+type DepositRequest struct {
+	Pubkey                [48]byte  `json:"pubkey" ssz-size:"48"`
+	WithdrawalCredentials [32]byte  `json:"withdrawal_credentials" ssz-size:"32"`
+	Amount                uint64    `json:"amount"`
+	Signature             Signature `json:"signature" ssz-size:"96"`
+	Index                 uint64    `json:"index"`
+}
+
+type WithdrawalRequest struct {
+	SourceAddress   [20]byte `json:"source_address" ssz-size:"20"`
+	ValidatorPubkey [48]byte `json:"validator_pubkey" ssz-size:"48"`
+	Amount          uint64   `json:"amount"`
+}
+
+type ConsolidationRequest struct {
+	SourceAddress [20]byte `json:"source_address" ssz-size:"20"`
+	SourcePubkey  [48]byte `json:"source_pubkey" ssz-size:"48"`
+	TargetPubkey  [48]byte `json:"target_pubkey" ssz-size:"48"`
+}
+
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `json:"deposits" ssz-max:"8192"`
+	Withdrawals    []*WithdrawalRequest    `json:"withdrawals" ssz-max:"16"`
+	Consolidations []*ConsolidationRequest `json:"consolidations" ssz-max:"2"`
+}
+
+type PendingDeposit struct {
+	Pubkey                [48]byte  `json:"pubkey" ssz-size:"48"`
+	WithdrawalCredentials [32]byte  `json:"withdrawal_credentials" ssz-size:"32"`
+	Amount                uint64    `json:"amount"`
+	Signature             Signature `json:"signature" ssz-size:"96"`
+	Slot                  uint64    `json:"slot"`
+}
+
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    uint64 `json:"validator_index"`
+	Amount            uint64 `json:"amount"`
+	WithdrawableEpoch uint64 `json:"withdrawable_epoch"`
+}
+
+type PendingConsolidation struct {
+	SourceIndex uint64 `json:"source_index"`
+	TargetIndex uint64 `json:"target_index"`
+}
+
+type AttestationElectra struct {
+	AggregationBits []byte           `json:"aggregation_bits" ssz:"bitlist" ssz-max:"131072"`
+	Data            *AttestationData `json:"data"`
+	Signature       Signature        `json:"signature" ssz-size:"96"`
+	CommitteeBits   [8]byte          `json:"committee_bits" ssz-size:"8"`
+}
+
+type SingleAttestation struct {
+	CommitteeIndex uint64           `json:"committee_index"`
+	AttesterIndex  uint64           `json:"attester_index"`
+	Data           *AttestationData `json:"data"`
+	Signature      Signature        `json:"signature" ssz-size:"96"`
+}
+
+type IndexedAttestationElectra struct {
+	AttestationIndices []uint64         `json:"attesting_indices" ssz-max:"131072"`
+	Data               *AttestationData `json:"data"`
+	Signature          Signature        `json:"signature" ssz-size:"96"`
+}
+
+type AttesterSlashingElectra struct {
+	Attestation1 *IndexedAttestationElectra `json:"attestation_1"`
+	Attestation2 *IndexedAttestationElectra `json:"attestation_2"`
+}
+
+type AggregateAndProofElectra struct {
+	Index          uint64              `json:"aggregator_index"`
+	Aggregate      *AttestationElectra `json:"aggregate"`
+	SelectionProof [96]byte            `json:"selection_proof" ssz-size:"96"`
+}
+
+type SignedAggregateAndProofElectra struct {
+	Message   *AggregateAndProofElectra `json:"message"`
+	Signature Signature                 `json:"signature" ssz-size:"96"`
+}
+
+type BeaconStateElectra struct {
+	GenesisTime                   uint64                       `json:"genesis_time"`
+	GenesisValidatorsRoot         [32]byte                     `json:"genesis_validators_root" ssz-size:"32"`
+	Slot                          uint64                       `json:"slot"`
+	Fork                          *Fork                        `json:"fork"`
+	LatestBlockHeader             *BeaconBlockHeader           `json:"latest_block_header"`
+	BlockRoots                    [8192][32]byte               `json:"block_roots" ssz-size:"8192,32"`
+	StateRoots                    [8192][32]byte               `json:"state_roots" ssz-size:"8192,32"`
+	HistoricalRoots               [][]byte                     `json:"historical_roots" ssz-max:"16777216" ssz-size:"?,32"`
+	Eth1Data                      *Eth1Data                    `json:"eth1_data"`
+	Eth1DataVotes                 []*Eth1Data                  `json:"eth1_data_votes" ssz-max:"2048"`
+	Eth1DepositIndex              uint64                       `json:"eth1_deposit_index"`
+	Validators                    []*Validator                 `json:"validators" ssz-max:"1099511627776"`
+	Balances                      []uint64                     `json:"balances" ssz-max:"1099511627776"`
+	RandaoMixes                   [65536][32]byte              `json:"randao_mixes" ssz-size:"65536,32"`
+	Slashings                     []uint64                     `json:"slashings" ssz-size:"8192"`
+	PreviousEpochParticipation    []byte                       `json:"previous_epoch_participation" ssz-max:"1099511627776"`
+	CurrentEpochParticipation     []byte                       `json:"current_epoch_participation" ssz-max:"1099511627776"`
+	JustificationBits             [1]byte                      `json:"justification_bits" ssz-size:"1"`
+	PreviousJustifiedCheckpoint   *Checkpoint                  `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint    *Checkpoint                  `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint           *Checkpoint                  `json:"finalized_checkpoint"`
+	InactivityScores              []uint64                     `json:"inactivity_scores" ssz-max:"1099511627776"`
+	CurrentSyncCommittee          *SyncCommittee               `json:"current_sync_committee"`
+	NextSyncCommittee             *SyncCommittee               `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeaderDeneb `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex           uint64                       `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex  uint64                       `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries           []*HistoricalSummary         `json:"historical_summaries" ssz-max:"16777216"`
+	DepositRequestsStartIndex     uint64                       `json:"deposit_requests_start_index"`
+	DepositBalanceToConsume       uint64                       `json:"deposit_balance_to_consume"`
+	ExitBalanceToConsume          uint64                       `json:"exit_balance_to_consume"`
+	EarliestExitEpoch             uint64                       `json:"earliest_exit_epoch"`
+	ConsolidationBalanceToConsume uint64                       `json:"consolidation_balance_to_consume"`
+	EarliestConsolidationEpoch    uint64                       `json:"earliest_consolidation_epoch"`
+	PendingDeposits               []*PendingDeposit            `json:"pending_deposits" ssz-max:"134217728"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal  `json:"pending_partial_withdrawals" ssz-max:"134217728"`
+	PendingConsolidations         []*PendingConsolidation      `json:"pending_consolidations" ssz-max:"262144"`
+}
+
+type SignedBeaconBlockElectra struct {
+	Block     *BeaconBlockElectra `json:"message"`
+	Signature Signature           `json:"signature" ssz-size:"96"`
+}
+
+type BeaconBlockElectra struct {
+	Slot          uint64                  `json:"slot"`
+	ProposerIndex uint64                  `json:"proposer_index"`
+	ParentRoot    Root                    `json:"parent_root" ssz-size:"32"`
+	StateRoot     Root                    `json:"state_root" ssz-size:"32"`
+	Body          *BeaconBlockBodyElectra `json:"body"`
+}
+
+type BeaconBlockBodyElectra struct {
+	RandaoReveal          Signature                     `json:"randao_reveal" ssz-size:"96"`
+	Eth1Data              *Eth1Data                     `json:"eth1_data"`
+	Graffiti              [32]byte                      `json:"graffiti" ssz-size:"32"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings" ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashingElectra    `json:"attester_slashings" ssz-max:"1"`
+	Attestations          []*AttestationElectra         `json:"attestations" ssz-max:"8"`
+	Deposits              []*Deposit                    `json:"deposits" ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits" ssz-max:"16"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayloadDeneb        `json:"execution_payload"`
+	BlsToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes" ssz-max:"16"`
+	BlobKzgCommitments    [][48]byte                    `json:"blob_kzg_commitments" ssz-max:"4096" ssz-size:"?,48"`
+	ExecutionRequests     *ExecutionRequests            `json:"execution_requests"`
+}
+
+type SignedBlindedBeaconBlockElectra struct {
+	Block     *BlindedBeaconBlockElectra `json:"message"`
+	Signature Signature                  `json:"signature" ssz-size:"96"`
+}
+
+type BlindedBeaconBlockElectra struct {
+	Slot          uint64                         `json:"slot"`
+	ProposerIndex uint64                         `json:"proposer_index"`
+	ParentRoot    Root                           `json:"parent_root" ssz-size:"32"`
+	StateRoot     Root                           `json:"state_root" ssz-size:"32"`
+	Body          *BlindedBeaconBlockBodyElectra `json:"body"`
+}
+
+type BlindedBeaconBlockBodyElectra struct {
+	RandaoReveal           Signature                     `json:"randao_reveal" ssz-size:"96"`
+	Eth1Data               *Eth1Data                     `json:"eth1_data"`
+	Graffiti               [32]byte                      `json:"graffiti" ssz-size:"32"`
+	ProposerSlashings      []*ProposerSlashing           `json:"proposer_slashings" ssz-max:"16"`
+	AttesterSlashings      []*AttesterSlashingElectra    `json:"attester_slashings" ssz-max:"1"`
+	Attestations           []*AttestationElectra         `json:"attestations" ssz-max:"8"`
+	Deposits               []*Deposit                    `json:"deposits" ssz-max:"16"`
+	VoluntaryExits         []*SignedVoluntaryExit        `json:"voluntary_exits" ssz-max:"16"`
+	SyncAggregate          *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeaderDeneb  `json:"execution_payload_header"`
+	BlsToExecutionChanges  []*SignedBLSToExecutionChange `json:"bls_to_execution_changes" ssz-max:"16"`
+	BlobKzgCommitments     [][48]byte                    `json:"blob_kzg_commitments" ssz-max:"4096" ssz-size:"?,48"`
+	ExecutionRequests      *ExecutionRequests            `json:"execution_requests"`
+}
+
+type LightClientBootstrapElectra struct {
+	Header                     *LightClientHeaderDeneb `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee          `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch [][32]byte              `json:"current_sync_committee_branch" ssz-size:"6,32"`
+}
+
+type LightClientFinalityUpdateElectra struct {
+	AttestedHeader  *LightClientHeaderDeneb `json:"attested_header"`
+	FinalizedHeader *LightClientHeaderDeneb `json:"finalized_header"`
+	FinalityBranch  [][32]byte              `json:"finality_branch" ssz-size:"7,32"`
+	SyncAggregate   *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot   uint64                  `json:"signature_slot"`
+}
+
+type LightClientUpdateElectra struct {
+	AttestedHeader          *LightClientHeaderDeneb `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee          `json:"next_sync_committee"`
+	NextSyncCommitteeBranch [][32]byte              `json:"next_sync_committee_branch" ssz-size:"6,32"`
+	FinalizedHeader         *LightClientHeaderDeneb `json:"finalized_header"`
+	FinalityBranch          [][32]byte              `json:"finality_branch" ssz-size:"7,32"`
+	SyncAggregate           *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot           uint64                  `json:"signature_slot"`
+}
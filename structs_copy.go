@@ -0,0 +1,6439 @@
+// Code generated by copygen. DO NOT EDIT.
+package consensus
+
+import "bytes"
+
+// Copy returns a deep copy of the AggregateAndProof object
+func (a *AggregateAndProof) Copy() *AggregateAndProof {
+	if a == nil {
+		return nil
+	}
+	cpy := *a
+	cpy.Aggregate = a.Aggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two AggregateAndProof objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (a *AggregateAndProof) Equal(other *AggregateAndProof) bool {
+	if a == other {
+		return true
+	}
+	if a == nil {
+		a = new(AggregateAndProof)
+	}
+	if other == nil {
+		other = new(AggregateAndProof)
+	}
+	if a.Index != other.Index {
+		return false
+	}
+	if !a.Aggregate.Equal(other.Aggregate) {
+		return false
+	}
+	if a.SelectionProof != other.SelectionProof {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Checkpoint object
+func (c *Checkpoint) Copy() *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	cpy := *c
+	return &cpy
+}
+
+// Equal returns whether two Checkpoint objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (c *Checkpoint) Equal(other *Checkpoint) bool {
+	if c == other {
+		return true
+	}
+	if c == nil {
+		c = new(Checkpoint)
+	}
+	if other == nil {
+		other = new(Checkpoint)
+	}
+	if c.Epoch != other.Epoch {
+		return false
+	}
+	if c.Root != other.Root {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the AttestationData object
+func (a *AttestationData) Copy() *AttestationData {
+	if a == nil {
+		return nil
+	}
+	cpy := *a
+	cpy.Source = a.Source.Copy()
+	cpy.Target = a.Target.Copy()
+	return &cpy
+}
+
+// Equal returns whether two AttestationData objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (a *AttestationData) Equal(other *AttestationData) bool {
+	if a == other {
+		return true
+	}
+	if a == nil {
+		a = new(AttestationData)
+	}
+	if other == nil {
+		other = new(AttestationData)
+	}
+	if a.Slot != other.Slot {
+		return false
+	}
+	if a.Index != other.Index {
+		return false
+	}
+	if a.BeaconBlockHash != other.BeaconBlockHash {
+		return false
+	}
+	if !a.Source.Equal(other.Source) {
+		return false
+	}
+	if !a.Target.Equal(other.Target) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Attestation object
+func (a *Attestation) Copy() *Attestation {
+	if a == nil {
+		return nil
+	}
+	cpy := *a
+	if a.AggregationBits != nil {
+		cpy.AggregationBits = make([]byte, len(a.AggregationBits))
+		copy(cpy.AggregationBits, a.AggregationBits)
+	}
+	cpy.Data = a.Data.Copy()
+	return &cpy
+}
+
+// Equal returns whether two Attestation objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (a *Attestation) Equal(other *Attestation) bool {
+	if a == other {
+		return true
+	}
+	if a == nil {
+		a = new(Attestation)
+	}
+	if other == nil {
+		other = new(Attestation)
+	}
+	if !bytes.Equal(a.AggregationBits, other.AggregationBits) {
+		return false
+	}
+	if !a.Data.Equal(other.Data) {
+		return false
+	}
+	if a.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the DepositData object
+func (d *DepositData) Copy() *DepositData {
+	if d == nil {
+		return nil
+	}
+	cpy := *d
+	return &cpy
+}
+
+// Equal returns whether two DepositData objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (d *DepositData) Equal(other *DepositData) bool {
+	if d == other {
+		return true
+	}
+	if d == nil {
+		d = new(DepositData)
+	}
+	if other == nil {
+		other = new(DepositData)
+	}
+	if d.Pubkey != other.Pubkey {
+		return false
+	}
+	if d.WithdrawalCredentials != other.WithdrawalCredentials {
+		return false
+	}
+	if d.Amount != other.Amount {
+		return false
+	}
+	if d.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Deposit object
+func (d *Deposit) Copy() *Deposit {
+	if d == nil {
+		return nil
+	}
+	cpy := *d
+	cpy.Data = d.Data.Copy()
+	return &cpy
+}
+
+// Equal returns whether two Deposit objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (d *Deposit) Equal(other *Deposit) bool {
+	if d == other {
+		return true
+	}
+	if d == nil {
+		d = new(Deposit)
+	}
+	if other == nil {
+		other = new(Deposit)
+	}
+	if d.Proof != other.Proof {
+		return false
+	}
+	if !d.Data.Equal(other.Data) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the DepositMessage object
+func (d *DepositMessage) Copy() *DepositMessage {
+	if d == nil {
+		return nil
+	}
+	cpy := *d
+	return &cpy
+}
+
+// Equal returns whether two DepositMessage objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (d *DepositMessage) Equal(other *DepositMessage) bool {
+	if d == other {
+		return true
+	}
+	if d == nil {
+		d = new(DepositMessage)
+	}
+	if other == nil {
+		other = new(DepositMessage)
+	}
+	if d.Pubkey != other.Pubkey {
+		return false
+	}
+	if d.WithdrawalCredentials != other.WithdrawalCredentials {
+		return false
+	}
+	if d.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the IndexedAttestation object
+func (i *IndexedAttestation) Copy() *IndexedAttestation {
+	if i == nil {
+		return nil
+	}
+	cpy := *i
+	if i.AttestationIndices != nil {
+		cpy.AttestationIndices = make([]uint64, len(i.AttestationIndices))
+		copy(cpy.AttestationIndices, i.AttestationIndices)
+	}
+	cpy.Data = i.Data.Copy()
+	return &cpy
+}
+
+// Equal returns whether two IndexedAttestation objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (i *IndexedAttestation) Equal(other *IndexedAttestation) bool {
+	if i == other {
+		return true
+	}
+	if i == nil {
+		i = new(IndexedAttestation)
+	}
+	if other == nil {
+		other = new(IndexedAttestation)
+	}
+	if len(i.AttestationIndices) != len(other.AttestationIndices) {
+		return false
+	}
+	for indx := range i.AttestationIndices {
+		if i.AttestationIndices[indx] != other.AttestationIndices[indx] {
+			return false
+		}
+	}
+	if !i.Data.Equal(other.Data) {
+		return false
+	}
+	if i.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the PendingAttestation object
+func (p *PendingAttestation) Copy() *PendingAttestation {
+	if p == nil {
+		return nil
+	}
+	cpy := *p
+	if p.AggregationBits != nil {
+		cpy.AggregationBits = make([]byte, len(p.AggregationBits))
+		copy(cpy.AggregationBits, p.AggregationBits)
+	}
+	cpy.Data = p.Data.Copy()
+	return &cpy
+}
+
+// Equal returns whether two PendingAttestation objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (p *PendingAttestation) Equal(other *PendingAttestation) bool {
+	if p == other {
+		return true
+	}
+	if p == nil {
+		p = new(PendingAttestation)
+	}
+	if other == nil {
+		other = new(PendingAttestation)
+	}
+	if !bytes.Equal(p.AggregationBits, other.AggregationBits) {
+		return false
+	}
+	if !p.Data.Equal(other.Data) {
+		return false
+	}
+	if p.InclusionDelay != other.InclusionDelay {
+		return false
+	}
+	if p.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Fork object
+func (f *Fork) Copy() *Fork {
+	if f == nil {
+		return nil
+	}
+	cpy := *f
+	return &cpy
+}
+
+// Equal returns whether two Fork objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (f *Fork) Equal(other *Fork) bool {
+	if f == other {
+		return true
+	}
+	if f == nil {
+		f = new(Fork)
+	}
+	if other == nil {
+		other = new(Fork)
+	}
+	if f.PreviousVersion != other.PreviousVersion {
+		return false
+	}
+	if f.CurrentVersion != other.CurrentVersion {
+		return false
+	}
+	if f.Epoch != other.Epoch {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Validator object
+func (v *Validator) Copy() *Validator {
+	if v == nil {
+		return nil
+	}
+	cpy := *v
+	return &cpy
+}
+
+// Equal returns whether two Validator objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (v *Validator) Equal(other *Validator) bool {
+	if v == other {
+		return true
+	}
+	if v == nil {
+		v = new(Validator)
+	}
+	if other == nil {
+		other = new(Validator)
+	}
+	if v.Pubkey != other.Pubkey {
+		return false
+	}
+	if v.WithdrawalCredentials != other.WithdrawalCredentials {
+		return false
+	}
+	if v.EffectiveBalance != other.EffectiveBalance {
+		return false
+	}
+	if v.Slashed != other.Slashed {
+		return false
+	}
+	if v.ActivationEligibilityEpoch != other.ActivationEligibilityEpoch {
+		return false
+	}
+	if v.ActivationEpoch != other.ActivationEpoch {
+		return false
+	}
+	if v.ExitEpoch != other.ExitEpoch {
+		return false
+	}
+	if v.WithdrawableEpoch != other.WithdrawableEpoch {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the VoluntaryExit object
+func (v *VoluntaryExit) Copy() *VoluntaryExit {
+	if v == nil {
+		return nil
+	}
+	cpy := *v
+	return &cpy
+}
+
+// Equal returns whether two VoluntaryExit objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (v *VoluntaryExit) Equal(other *VoluntaryExit) bool {
+	if v == other {
+		return true
+	}
+	if v == nil {
+		v = new(VoluntaryExit)
+	}
+	if other == nil {
+		other = new(VoluntaryExit)
+	}
+	if v.Epoch != other.Epoch {
+		return false
+	}
+	if v.ValidatorIndex != other.ValidatorIndex {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) Copy() *SignedVoluntaryExit {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Exit = s.Exit.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedVoluntaryExit objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedVoluntaryExit) Equal(other *SignedVoluntaryExit) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedVoluntaryExit)
+	}
+	if other == nil {
+		other = new(SignedVoluntaryExit)
+	}
+	if !s.Exit.Equal(other.Exit) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the HistoricalBatch object
+func (h *HistoricalBatch) Copy() *HistoricalBatch {
+	if h == nil {
+		return nil
+	}
+	cpy := *h
+	return &cpy
+}
+
+// Equal returns whether two HistoricalBatch objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (h *HistoricalBatch) Equal(other *HistoricalBatch) bool {
+	if h == other {
+		return true
+	}
+	if h == nil {
+		h = new(HistoricalBatch)
+	}
+	if other == nil {
+		other = new(HistoricalBatch)
+	}
+	if h.BlockRoots != other.BlockRoots {
+		return false
+	}
+	if h.StateRoots != other.StateRoots {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Eth1Data object
+func (e *Eth1Data) Copy() *Eth1Data {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	return &cpy
+}
+
+// Equal returns whether two Eth1Data objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *Eth1Data) Equal(other *Eth1Data) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(Eth1Data)
+	}
+	if other == nil {
+		other = new(Eth1Data)
+	}
+	if e.DepositRoot != other.DepositRoot {
+		return false
+	}
+	if e.DepositCount != other.DepositCount {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SigningRoot object
+func (s *SigningRoot) Copy() *SigningRoot {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	if s.Domain != nil {
+		cpy.Domain = make([]byte, len(s.Domain))
+		copy(cpy.Domain, s.Domain)
+	}
+	return &cpy
+}
+
+// Equal returns whether two SigningRoot objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SigningRoot) Equal(other *SigningRoot) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SigningRoot)
+	}
+	if other == nil {
+		other = new(SigningRoot)
+	}
+	if s.ObjectRoot != other.ObjectRoot {
+		return false
+	}
+	if !bytes.Equal(s.Domain, other.Domain) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ProposerSlashing object
+func (p *ProposerSlashing) Copy() *ProposerSlashing {
+	if p == nil {
+		return nil
+	}
+	cpy := *p
+	cpy.Header1 = p.Header1.Copy()
+	cpy.Header2 = p.Header2.Copy()
+	return &cpy
+}
+
+// Equal returns whether two ProposerSlashing objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (p *ProposerSlashing) Equal(other *ProposerSlashing) bool {
+	if p == other {
+		return true
+	}
+	if p == nil {
+		p = new(ProposerSlashing)
+	}
+	if other == nil {
+		other = new(ProposerSlashing)
+	}
+	if !p.Header1.Equal(other.Header1) {
+		return false
+	}
+	if !p.Header2.Equal(other.Header2) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the AttesterSlashing object
+func (a *AttesterSlashing) Copy() *AttesterSlashing {
+	if a == nil {
+		return nil
+	}
+	cpy := *a
+	cpy.Attestation1 = a.Attestation1.Copy()
+	cpy.Attestation2 = a.Attestation2.Copy()
+	return &cpy
+}
+
+// Equal returns whether two AttesterSlashing objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (a *AttesterSlashing) Equal(other *AttesterSlashing) bool {
+	if a == other {
+		return true
+	}
+	if a == nil {
+		a = new(AttesterSlashing)
+	}
+	if other == nil {
+		other = new(AttesterSlashing)
+	}
+	if !a.Attestation1.Equal(other.Attestation1) {
+		return false
+	}
+	if !a.Attestation2.Equal(other.Attestation2) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Transfer object
+func (t *Transfer) Copy() *Transfer {
+	if t == nil {
+		return nil
+	}
+	cpy := *t
+	return &cpy
+}
+
+// Equal returns whether two Transfer objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (t *Transfer) Equal(other *Transfer) bool {
+	if t == other {
+		return true
+	}
+	if t == nil {
+		t = new(Transfer)
+	}
+	if other == nil {
+		other = new(Transfer)
+	}
+	if t.Sender != other.Sender {
+		return false
+	}
+	if t.Recipient != other.Recipient {
+		return false
+	}
+	if t.Amount != other.Amount {
+		return false
+	}
+	if t.Fee != other.Fee {
+		return false
+	}
+	if t.Slot != other.Slot {
+		return false
+	}
+	if t.Pubkey != other.Pubkey {
+		return false
+	}
+	if t.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconStatePhase0 object
+func (b *BeaconStatePhase0) Copy() *BeaconStatePhase0 {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Fork = b.Fork.Copy()
+	cpy.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.HistoricalRoots != nil {
+		cpy.HistoricalRoots = make([][32]byte, len(b.HistoricalRoots))
+		copy(cpy.HistoricalRoots, b.HistoricalRoots)
+	}
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.Eth1DataVotes != nil {
+		cpy.Eth1DataVotes = make([]*Eth1Data, len(b.Eth1DataVotes))
+		for indx, elem := range b.Eth1DataVotes {
+			cpy.Eth1DataVotes[indx] = elem.Copy()
+		}
+	}
+	if b.Validators != nil {
+		cpy.Validators = make([]*Validator, len(b.Validators))
+		for indx, elem := range b.Validators {
+			cpy.Validators[indx] = elem.Copy()
+		}
+	}
+	if b.Balances != nil {
+		cpy.Balances = make([]uint64, len(b.Balances))
+		copy(cpy.Balances, b.Balances)
+	}
+	if b.Slashings != nil {
+		cpy.Slashings = make([]uint64, len(b.Slashings))
+		copy(cpy.Slashings, b.Slashings)
+	}
+	if b.PreviousEpochAttestations != nil {
+		cpy.PreviousEpochAttestations = make([]*PendingAttestation, len(b.PreviousEpochAttestations))
+		for indx, elem := range b.PreviousEpochAttestations {
+			cpy.PreviousEpochAttestations[indx] = elem.Copy()
+		}
+	}
+	if b.CurrentEpochAttestations != nil {
+		cpy.CurrentEpochAttestations = make([]*PendingAttestation, len(b.CurrentEpochAttestations))
+		for indx, elem := range b.CurrentEpochAttestations {
+			cpy.CurrentEpochAttestations[indx] = elem.Copy()
+		}
+	}
+	cpy.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	cpy.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	cpy.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconStatePhase0 objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconStatePhase0) Equal(other *BeaconStatePhase0) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconStatePhase0)
+	}
+	if other == nil {
+		other = new(BeaconStatePhase0)
+	}
+	if b.GenesisTime != other.GenesisTime {
+		return false
+	}
+	if b.GenesisValidatorsRoot != other.GenesisValidatorsRoot {
+		return false
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if !b.Fork.Equal(other.Fork) {
+		return false
+	}
+	if !b.LatestBlockHeader.Equal(other.LatestBlockHeader) {
+		return false
+	}
+	if b.BlockRoots != other.BlockRoots {
+		return false
+	}
+	if b.StateRoots != other.StateRoots {
+		return false
+	}
+	if len(b.HistoricalRoots) != len(other.HistoricalRoots) {
+		return false
+	}
+	for indx := range b.HistoricalRoots {
+		if b.HistoricalRoots[indx] != other.HistoricalRoots[indx] {
+			return false
+		}
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if len(b.Eth1DataVotes) != len(other.Eth1DataVotes) {
+		return false
+	}
+	for indx := range b.Eth1DataVotes {
+		if !b.Eth1DataVotes[indx].Equal(other.Eth1DataVotes[indx]) {
+			return false
+		}
+	}
+	if b.Eth1DepositIndex != other.Eth1DepositIndex {
+		return false
+	}
+	if len(b.Validators) != len(other.Validators) {
+		return false
+	}
+	for indx := range b.Validators {
+		if !b.Validators[indx].Equal(other.Validators[indx]) {
+			return false
+		}
+	}
+	if len(b.Balances) != len(other.Balances) {
+		return false
+	}
+	for indx := range b.Balances {
+		if b.Balances[indx] != other.Balances[indx] {
+			return false
+		}
+	}
+	if b.RandaoMixes != other.RandaoMixes {
+		return false
+	}
+	if len(b.Slashings) != len(other.Slashings) {
+		return false
+	}
+	for indx := range b.Slashings {
+		if b.Slashings[indx] != other.Slashings[indx] {
+			return false
+		}
+	}
+	if len(b.PreviousEpochAttestations) != len(other.PreviousEpochAttestations) {
+		return false
+	}
+	for indx := range b.PreviousEpochAttestations {
+		if !b.PreviousEpochAttestations[indx].Equal(other.PreviousEpochAttestations[indx]) {
+			return false
+		}
+	}
+	if len(b.CurrentEpochAttestations) != len(other.CurrentEpochAttestations) {
+		return false
+	}
+	for indx := range b.CurrentEpochAttestations {
+		if !b.CurrentEpochAttestations[indx].Equal(other.CurrentEpochAttestations[indx]) {
+			return false
+		}
+	}
+	if b.JustificationBits != other.JustificationBits {
+		return false
+	}
+	if !b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) {
+		return false
+	}
+	if !b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) {
+		return false
+	}
+	if !b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockPhase0 object
+func (s *SignedBeaconBlockPhase0) Copy() *SignedBeaconBlockPhase0 {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBeaconBlockPhase0 objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBeaconBlockPhase0) Equal(other *SignedBeaconBlockPhase0) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBeaconBlockPhase0)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockPhase0)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockPhase0 object
+func (b *BeaconBlockPhase0) Copy() *BeaconBlockPhase0 {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockPhase0 objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockPhase0) Equal(other *BeaconBlockPhase0) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockPhase0)
+	}
+	if other == nil {
+		other = new(BeaconBlockPhase0)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockBodyPhase0 object
+func (b *BeaconBlockBodyPhase0) Copy() *BeaconBlockBodyPhase0 {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*Attestation, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockBodyPhase0 objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockBodyPhase0) Equal(other *BeaconBlockBodyPhase0) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockBodyPhase0)
+	}
+	if other == nil {
+		other = new(BeaconBlockBodyPhase0)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) Copy() *SignedBeaconBlockHeader {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Header = s.Header.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBeaconBlockHeader objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBeaconBlockHeader) Equal(other *SignedBeaconBlockHeader) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBeaconBlockHeader)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockHeader)
+	}
+	if !s.Header.Equal(other.Header) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) Copy() *BeaconBlockHeader {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockHeader objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockHeader) Equal(other *BeaconBlockHeader) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockHeader)
+	}
+	if other == nil {
+		other = new(BeaconBlockHeader)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if b.BodyRoot != other.BodyRoot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ForkData object
+func (f *ForkData) Copy() *ForkData {
+	if f == nil {
+		return nil
+	}
+	cpy := *f
+	return &cpy
+}
+
+// Equal returns whether two ForkData objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (f *ForkData) Equal(other *ForkData) bool {
+	if f == other {
+		return true
+	}
+	if f == nil {
+		f = new(ForkData)
+	}
+	if other == nil {
+		other = new(ForkData)
+	}
+	if f.CurrentVersion != other.CurrentVersion {
+		return false
+	}
+	if f.GenesisValidatorsRoot != other.GenesisValidatorsRoot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ENRForkID object
+func (e *ENRForkID) Copy() *ENRForkID {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	return &cpy
+}
+
+// Equal returns whether two ENRForkID objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ENRForkID) Equal(other *ENRForkID) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ENRForkID)
+	}
+	if other == nil {
+		other = new(ENRForkID)
+	}
+	if e.ForkDigest != other.ForkDigest {
+		return false
+	}
+	if e.NextForkVersion != other.NextForkVersion {
+		return false
+	}
+	if e.NextForkEpoch != other.NextForkEpoch {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SigningData object
+func (s *SigningData) Copy() *SigningData {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	return &cpy
+}
+
+// Equal returns whether two SigningData objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SigningData) Equal(other *SigningData) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SigningData)
+	}
+	if other == nil {
+		other = new(SigningData)
+	}
+	if s.ObjectRoot != other.ObjectRoot {
+		return false
+	}
+	if s.Domain != other.Domain {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientHeader object
+func (l *LightClientHeader) Copy() *LightClientHeader {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.Header = l.Header.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientHeader objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientHeader) Equal(other *LightClientHeader) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientHeader)
+	}
+	if other == nil {
+		other = new(LightClientHeader)
+	}
+	if !l.Header.Equal(other.Header) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientBootstrap object
+func (l *LightClientBootstrap) Copy() *LightClientBootstrap {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.Header = l.Header.Copy()
+	cpy.CurrentSyncCommittee = l.CurrentSyncCommittee.Copy()
+	if l.CurrentSyncCommitteeBranch != nil {
+		cpy.CurrentSyncCommitteeBranch = make([][32]byte, len(l.CurrentSyncCommitteeBranch))
+		copy(cpy.CurrentSyncCommitteeBranch, l.CurrentSyncCommitteeBranch)
+	}
+	return &cpy
+}
+
+// Equal returns whether two LightClientBootstrap objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientBootstrap) Equal(other *LightClientBootstrap) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientBootstrap)
+	}
+	if other == nil {
+		other = new(LightClientBootstrap)
+	}
+	if !l.Header.Equal(other.Header) {
+		return false
+	}
+	if !l.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if len(l.CurrentSyncCommitteeBranch) != len(other.CurrentSyncCommitteeBranch) {
+		return false
+	}
+	for indx := range l.CurrentSyncCommitteeBranch {
+		if l.CurrentSyncCommitteeBranch[indx] != other.CurrentSyncCommitteeBranch[indx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) Copy() *LightClientFinalityUpdate {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.FinalizedHeader = l.FinalizedHeader.Copy()
+	if l.FinalityBranch != nil {
+		cpy.FinalityBranch = make([][32]byte, len(l.FinalityBranch))
+		copy(cpy.FinalityBranch, l.FinalityBranch)
+	}
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientFinalityUpdate objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientFinalityUpdate) Equal(other *LightClientFinalityUpdate) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientFinalityUpdate)
+	}
+	if other == nil {
+		other = new(LightClientFinalityUpdate)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.FinalizedHeader.Equal(other.FinalizedHeader) {
+		return false
+	}
+	if len(l.FinalityBranch) != len(other.FinalityBranch) {
+		return false
+	}
+	for indx := range l.FinalityBranch {
+		if l.FinalityBranch[indx] != other.FinalityBranch[indx] {
+			return false
+		}
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) Copy() *LightClientOptimisticUpdate {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientOptimisticUpdate objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientOptimisticUpdate) Equal(other *LightClientOptimisticUpdate) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientOptimisticUpdate)
+	}
+	if other == nil {
+		other = new(LightClientOptimisticUpdate)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientUpdate object
+func (l *LightClientUpdate) Copy() *LightClientUpdate {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.NextSyncCommittee = l.NextSyncCommittee.Copy()
+	if l.NextSyncCommitteeBranch != nil {
+		cpy.NextSyncCommitteeBranch = make([][32]byte, len(l.NextSyncCommitteeBranch))
+		copy(cpy.NextSyncCommitteeBranch, l.NextSyncCommitteeBranch)
+	}
+	cpy.FinalizedHeader = l.FinalizedHeader.Copy()
+	if l.FinalityBranch != nil {
+		cpy.FinalityBranch = make([][32]byte, len(l.FinalityBranch))
+		copy(cpy.FinalityBranch, l.FinalityBranch)
+	}
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientUpdate objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientUpdate) Equal(other *LightClientUpdate) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientUpdate)
+	}
+	if other == nil {
+		other = new(LightClientUpdate)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	if len(l.NextSyncCommitteeBranch) != len(other.NextSyncCommitteeBranch) {
+		return false
+	}
+	for indx := range l.NextSyncCommitteeBranch {
+		if l.NextSyncCommitteeBranch[indx] != other.NextSyncCommitteeBranch[indx] {
+			return false
+		}
+	}
+	if !l.FinalizedHeader.Equal(other.FinalizedHeader) {
+		return false
+	}
+	if len(l.FinalityBranch) != len(other.FinalityBranch) {
+		return false
+	}
+	for indx := range l.FinalityBranch {
+		if l.FinalityBranch[indx] != other.FinalityBranch[indx] {
+			return false
+		}
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconStateAltair object
+func (b *BeaconStateAltair) Copy() *BeaconStateAltair {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Fork = b.Fork.Copy()
+	cpy.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.HistoricalRoots != nil {
+		cpy.HistoricalRoots = make([][32]byte, len(b.HistoricalRoots))
+		copy(cpy.HistoricalRoots, b.HistoricalRoots)
+	}
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.Eth1DataVotes != nil {
+		cpy.Eth1DataVotes = make([]*Eth1Data, len(b.Eth1DataVotes))
+		for indx, elem := range b.Eth1DataVotes {
+			cpy.Eth1DataVotes[indx] = elem.Copy()
+		}
+	}
+	if b.Validators != nil {
+		cpy.Validators = make([]*Validator, len(b.Validators))
+		for indx, elem := range b.Validators {
+			cpy.Validators[indx] = elem.Copy()
+		}
+	}
+	if b.Balances != nil {
+		cpy.Balances = make([]uint64, len(b.Balances))
+		copy(cpy.Balances, b.Balances)
+	}
+	if b.Slashings != nil {
+		cpy.Slashings = make([]uint64, len(b.Slashings))
+		copy(cpy.Slashings, b.Slashings)
+	}
+	if b.PreviousEpochParticipation != nil {
+		cpy.PreviousEpochParticipation = make([]byte, len(b.PreviousEpochParticipation))
+		copy(cpy.PreviousEpochParticipation, b.PreviousEpochParticipation)
+	}
+	if b.CurrentEpochParticipation != nil {
+		cpy.CurrentEpochParticipation = make([]byte, len(b.CurrentEpochParticipation))
+		copy(cpy.CurrentEpochParticipation, b.CurrentEpochParticipation)
+	}
+	cpy.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	cpy.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	cpy.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	if b.InactivityScores != nil {
+		cpy.InactivityScores = make([]uint64, len(b.InactivityScores))
+		copy(cpy.InactivityScores, b.InactivityScores)
+	}
+	cpy.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	cpy.NextSyncCommittee = b.NextSyncCommittee.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconStateAltair objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconStateAltair) Equal(other *BeaconStateAltair) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconStateAltair)
+	}
+	if other == nil {
+		other = new(BeaconStateAltair)
+	}
+	if b.GenesisTime != other.GenesisTime {
+		return false
+	}
+	if b.GenesisValidatorsRoot != other.GenesisValidatorsRoot {
+		return false
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if !b.Fork.Equal(other.Fork) {
+		return false
+	}
+	if !b.LatestBlockHeader.Equal(other.LatestBlockHeader) {
+		return false
+	}
+	if b.BlockRoots != other.BlockRoots {
+		return false
+	}
+	if b.StateRoots != other.StateRoots {
+		return false
+	}
+	if len(b.HistoricalRoots) != len(other.HistoricalRoots) {
+		return false
+	}
+	for indx := range b.HistoricalRoots {
+		if b.HistoricalRoots[indx] != other.HistoricalRoots[indx] {
+			return false
+		}
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if len(b.Eth1DataVotes) != len(other.Eth1DataVotes) {
+		return false
+	}
+	for indx := range b.Eth1DataVotes {
+		if !b.Eth1DataVotes[indx].Equal(other.Eth1DataVotes[indx]) {
+			return false
+		}
+	}
+	if b.Eth1DepositIndex != other.Eth1DepositIndex {
+		return false
+	}
+	if len(b.Validators) != len(other.Validators) {
+		return false
+	}
+	for indx := range b.Validators {
+		if !b.Validators[indx].Equal(other.Validators[indx]) {
+			return false
+		}
+	}
+	if len(b.Balances) != len(other.Balances) {
+		return false
+	}
+	for indx := range b.Balances {
+		if b.Balances[indx] != other.Balances[indx] {
+			return false
+		}
+	}
+	if b.RandaoMixes != other.RandaoMixes {
+		return false
+	}
+	if len(b.Slashings) != len(other.Slashings) {
+		return false
+	}
+	for indx := range b.Slashings {
+		if b.Slashings[indx] != other.Slashings[indx] {
+			return false
+		}
+	}
+	if !bytes.Equal(b.PreviousEpochParticipation, other.PreviousEpochParticipation) {
+		return false
+	}
+	if !bytes.Equal(b.CurrentEpochParticipation, other.CurrentEpochParticipation) {
+		return false
+	}
+	if b.JustificationBits != other.JustificationBits {
+		return false
+	}
+	if !b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) {
+		return false
+	}
+	if !b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) {
+		return false
+	}
+	if !b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint) {
+		return false
+	}
+	if len(b.InactivityScores) != len(other.InactivityScores) {
+		return false
+	}
+	for indx := range b.InactivityScores {
+		if b.InactivityScores[indx] != other.InactivityScores[indx] {
+			return false
+		}
+	}
+	if !b.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if !b.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockAltair object
+func (s *SignedBeaconBlockAltair) Copy() *SignedBeaconBlockAltair {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBeaconBlockAltair objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBeaconBlockAltair) Equal(other *SignedBeaconBlockAltair) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBeaconBlockAltair)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockAltair)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockAltair object
+func (b *BeaconBlockAltair) Copy() *BeaconBlockAltair {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockAltair objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockAltair) Equal(other *BeaconBlockAltair) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockAltair)
+	}
+	if other == nil {
+		other = new(BeaconBlockAltair)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) Copy() *BeaconBlockBodyAltair {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*Attestation, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockBodyAltair objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockBodyAltair) Equal(other *BeaconBlockBodyAltair) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockBodyAltair)
+	}
+	if other == nil {
+		other = new(BeaconBlockBodyAltair)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SyncAggregate object
+func (s *SyncAggregate) Copy() *SyncAggregate {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	return &cpy
+}
+
+// Equal returns whether two SyncAggregate objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SyncAggregate) Equal(other *SyncAggregate) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SyncAggregate)
+	}
+	if other == nil {
+		other = new(SyncAggregate)
+	}
+	if s.SyncCommiteeBits != other.SyncCommiteeBits {
+		return false
+	}
+	if s.SyncCommiteeSignature != other.SyncCommiteeSignature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SyncCommittee object
+func (s *SyncCommittee) Copy() *SyncCommittee {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	return &cpy
+}
+
+// Equal returns whether two SyncCommittee objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SyncCommittee) Equal(other *SyncCommittee) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SyncCommittee)
+	}
+	if other == nil {
+		other = new(SyncCommittee)
+	}
+	if s.PubKeys != other.PubKeys {
+		return false
+	}
+	if s.AggregatePubKey != other.AggregatePubKey {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) Copy() *BeaconStateBellatrix {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Fork = b.Fork.Copy()
+	cpy.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.HistoricalRoots != nil {
+		cpy.HistoricalRoots = make([][]byte, len(b.HistoricalRoots))
+		for indx, elem := range b.HistoricalRoots {
+			if elem != nil {
+				cpy.HistoricalRoots[indx] = append([]byte{}, elem...)
+			}
+		}
+	}
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.Eth1DataVotes != nil {
+		cpy.Eth1DataVotes = make([]*Eth1Data, len(b.Eth1DataVotes))
+		for indx, elem := range b.Eth1DataVotes {
+			cpy.Eth1DataVotes[indx] = elem.Copy()
+		}
+	}
+	if b.Validators != nil {
+		cpy.Validators = make([]*Validator, len(b.Validators))
+		for indx, elem := range b.Validators {
+			cpy.Validators[indx] = elem.Copy()
+		}
+	}
+	if b.Balances != nil {
+		cpy.Balances = make([]uint64, len(b.Balances))
+		copy(cpy.Balances, b.Balances)
+	}
+	if b.Slashings != nil {
+		cpy.Slashings = make([]uint64, len(b.Slashings))
+		copy(cpy.Slashings, b.Slashings)
+	}
+	if b.PreviousEpochParticipation != nil {
+		cpy.PreviousEpochParticipation = make([]byte, len(b.PreviousEpochParticipation))
+		copy(cpy.PreviousEpochParticipation, b.PreviousEpochParticipation)
+	}
+	if b.CurrentEpochParticipation != nil {
+		cpy.CurrentEpochParticipation = make([]byte, len(b.CurrentEpochParticipation))
+		copy(cpy.CurrentEpochParticipation, b.CurrentEpochParticipation)
+	}
+	cpy.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	cpy.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	cpy.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	if b.InactivityScores != nil {
+		cpy.InactivityScores = make([]uint64, len(b.InactivityScores))
+		copy(cpy.InactivityScores, b.InactivityScores)
+	}
+	cpy.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	cpy.NextSyncCommittee = b.NextSyncCommittee.Copy()
+	cpy.LatestExecutionPayloadHeader = b.LatestExecutionPayloadHeader.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconStateBellatrix objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconStateBellatrix) Equal(other *BeaconStateBellatrix) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconStateBellatrix)
+	}
+	if other == nil {
+		other = new(BeaconStateBellatrix)
+	}
+	if b.GenesisTime != other.GenesisTime {
+		return false
+	}
+	if b.GenesisValidatorsRoot != other.GenesisValidatorsRoot {
+		return false
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if !b.Fork.Equal(other.Fork) {
+		return false
+	}
+	if !b.LatestBlockHeader.Equal(other.LatestBlockHeader) {
+		return false
+	}
+	if b.BlockRoots != other.BlockRoots {
+		return false
+	}
+	if b.StateRoots != other.StateRoots {
+		return false
+	}
+	if len(b.HistoricalRoots) != len(other.HistoricalRoots) {
+		return false
+	}
+	for indx := range b.HistoricalRoots {
+		if !bytes.Equal(b.HistoricalRoots[indx], other.HistoricalRoots[indx]) {
+			return false
+		}
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if len(b.Eth1DataVotes) != len(other.Eth1DataVotes) {
+		return false
+	}
+	for indx := range b.Eth1DataVotes {
+		if !b.Eth1DataVotes[indx].Equal(other.Eth1DataVotes[indx]) {
+			return false
+		}
+	}
+	if b.Eth1DepositIndex != other.Eth1DepositIndex {
+		return false
+	}
+	if len(b.Validators) != len(other.Validators) {
+		return false
+	}
+	for indx := range b.Validators {
+		if !b.Validators[indx].Equal(other.Validators[indx]) {
+			return false
+		}
+	}
+	if len(b.Balances) != len(other.Balances) {
+		return false
+	}
+	for indx := range b.Balances {
+		if b.Balances[indx] != other.Balances[indx] {
+			return false
+		}
+	}
+	if b.RandaoMixes != other.RandaoMixes {
+		return false
+	}
+	if len(b.Slashings) != len(other.Slashings) {
+		return false
+	}
+	for indx := range b.Slashings {
+		if b.Slashings[indx] != other.Slashings[indx] {
+			return false
+		}
+	}
+	if !bytes.Equal(b.PreviousEpochParticipation, other.PreviousEpochParticipation) {
+		return false
+	}
+	if !bytes.Equal(b.CurrentEpochParticipation, other.CurrentEpochParticipation) {
+		return false
+	}
+	if b.JustificationBits != other.JustificationBits {
+		return false
+	}
+	if !b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) {
+		return false
+	}
+	if !b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) {
+		return false
+	}
+	if !b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint) {
+		return false
+	}
+	if len(b.InactivityScores) != len(other.InactivityScores) {
+		return false
+	}
+	for indx := range b.InactivityScores {
+		if b.InactivityScores[indx] != other.InactivityScores[indx] {
+			return false
+		}
+	}
+	if !b.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if !b.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	if !b.LatestExecutionPayloadHeader.Equal(other.LatestExecutionPayloadHeader) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockBellatrix object
+func (s *SignedBeaconBlockBellatrix) Copy() *SignedBeaconBlockBellatrix {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBeaconBlockBellatrix objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBeaconBlockBellatrix) Equal(other *SignedBeaconBlockBellatrix) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBeaconBlockBellatrix)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockBellatrix)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockBellatrix object
+func (b *BeaconBlockBellatrix) Copy() *BeaconBlockBellatrix {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockBellatrix objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockBellatrix) Equal(other *BeaconBlockBellatrix) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockBellatrix)
+	}
+	if other == nil {
+		other = new(BeaconBlockBellatrix)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) Copy() *BeaconBlockBodyBellatrix {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*Attestation, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	cpy.ExecutionPayload = b.ExecutionPayload.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockBodyBellatrix objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockBodyBellatrix) Equal(other *BeaconBlockBodyBellatrix) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockBodyBellatrix)
+	}
+	if other == nil {
+		other = new(BeaconBlockBodyBellatrix)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayload.Equal(other.ExecutionPayload) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBlindedBeaconBlock object
+func (s *SignedBlindedBeaconBlock) Copy() *SignedBlindedBeaconBlock {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBlindedBeaconBlock objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBlindedBeaconBlock) Equal(other *SignedBlindedBeaconBlock) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBlindedBeaconBlock)
+	}
+	if other == nil {
+		other = new(SignedBlindedBeaconBlock)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlindedBeaconBlock object
+func (b *BlindedBeaconBlock) Copy() *BlindedBeaconBlock {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BlindedBeaconBlock objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlindedBeaconBlock) Equal(other *BlindedBeaconBlock) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlindedBeaconBlock)
+	}
+	if other == nil {
+		other = new(BlindedBeaconBlock)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlindedBeaconBlockBody object
+func (b *BlindedBeaconBlockBody) Copy() *BlindedBeaconBlockBody {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*Attestation, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	cpy.ExecutionPayloadHeader = b.ExecutionPayloadHeader.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BlindedBeaconBlockBody objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlindedBeaconBlockBody) Equal(other *BlindedBeaconBlockBody) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlindedBeaconBlockBody)
+	}
+	if other == nil {
+		other = new(BlindedBeaconBlockBody)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayloadHeader.Equal(other.ExecutionPayloadHeader) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ExecutionPayload object
+func (e *ExecutionPayload) Copy() *ExecutionPayload {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	if e.ExtraData != nil {
+		cpy.ExtraData = make([]byte, len(e.ExtraData))
+		copy(cpy.ExtraData, e.ExtraData)
+	}
+	if e.Transactions != nil {
+		cpy.Transactions = make([][]byte, len(e.Transactions))
+		for indx, elem := range e.Transactions {
+			if elem != nil {
+				cpy.Transactions[indx] = append([]byte{}, elem...)
+			}
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two ExecutionPayload objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ExecutionPayload) Equal(other *ExecutionPayload) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ExecutionPayload)
+	}
+	if other == nil {
+		other = new(ExecutionPayload)
+	}
+	if e.ParentHash != other.ParentHash {
+		return false
+	}
+	if e.FeeRecipient != other.FeeRecipient {
+		return false
+	}
+	if e.StateRoot != other.StateRoot {
+		return false
+	}
+	if e.ReceiptsRoot != other.ReceiptsRoot {
+		return false
+	}
+	if e.LogsBloom != other.LogsBloom {
+		return false
+	}
+	if e.PrevRandao != other.PrevRandao {
+		return false
+	}
+	if e.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if e.GasLimit != other.GasLimit {
+		return false
+	}
+	if e.GasUsed != other.GasUsed {
+		return false
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if !bytes.Equal(e.ExtraData, other.ExtraData) {
+		return false
+	}
+	if e.BaseFeePerGas != other.BaseFeePerGas {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	if len(e.Transactions) != len(other.Transactions) {
+		return false
+	}
+	for indx := range e.Transactions {
+		if !bytes.Equal(e.Transactions[indx], other.Transactions[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) Copy() *ExecutionPayloadHeader {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	if e.ExtraData != nil {
+		cpy.ExtraData = make([]byte, len(e.ExtraData))
+		copy(cpy.ExtraData, e.ExtraData)
+	}
+	return &cpy
+}
+
+// Equal returns whether two ExecutionPayloadHeader objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ExecutionPayloadHeader) Equal(other *ExecutionPayloadHeader) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ExecutionPayloadHeader)
+	}
+	if other == nil {
+		other = new(ExecutionPayloadHeader)
+	}
+	if e.ParentHash != other.ParentHash {
+		return false
+	}
+	if e.FeeRecipient != other.FeeRecipient {
+		return false
+	}
+	if e.StateRoot != other.StateRoot {
+		return false
+	}
+	if e.ReceiptsRoot != other.ReceiptsRoot {
+		return false
+	}
+	if e.LogsBloom != other.LogsBloom {
+		return false
+	}
+	if e.PrevRandao != other.PrevRandao {
+		return false
+	}
+	if e.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if e.GasLimit != other.GasLimit {
+		return false
+	}
+	if e.GasUsed != other.GasUsed {
+		return false
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if !bytes.Equal(e.ExtraData, other.ExtraData) {
+		return false
+	}
+	if e.BaseFeePerGas != other.BaseFeePerGas {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	if e.TransactionsRoot != other.TransactionsRoot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SyncAggregatorSelectionData object
+func (s *SyncAggregatorSelectionData) Copy() *SyncAggregatorSelectionData {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	return &cpy
+}
+
+// Equal returns whether two SyncAggregatorSelectionData objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SyncAggregatorSelectionData) Equal(other *SyncAggregatorSelectionData) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SyncAggregatorSelectionData)
+	}
+	if other == nil {
+		other = new(SyncAggregatorSelectionData)
+	}
+	if s.Slot != other.Slot {
+		return false
+	}
+	if s.SubCommitteeIndex != other.SubCommitteeIndex {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SyncCommitteeContribution object
+func (s *SyncCommitteeContribution) Copy() *SyncCommitteeContribution {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	if s.AggregationBits != nil {
+		cpy.AggregationBits = make([]byte, len(s.AggregationBits))
+		copy(cpy.AggregationBits, s.AggregationBits)
+	}
+	return &cpy
+}
+
+// Equal returns whether two SyncCommitteeContribution objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SyncCommitteeContribution) Equal(other *SyncCommitteeContribution) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SyncCommitteeContribution)
+	}
+	if other == nil {
+		other = new(SyncCommitteeContribution)
+	}
+	if s.Slot != other.Slot {
+		return false
+	}
+	if s.BeaconBlockRoot != other.BeaconBlockRoot {
+		return false
+	}
+	if s.SubcommitteeIndex != other.SubcommitteeIndex {
+		return false
+	}
+	if !bytes.Equal(s.AggregationBits, other.AggregationBits) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ContributionAndProof object
+func (c *ContributionAndProof) Copy() *ContributionAndProof {
+	if c == nil {
+		return nil
+	}
+	cpy := *c
+	cpy.Contribution = c.Contribution.Copy()
+	return &cpy
+}
+
+// Equal returns whether two ContributionAndProof objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (c *ContributionAndProof) Equal(other *ContributionAndProof) bool {
+	if c == other {
+		return true
+	}
+	if c == nil {
+		c = new(ContributionAndProof)
+	}
+	if other == nil {
+		other = new(ContributionAndProof)
+	}
+	if c.AggregatorIndex != other.AggregatorIndex {
+		return false
+	}
+	if !c.Contribution.Equal(other.Contribution) {
+		return false
+	}
+	if c.SelectionProof != other.SelectionProof {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedContributionAndProof object
+func (s *SignedContributionAndProof) Copy() *SignedContributionAndProof {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Message = s.Message.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedContributionAndProof objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedContributionAndProof) Equal(other *SignedContributionAndProof) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedContributionAndProof)
+	}
+	if other == nil {
+		other = new(SignedContributionAndProof)
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SyncCommitteeMessage object
+func (s *SyncCommitteeMessage) Copy() *SyncCommitteeMessage {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	return &cpy
+}
+
+// Equal returns whether two SyncCommitteeMessage objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SyncCommitteeMessage) Equal(other *SyncCommitteeMessage) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SyncCommitteeMessage)
+	}
+	if other == nil {
+		other = new(SyncCommitteeMessage)
+	}
+	if s.Slot != other.Slot {
+		return false
+	}
+	if s.BlockRoot != other.BlockRoot {
+		return false
+	}
+	if s.ValidatorIndex != other.ValidatorIndex {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedAggregateAndProof object
+func (s *SignedAggregateAndProof) Copy() *SignedAggregateAndProof {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Message = s.Message.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedAggregateAndProof objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedAggregateAndProof) Equal(other *SignedAggregateAndProof) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedAggregateAndProof)
+	}
+	if other == nil {
+		other = new(SignedAggregateAndProof)
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Eth1Block object
+func (e *Eth1Block) Copy() *Eth1Block {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	return &cpy
+}
+
+// Equal returns whether two Eth1Block objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *Eth1Block) Equal(other *Eth1Block) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(Eth1Block)
+	}
+	if other == nil {
+		other = new(Eth1Block)
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if e.DepositRoot != other.DepositRoot {
+		return false
+	}
+	if e.DepositCount != other.DepositCount {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the PowBlock object
+func (p *PowBlock) Copy() *PowBlock {
+	if p == nil {
+		return nil
+	}
+	cpy := *p
+	return &cpy
+}
+
+// Equal returns whether two PowBlock objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (p *PowBlock) Equal(other *PowBlock) bool {
+	if p == other {
+		return true
+	}
+	if p == nil {
+		p = new(PowBlock)
+	}
+	if other == nil {
+		other = new(PowBlock)
+	}
+	if p.BlockHash != other.BlockHash {
+		return false
+	}
+	if p.ParentHash != other.ParentHash {
+		return false
+	}
+	if p.TotalDifficulty != other.TotalDifficulty {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ExecutionPayloadCapella object
+func (e *ExecutionPayloadCapella) Copy() *ExecutionPayloadCapella {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	if e.ExtraData != nil {
+		cpy.ExtraData = make([]byte, len(e.ExtraData))
+		copy(cpy.ExtraData, e.ExtraData)
+	}
+	if e.Transactions != nil {
+		cpy.Transactions = make([][]byte, len(e.Transactions))
+		for indx, elem := range e.Transactions {
+			if elem != nil {
+				cpy.Transactions[indx] = append([]byte{}, elem...)
+			}
+		}
+	}
+	if e.Withdrawals != nil {
+		cpy.Withdrawals = make([]*Withdrawal, len(e.Withdrawals))
+		for indx, elem := range e.Withdrawals {
+			cpy.Withdrawals[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two ExecutionPayloadCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ExecutionPayloadCapella) Equal(other *ExecutionPayloadCapella) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ExecutionPayloadCapella)
+	}
+	if other == nil {
+		other = new(ExecutionPayloadCapella)
+	}
+	if e.ParentHash != other.ParentHash {
+		return false
+	}
+	if e.FeeRecipient != other.FeeRecipient {
+		return false
+	}
+	if e.StateRoot != other.StateRoot {
+		return false
+	}
+	if e.ReceiptsRoot != other.ReceiptsRoot {
+		return false
+	}
+	if e.LogsBloom != other.LogsBloom {
+		return false
+	}
+	if e.PrevRandao != other.PrevRandao {
+		return false
+	}
+	if e.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if e.GasLimit != other.GasLimit {
+		return false
+	}
+	if e.GasUsed != other.GasUsed {
+		return false
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if !bytes.Equal(e.ExtraData, other.ExtraData) {
+		return false
+	}
+	if e.BaseFeePerGas != other.BaseFeePerGas {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	if len(e.Transactions) != len(other.Transactions) {
+		return false
+	}
+	for indx := range e.Transactions {
+		if !bytes.Equal(e.Transactions[indx], other.Transactions[indx]) {
+			return false
+		}
+	}
+	if len(e.Withdrawals) != len(other.Withdrawals) {
+		return false
+	}
+	for indx := range e.Withdrawals {
+		if !e.Withdrawals[indx].Equal(other.Withdrawals[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ExecutionPayloadHeaderCapella object
+func (e *ExecutionPayloadHeaderCapella) Copy() *ExecutionPayloadHeaderCapella {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	if e.ExtraData != nil {
+		cpy.ExtraData = make([]byte, len(e.ExtraData))
+		copy(cpy.ExtraData, e.ExtraData)
+	}
+	return &cpy
+}
+
+// Equal returns whether two ExecutionPayloadHeaderCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ExecutionPayloadHeaderCapella) Equal(other *ExecutionPayloadHeaderCapella) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ExecutionPayloadHeaderCapella)
+	}
+	if other == nil {
+		other = new(ExecutionPayloadHeaderCapella)
+	}
+	if e.ParentHash != other.ParentHash {
+		return false
+	}
+	if e.FeeRecipient != other.FeeRecipient {
+		return false
+	}
+	if e.StateRoot != other.StateRoot {
+		return false
+	}
+	if e.ReceiptsRoot != other.ReceiptsRoot {
+		return false
+	}
+	if e.LogsBloom != other.LogsBloom {
+		return false
+	}
+	if e.PrevRandao != other.PrevRandao {
+		return false
+	}
+	if e.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if e.GasLimit != other.GasLimit {
+		return false
+	}
+	if e.GasUsed != other.GasUsed {
+		return false
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if !bytes.Equal(e.ExtraData, other.ExtraData) {
+		return false
+	}
+	if e.BaseFeePerGas != other.BaseFeePerGas {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	if e.TransactionsRoot != other.TransactionsRoot {
+		return false
+	}
+	if e.WithdrawalRoot != other.WithdrawalRoot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BLSToExecutionChange object
+func (b *BLSToExecutionChange) Copy() *BLSToExecutionChange {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	return &cpy
+}
+
+// Equal returns whether two BLSToExecutionChange objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BLSToExecutionChange) Equal(other *BLSToExecutionChange) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BLSToExecutionChange)
+	}
+	if other == nil {
+		other = new(BLSToExecutionChange)
+	}
+	if b.ValidatorIndex != other.ValidatorIndex {
+		return false
+	}
+	if b.FromBLSPubKey != other.FromBLSPubKey {
+		return false
+	}
+	if b.ToExecutionAddress != other.ToExecutionAddress {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the HistoricalSummary object
+func (h *HistoricalSummary) Copy() *HistoricalSummary {
+	if h == nil {
+		return nil
+	}
+	cpy := *h
+	return &cpy
+}
+
+// Equal returns whether two HistoricalSummary objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (h *HistoricalSummary) Equal(other *HistoricalSummary) bool {
+	if h == other {
+		return true
+	}
+	if h == nil {
+		h = new(HistoricalSummary)
+	}
+	if other == nil {
+		other = new(HistoricalSummary)
+	}
+	if h.BlockSummaryRoot != other.BlockSummaryRoot {
+		return false
+	}
+	if h.StateSummaryRoot != other.StateSummaryRoot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) Copy() *SignedBLSToExecutionChange {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Message = s.Message.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBLSToExecutionChange objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBLSToExecutionChange) Equal(other *SignedBLSToExecutionChange) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBLSToExecutionChange)
+	}
+	if other == nil {
+		other = new(SignedBLSToExecutionChange)
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the Withdrawal object
+func (w *Withdrawal) Copy() *Withdrawal {
+	if w == nil {
+		return nil
+	}
+	cpy := *w
+	return &cpy
+}
+
+// Equal returns whether two Withdrawal objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (w *Withdrawal) Equal(other *Withdrawal) bool {
+	if w == other {
+		return true
+	}
+	if w == nil {
+		w = new(Withdrawal)
+	}
+	if other == nil {
+		other = new(Withdrawal)
+	}
+	if w.Index != other.Index {
+		return false
+	}
+	if w.ValidatorIndex != other.ValidatorIndex {
+		return false
+	}
+	if w.Address != other.Address {
+		return false
+	}
+	if w.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconStateCapella object
+func (b *BeaconStateCapella) Copy() *BeaconStateCapella {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Fork = b.Fork.Copy()
+	cpy.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.HistoricalRoots != nil {
+		cpy.HistoricalRoots = make([][]byte, len(b.HistoricalRoots))
+		for indx, elem := range b.HistoricalRoots {
+			if elem != nil {
+				cpy.HistoricalRoots[indx] = append([]byte{}, elem...)
+			}
+		}
+	}
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.Eth1DataVotes != nil {
+		cpy.Eth1DataVotes = make([]*Eth1Data, len(b.Eth1DataVotes))
+		for indx, elem := range b.Eth1DataVotes {
+			cpy.Eth1DataVotes[indx] = elem.Copy()
+		}
+	}
+	if b.Validators != nil {
+		cpy.Validators = make([]*Validator, len(b.Validators))
+		for indx, elem := range b.Validators {
+			cpy.Validators[indx] = elem.Copy()
+		}
+	}
+	if b.Balances != nil {
+		cpy.Balances = make([]uint64, len(b.Balances))
+		copy(cpy.Balances, b.Balances)
+	}
+	if b.Slashings != nil {
+		cpy.Slashings = make([]uint64, len(b.Slashings))
+		copy(cpy.Slashings, b.Slashings)
+	}
+	if b.PreviousEpochParticipation != nil {
+		cpy.PreviousEpochParticipation = make([]byte, len(b.PreviousEpochParticipation))
+		copy(cpy.PreviousEpochParticipation, b.PreviousEpochParticipation)
+	}
+	if b.CurrentEpochParticipation != nil {
+		cpy.CurrentEpochParticipation = make([]byte, len(b.CurrentEpochParticipation))
+		copy(cpy.CurrentEpochParticipation, b.CurrentEpochParticipation)
+	}
+	cpy.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	cpy.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	cpy.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	if b.InactivityScores != nil {
+		cpy.InactivityScores = make([]uint64, len(b.InactivityScores))
+		copy(cpy.InactivityScores, b.InactivityScores)
+	}
+	cpy.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	cpy.NextSyncCommittee = b.NextSyncCommittee.Copy()
+	cpy.LatestExecutionPayloadHeader = b.LatestExecutionPayloadHeader.Copy()
+	if b.HistoricalSummaries != nil {
+		cpy.HistoricalSummaries = make([]*HistoricalSummary, len(b.HistoricalSummaries))
+		for indx, elem := range b.HistoricalSummaries {
+			cpy.HistoricalSummaries[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two BeaconStateCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconStateCapella) Equal(other *BeaconStateCapella) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconStateCapella)
+	}
+	if other == nil {
+		other = new(BeaconStateCapella)
+	}
+	if b.GenesisTime != other.GenesisTime {
+		return false
+	}
+	if b.GenesisValidatorsRoot != other.GenesisValidatorsRoot {
+		return false
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if !b.Fork.Equal(other.Fork) {
+		return false
+	}
+	if !b.LatestBlockHeader.Equal(other.LatestBlockHeader) {
+		return false
+	}
+	if b.BlockRoots != other.BlockRoots {
+		return false
+	}
+	if b.StateRoots != other.StateRoots {
+		return false
+	}
+	if len(b.HistoricalRoots) != len(other.HistoricalRoots) {
+		return false
+	}
+	for indx := range b.HistoricalRoots {
+		if !bytes.Equal(b.HistoricalRoots[indx], other.HistoricalRoots[indx]) {
+			return false
+		}
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if len(b.Eth1DataVotes) != len(other.Eth1DataVotes) {
+		return false
+	}
+	for indx := range b.Eth1DataVotes {
+		if !b.Eth1DataVotes[indx].Equal(other.Eth1DataVotes[indx]) {
+			return false
+		}
+	}
+	if b.Eth1DepositIndex != other.Eth1DepositIndex {
+		return false
+	}
+	if len(b.Validators) != len(other.Validators) {
+		return false
+	}
+	for indx := range b.Validators {
+		if !b.Validators[indx].Equal(other.Validators[indx]) {
+			return false
+		}
+	}
+	if len(b.Balances) != len(other.Balances) {
+		return false
+	}
+	for indx := range b.Balances {
+		if b.Balances[indx] != other.Balances[indx] {
+			return false
+		}
+	}
+	if b.RandaoMixes != other.RandaoMixes {
+		return false
+	}
+	if len(b.Slashings) != len(other.Slashings) {
+		return false
+	}
+	for indx := range b.Slashings {
+		if b.Slashings[indx] != other.Slashings[indx] {
+			return false
+		}
+	}
+	if !bytes.Equal(b.PreviousEpochParticipation, other.PreviousEpochParticipation) {
+		return false
+	}
+	if !bytes.Equal(b.CurrentEpochParticipation, other.CurrentEpochParticipation) {
+		return false
+	}
+	if b.JustificationBits != other.JustificationBits {
+		return false
+	}
+	if !b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) {
+		return false
+	}
+	if !b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) {
+		return false
+	}
+	if !b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint) {
+		return false
+	}
+	if len(b.InactivityScores) != len(other.InactivityScores) {
+		return false
+	}
+	for indx := range b.InactivityScores {
+		if b.InactivityScores[indx] != other.InactivityScores[indx] {
+			return false
+		}
+	}
+	if !b.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if !b.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	if !b.LatestExecutionPayloadHeader.Equal(other.LatestExecutionPayloadHeader) {
+		return false
+	}
+	if b.NextWithdrawalIndex != other.NextWithdrawalIndex {
+		return false
+	}
+	if b.NextWithdrawalValidatorIndex != other.NextWithdrawalValidatorIndex {
+		return false
+	}
+	if len(b.HistoricalSummaries) != len(other.HistoricalSummaries) {
+		return false
+	}
+	for indx := range b.HistoricalSummaries {
+		if !b.HistoricalSummaries[indx].Equal(other.HistoricalSummaries[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockCapella object
+func (s *SignedBeaconBlockCapella) Copy() *SignedBeaconBlockCapella {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBeaconBlockCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBeaconBlockCapella) Equal(other *SignedBeaconBlockCapella) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBeaconBlockCapella)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockCapella)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockCapella object
+func (b *BeaconBlockCapella) Copy() *BeaconBlockCapella {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockCapella) Equal(other *BeaconBlockCapella) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockCapella)
+	}
+	if other == nil {
+		other = new(BeaconBlockCapella)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) Copy() *BeaconBlockBodyCapella {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*Attestation, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	cpy.ExecutionPayload = b.ExecutionPayload.Copy()
+	if b.BlsToExecutionChanges != nil {
+		cpy.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, len(b.BlsToExecutionChanges))
+		for indx, elem := range b.BlsToExecutionChanges {
+			cpy.BlsToExecutionChanges[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockBodyCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockBodyCapella) Equal(other *BeaconBlockBodyCapella) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockBodyCapella)
+	}
+	if other == nil {
+		other = new(BeaconBlockBodyCapella)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayload.Equal(other.ExecutionPayload) {
+		return false
+	}
+	if len(b.BlsToExecutionChanges) != len(other.BlsToExecutionChanges) {
+		return false
+	}
+	for indx := range b.BlsToExecutionChanges {
+		if !b.BlsToExecutionChanges[indx].Equal(other.BlsToExecutionChanges[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBlindedBeaconBlockCapella object
+func (s *SignedBlindedBeaconBlockCapella) Copy() *SignedBlindedBeaconBlockCapella {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBlindedBeaconBlockCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBlindedBeaconBlockCapella) Equal(other *SignedBlindedBeaconBlockCapella) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBlindedBeaconBlockCapella)
+	}
+	if other == nil {
+		other = new(SignedBlindedBeaconBlockCapella)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlindedBeaconBlockCapella object
+func (b *BlindedBeaconBlockCapella) Copy() *BlindedBeaconBlockCapella {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BlindedBeaconBlockCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlindedBeaconBlockCapella) Equal(other *BlindedBeaconBlockCapella) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlindedBeaconBlockCapella)
+	}
+	if other == nil {
+		other = new(BlindedBeaconBlockCapella)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlindedBeaconBlockBodyCapella object
+func (b *BlindedBeaconBlockBodyCapella) Copy() *BlindedBeaconBlockBodyCapella {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*Attestation, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	cpy.ExecutionPayloadHeader = b.ExecutionPayloadHeader.Copy()
+	if b.BlsToExecutionChanges != nil {
+		cpy.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, len(b.BlsToExecutionChanges))
+		for indx, elem := range b.BlsToExecutionChanges {
+			cpy.BlsToExecutionChanges[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two BlindedBeaconBlockBodyCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlindedBeaconBlockBodyCapella) Equal(other *BlindedBeaconBlockBodyCapella) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlindedBeaconBlockBodyCapella)
+	}
+	if other == nil {
+		other = new(BlindedBeaconBlockBodyCapella)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayloadHeader.Equal(other.ExecutionPayloadHeader) {
+		return false
+	}
+	if len(b.BlsToExecutionChanges) != len(other.BlsToExecutionChanges) {
+		return false
+	}
+	for indx := range b.BlsToExecutionChanges {
+		if !b.BlsToExecutionChanges[indx].Equal(other.BlsToExecutionChanges[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientHeaderCapella object
+func (l *LightClientHeaderCapella) Copy() *LightClientHeaderCapella {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.Header = l.Header.Copy()
+	cpy.Execution = l.Execution.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientHeaderCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientHeaderCapella) Equal(other *LightClientHeaderCapella) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientHeaderCapella)
+	}
+	if other == nil {
+		other = new(LightClientHeaderCapella)
+	}
+	if !l.Header.Equal(other.Header) {
+		return false
+	}
+	if !l.Execution.Equal(other.Execution) {
+		return false
+	}
+	if l.ExecutionBranch != other.ExecutionBranch {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientBootstrapCapella object
+func (l *LightClientBootstrapCapella) Copy() *LightClientBootstrapCapella {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.Header = l.Header.Copy()
+	cpy.CurrentSyncCommittee = l.CurrentSyncCommittee.Copy()
+	if l.CurrentSyncCommitteeBranch != nil {
+		cpy.CurrentSyncCommitteeBranch = make([][32]byte, len(l.CurrentSyncCommitteeBranch))
+		copy(cpy.CurrentSyncCommitteeBranch, l.CurrentSyncCommitteeBranch)
+	}
+	return &cpy
+}
+
+// Equal returns whether two LightClientBootstrapCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientBootstrapCapella) Equal(other *LightClientBootstrapCapella) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientBootstrapCapella)
+	}
+	if other == nil {
+		other = new(LightClientBootstrapCapella)
+	}
+	if !l.Header.Equal(other.Header) {
+		return false
+	}
+	if !l.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if len(l.CurrentSyncCommitteeBranch) != len(other.CurrentSyncCommitteeBranch) {
+		return false
+	}
+	for indx := range l.CurrentSyncCommitteeBranch {
+		if l.CurrentSyncCommitteeBranch[indx] != other.CurrentSyncCommitteeBranch[indx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientFinalityUpdateCapella object
+func (l *LightClientFinalityUpdateCapella) Copy() *LightClientFinalityUpdateCapella {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.FinalizedHeader = l.FinalizedHeader.Copy()
+	if l.FinalityBranch != nil {
+		cpy.FinalityBranch = make([][32]byte, len(l.FinalityBranch))
+		copy(cpy.FinalityBranch, l.FinalityBranch)
+	}
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientFinalityUpdateCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientFinalityUpdateCapella) Equal(other *LightClientFinalityUpdateCapella) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientFinalityUpdateCapella)
+	}
+	if other == nil {
+		other = new(LightClientFinalityUpdateCapella)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.FinalizedHeader.Equal(other.FinalizedHeader) {
+		return false
+	}
+	if len(l.FinalityBranch) != len(other.FinalityBranch) {
+		return false
+	}
+	for indx := range l.FinalityBranch {
+		if l.FinalityBranch[indx] != other.FinalityBranch[indx] {
+			return false
+		}
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientOptimisticUpdateCapella object
+func (l *LightClientOptimisticUpdateCapella) Copy() *LightClientOptimisticUpdateCapella {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientOptimisticUpdateCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientOptimisticUpdateCapella) Equal(other *LightClientOptimisticUpdateCapella) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientOptimisticUpdateCapella)
+	}
+	if other == nil {
+		other = new(LightClientOptimisticUpdateCapella)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientUpdateCapella object
+func (l *LightClientUpdateCapella) Copy() *LightClientUpdateCapella {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.NextSyncCommittee = l.NextSyncCommittee.Copy()
+	if l.NextSyncCommitteeBranch != nil {
+		cpy.NextSyncCommitteeBranch = make([][32]byte, len(l.NextSyncCommitteeBranch))
+		copy(cpy.NextSyncCommitteeBranch, l.NextSyncCommitteeBranch)
+	}
+	cpy.FinalizedHeader = l.FinalizedHeader.Copy()
+	if l.FinalityBranch != nil {
+		cpy.FinalityBranch = make([][32]byte, len(l.FinalityBranch))
+		copy(cpy.FinalityBranch, l.FinalityBranch)
+	}
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientUpdateCapella objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientUpdateCapella) Equal(other *LightClientUpdateCapella) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientUpdateCapella)
+	}
+	if other == nil {
+		other = new(LightClientUpdateCapella)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	if len(l.NextSyncCommitteeBranch) != len(other.NextSyncCommitteeBranch) {
+		return false
+	}
+	for indx := range l.NextSyncCommitteeBranch {
+		if l.NextSyncCommitteeBranch[indx] != other.NextSyncCommitteeBranch[indx] {
+			return false
+		}
+	}
+	if !l.FinalizedHeader.Equal(other.FinalizedHeader) {
+		return false
+	}
+	if len(l.FinalityBranch) != len(other.FinalityBranch) {
+		return false
+	}
+	for indx := range l.FinalityBranch {
+		if l.FinalityBranch[indx] != other.FinalityBranch[indx] {
+			return false
+		}
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ExecutionPayloadDeneb object
+func (e *ExecutionPayloadDeneb) Copy() *ExecutionPayloadDeneb {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	if e.ExtraData != nil {
+		cpy.ExtraData = make([]byte, len(e.ExtraData))
+		copy(cpy.ExtraData, e.ExtraData)
+	}
+	if e.Transactions != nil {
+		cpy.Transactions = make([][]byte, len(e.Transactions))
+		for indx, elem := range e.Transactions {
+			if elem != nil {
+				cpy.Transactions[indx] = append([]byte{}, elem...)
+			}
+		}
+	}
+	if e.Withdrawals != nil {
+		cpy.Withdrawals = make([]*Withdrawal, len(e.Withdrawals))
+		for indx, elem := range e.Withdrawals {
+			cpy.Withdrawals[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two ExecutionPayloadDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ExecutionPayloadDeneb) Equal(other *ExecutionPayloadDeneb) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ExecutionPayloadDeneb)
+	}
+	if other == nil {
+		other = new(ExecutionPayloadDeneb)
+	}
+	if e.ParentHash != other.ParentHash {
+		return false
+	}
+	if e.FeeRecipient != other.FeeRecipient {
+		return false
+	}
+	if e.StateRoot != other.StateRoot {
+		return false
+	}
+	if e.ReceiptsRoot != other.ReceiptsRoot {
+		return false
+	}
+	if e.LogsBloom != other.LogsBloom {
+		return false
+	}
+	if e.PrevRandao != other.PrevRandao {
+		return false
+	}
+	if e.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if e.GasLimit != other.GasLimit {
+		return false
+	}
+	if e.GasUsed != other.GasUsed {
+		return false
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if !bytes.Equal(e.ExtraData, other.ExtraData) {
+		return false
+	}
+	if e.BaseFeePerGas != other.BaseFeePerGas {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	if len(e.Transactions) != len(other.Transactions) {
+		return false
+	}
+	for indx := range e.Transactions {
+		if !bytes.Equal(e.Transactions[indx], other.Transactions[indx]) {
+			return false
+		}
+	}
+	if len(e.Withdrawals) != len(other.Withdrawals) {
+		return false
+	}
+	for indx := range e.Withdrawals {
+		if !e.Withdrawals[indx].Equal(other.Withdrawals[indx]) {
+			return false
+		}
+	}
+	if e.BlobGasUsed != other.BlobGasUsed {
+		return false
+	}
+	if e.ExcessBlobGas != other.ExcessBlobGas {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ExecutionPayloadHeaderDeneb object
+func (e *ExecutionPayloadHeaderDeneb) Copy() *ExecutionPayloadHeaderDeneb {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	if e.ExtraData != nil {
+		cpy.ExtraData = make([]byte, len(e.ExtraData))
+		copy(cpy.ExtraData, e.ExtraData)
+	}
+	return &cpy
+}
+
+// Equal returns whether two ExecutionPayloadHeaderDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ExecutionPayloadHeaderDeneb) Equal(other *ExecutionPayloadHeaderDeneb) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ExecutionPayloadHeaderDeneb)
+	}
+	if other == nil {
+		other = new(ExecutionPayloadHeaderDeneb)
+	}
+	if e.ParentHash != other.ParentHash {
+		return false
+	}
+	if e.FeeRecipient != other.FeeRecipient {
+		return false
+	}
+	if e.StateRoot != other.StateRoot {
+		return false
+	}
+	if e.ReceiptsRoot != other.ReceiptsRoot {
+		return false
+	}
+	if e.LogsBloom != other.LogsBloom {
+		return false
+	}
+	if e.PrevRandao != other.PrevRandao {
+		return false
+	}
+	if e.BlockNumber != other.BlockNumber {
+		return false
+	}
+	if e.GasLimit != other.GasLimit {
+		return false
+	}
+	if e.GasUsed != other.GasUsed {
+		return false
+	}
+	if e.Timestamp != other.Timestamp {
+		return false
+	}
+	if !bytes.Equal(e.ExtraData, other.ExtraData) {
+		return false
+	}
+	if e.BaseFeePerGas != other.BaseFeePerGas {
+		return false
+	}
+	if e.BlockHash != other.BlockHash {
+		return false
+	}
+	if e.TransactionsRoot != other.TransactionsRoot {
+		return false
+	}
+	if e.WithdrawalRoot != other.WithdrawalRoot {
+		return false
+	}
+	if e.BlobGasUsed != other.BlobGasUsed {
+		return false
+	}
+	if e.ExcessBlobGas != other.ExcessBlobGas {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconStateDeneb object
+func (b *BeaconStateDeneb) Copy() *BeaconStateDeneb {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Fork = b.Fork.Copy()
+	cpy.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.HistoricalRoots != nil {
+		cpy.HistoricalRoots = make([][]byte, len(b.HistoricalRoots))
+		for indx, elem := range b.HistoricalRoots {
+			if elem != nil {
+				cpy.HistoricalRoots[indx] = append([]byte{}, elem...)
+			}
+		}
+	}
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.Eth1DataVotes != nil {
+		cpy.Eth1DataVotes = make([]*Eth1Data, len(b.Eth1DataVotes))
+		for indx, elem := range b.Eth1DataVotes {
+			cpy.Eth1DataVotes[indx] = elem.Copy()
+		}
+	}
+	if b.Validators != nil {
+		cpy.Validators = make([]*Validator, len(b.Validators))
+		for indx, elem := range b.Validators {
+			cpy.Validators[indx] = elem.Copy()
+		}
+	}
+	if b.Balances != nil {
+		cpy.Balances = make([]uint64, len(b.Balances))
+		copy(cpy.Balances, b.Balances)
+	}
+	if b.Slashings != nil {
+		cpy.Slashings = make([]uint64, len(b.Slashings))
+		copy(cpy.Slashings, b.Slashings)
+	}
+	if b.PreviousEpochParticipation != nil {
+		cpy.PreviousEpochParticipation = make([]byte, len(b.PreviousEpochParticipation))
+		copy(cpy.PreviousEpochParticipation, b.PreviousEpochParticipation)
+	}
+	if b.CurrentEpochParticipation != nil {
+		cpy.CurrentEpochParticipation = make([]byte, len(b.CurrentEpochParticipation))
+		copy(cpy.CurrentEpochParticipation, b.CurrentEpochParticipation)
+	}
+	cpy.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	cpy.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	cpy.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	if b.InactivityScores != nil {
+		cpy.InactivityScores = make([]uint64, len(b.InactivityScores))
+		copy(cpy.InactivityScores, b.InactivityScores)
+	}
+	cpy.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	cpy.NextSyncCommittee = b.NextSyncCommittee.Copy()
+	cpy.LatestExecutionPayloadHeader = b.LatestExecutionPayloadHeader.Copy()
+	if b.HistoricalSummaries != nil {
+		cpy.HistoricalSummaries = make([]*HistoricalSummary, len(b.HistoricalSummaries))
+		for indx, elem := range b.HistoricalSummaries {
+			cpy.HistoricalSummaries[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two BeaconStateDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconStateDeneb) Equal(other *BeaconStateDeneb) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconStateDeneb)
+	}
+	if other == nil {
+		other = new(BeaconStateDeneb)
+	}
+	if b.GenesisTime != other.GenesisTime {
+		return false
+	}
+	if b.GenesisValidatorsRoot != other.GenesisValidatorsRoot {
+		return false
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if !b.Fork.Equal(other.Fork) {
+		return false
+	}
+	if !b.LatestBlockHeader.Equal(other.LatestBlockHeader) {
+		return false
+	}
+	if b.BlockRoots != other.BlockRoots {
+		return false
+	}
+	if b.StateRoots != other.StateRoots {
+		return false
+	}
+	if len(b.HistoricalRoots) != len(other.HistoricalRoots) {
+		return false
+	}
+	for indx := range b.HistoricalRoots {
+		if !bytes.Equal(b.HistoricalRoots[indx], other.HistoricalRoots[indx]) {
+			return false
+		}
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if len(b.Eth1DataVotes) != len(other.Eth1DataVotes) {
+		return false
+	}
+	for indx := range b.Eth1DataVotes {
+		if !b.Eth1DataVotes[indx].Equal(other.Eth1DataVotes[indx]) {
+			return false
+		}
+	}
+	if b.Eth1DepositIndex != other.Eth1DepositIndex {
+		return false
+	}
+	if len(b.Validators) != len(other.Validators) {
+		return false
+	}
+	for indx := range b.Validators {
+		if !b.Validators[indx].Equal(other.Validators[indx]) {
+			return false
+		}
+	}
+	if len(b.Balances) != len(other.Balances) {
+		return false
+	}
+	for indx := range b.Balances {
+		if b.Balances[indx] != other.Balances[indx] {
+			return false
+		}
+	}
+	if b.RandaoMixes != other.RandaoMixes {
+		return false
+	}
+	if len(b.Slashings) != len(other.Slashings) {
+		return false
+	}
+	for indx := range b.Slashings {
+		if b.Slashings[indx] != other.Slashings[indx] {
+			return false
+		}
+	}
+	if !bytes.Equal(b.PreviousEpochParticipation, other.PreviousEpochParticipation) {
+		return false
+	}
+	if !bytes.Equal(b.CurrentEpochParticipation, other.CurrentEpochParticipation) {
+		return false
+	}
+	if b.JustificationBits != other.JustificationBits {
+		return false
+	}
+	if !b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) {
+		return false
+	}
+	if !b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) {
+		return false
+	}
+	if !b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint) {
+		return false
+	}
+	if len(b.InactivityScores) != len(other.InactivityScores) {
+		return false
+	}
+	for indx := range b.InactivityScores {
+		if b.InactivityScores[indx] != other.InactivityScores[indx] {
+			return false
+		}
+	}
+	if !b.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if !b.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	if !b.LatestExecutionPayloadHeader.Equal(other.LatestExecutionPayloadHeader) {
+		return false
+	}
+	if b.NextWithdrawalIndex != other.NextWithdrawalIndex {
+		return false
+	}
+	if b.NextWithdrawalValidatorIndex != other.NextWithdrawalValidatorIndex {
+		return false
+	}
+	if len(b.HistoricalSummaries) != len(other.HistoricalSummaries) {
+		return false
+	}
+	for indx := range b.HistoricalSummaries {
+		if !b.HistoricalSummaries[indx].Equal(other.HistoricalSummaries[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockDeneb object
+func (s *SignedBeaconBlockDeneb) Copy() *SignedBeaconBlockDeneb {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBeaconBlockDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBeaconBlockDeneb) Equal(other *SignedBeaconBlockDeneb) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBeaconBlockDeneb)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockDeneb)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockDeneb object
+func (b *BeaconBlockDeneb) Copy() *BeaconBlockDeneb {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockDeneb) Equal(other *BeaconBlockDeneb) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockDeneb)
+	}
+	if other == nil {
+		other = new(BeaconBlockDeneb)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockBodyDeneb object
+func (b *BeaconBlockBodyDeneb) Copy() *BeaconBlockBodyDeneb {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*Attestation, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	cpy.ExecutionPayload = b.ExecutionPayload.Copy()
+	if b.BlsToExecutionChanges != nil {
+		cpy.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, len(b.BlsToExecutionChanges))
+		for indx, elem := range b.BlsToExecutionChanges {
+			cpy.BlsToExecutionChanges[indx] = elem.Copy()
+		}
+	}
+	if b.BlobKzgCommitments != nil {
+		cpy.BlobKzgCommitments = make([][48]byte, len(b.BlobKzgCommitments))
+		copy(cpy.BlobKzgCommitments, b.BlobKzgCommitments)
+	}
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockBodyDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockBodyDeneb) Equal(other *BeaconBlockBodyDeneb) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockBodyDeneb)
+	}
+	if other == nil {
+		other = new(BeaconBlockBodyDeneb)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayload.Equal(other.ExecutionPayload) {
+		return false
+	}
+	if len(b.BlsToExecutionChanges) != len(other.BlsToExecutionChanges) {
+		return false
+	}
+	for indx := range b.BlsToExecutionChanges {
+		if !b.BlsToExecutionChanges[indx].Equal(other.BlsToExecutionChanges[indx]) {
+			return false
+		}
+	}
+	if len(b.BlobKzgCommitments) != len(other.BlobKzgCommitments) {
+		return false
+	}
+	for indx := range b.BlobKzgCommitments {
+		if b.BlobKzgCommitments[indx] != other.BlobKzgCommitments[indx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlobSidecar object
+func (b *BlobSidecar) Copy() *BlobSidecar {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.SignedBlockHeader = b.SignedBlockHeader.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BlobSidecar objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlobSidecar) Equal(other *BlobSidecar) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlobSidecar)
+	}
+	if other == nil {
+		other = new(BlobSidecar)
+	}
+	if b.Index != other.Index {
+		return false
+	}
+	if b.Blob != other.Blob {
+		return false
+	}
+	if b.KzgCommitment != other.KzgCommitment {
+		return false
+	}
+	if b.KzgProof != other.KzgProof {
+		return false
+	}
+	if !b.SignedBlockHeader.Equal(other.SignedBlockHeader) {
+		return false
+	}
+	if b.KzgCommitmentInclusionProof != other.KzgCommitmentInclusionProof {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlobIdentifier object
+func (b *BlobIdentifier) Copy() *BlobIdentifier {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	return &cpy
+}
+
+// Equal returns whether two BlobIdentifier objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlobIdentifier) Equal(other *BlobIdentifier) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlobIdentifier)
+	}
+	if other == nil {
+		other = new(BlobIdentifier)
+	}
+	if b.BlockRoot != other.BlockRoot {
+		return false
+	}
+	if b.Index != other.Index {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBlindedBeaconBlockDeneb object
+func (s *SignedBlindedBeaconBlockDeneb) Copy() *SignedBlindedBeaconBlockDeneb {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBlindedBeaconBlockDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBlindedBeaconBlockDeneb) Equal(other *SignedBlindedBeaconBlockDeneb) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBlindedBeaconBlockDeneb)
+	}
+	if other == nil {
+		other = new(SignedBlindedBeaconBlockDeneb)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlindedBeaconBlockDeneb object
+func (b *BlindedBeaconBlockDeneb) Copy() *BlindedBeaconBlockDeneb {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BlindedBeaconBlockDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlindedBeaconBlockDeneb) Equal(other *BlindedBeaconBlockDeneb) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlindedBeaconBlockDeneb)
+	}
+	if other == nil {
+		other = new(BlindedBeaconBlockDeneb)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) Copy() *BlindedBeaconBlockBodyDeneb {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashing, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*Attestation, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	cpy.ExecutionPayloadHeader = b.ExecutionPayloadHeader.Copy()
+	if b.BlsToExecutionChanges != nil {
+		cpy.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, len(b.BlsToExecutionChanges))
+		for indx, elem := range b.BlsToExecutionChanges {
+			cpy.BlsToExecutionChanges[indx] = elem.Copy()
+		}
+	}
+	if b.BlobKzgCommitments != nil {
+		cpy.BlobKzgCommitments = make([][48]byte, len(b.BlobKzgCommitments))
+		copy(cpy.BlobKzgCommitments, b.BlobKzgCommitments)
+	}
+	return &cpy
+}
+
+// Equal returns whether two BlindedBeaconBlockBodyDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlindedBeaconBlockBodyDeneb) Equal(other *BlindedBeaconBlockBodyDeneb) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlindedBeaconBlockBodyDeneb)
+	}
+	if other == nil {
+		other = new(BlindedBeaconBlockBodyDeneb)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayloadHeader.Equal(other.ExecutionPayloadHeader) {
+		return false
+	}
+	if len(b.BlsToExecutionChanges) != len(other.BlsToExecutionChanges) {
+		return false
+	}
+	for indx := range b.BlsToExecutionChanges {
+		if !b.BlsToExecutionChanges[indx].Equal(other.BlsToExecutionChanges[indx]) {
+			return false
+		}
+	}
+	if len(b.BlobKzgCommitments) != len(other.BlobKzgCommitments) {
+		return false
+	}
+	for indx := range b.BlobKzgCommitments {
+		if b.BlobKzgCommitments[indx] != other.BlobKzgCommitments[indx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlobsBundle object
+func (b *BlobsBundle) Copy() *BlobsBundle {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	if b.Commitments != nil {
+		cpy.Commitments = make([][48]byte, len(b.Commitments))
+		copy(cpy.Commitments, b.Commitments)
+	}
+	if b.Proofs != nil {
+		cpy.Proofs = make([][48]byte, len(b.Proofs))
+		copy(cpy.Proofs, b.Proofs)
+	}
+	if b.Blobs != nil {
+		cpy.Blobs = make([][131072]byte, len(b.Blobs))
+		copy(cpy.Blobs, b.Blobs)
+	}
+	return &cpy
+}
+
+// Equal returns whether two BlobsBundle objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlobsBundle) Equal(other *BlobsBundle) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlobsBundle)
+	}
+	if other == nil {
+		other = new(BlobsBundle)
+	}
+	if len(b.Commitments) != len(other.Commitments) {
+		return false
+	}
+	for indx := range b.Commitments {
+		if b.Commitments[indx] != other.Commitments[indx] {
+			return false
+		}
+	}
+	if len(b.Proofs) != len(other.Proofs) {
+		return false
+	}
+	for indx := range b.Proofs {
+		if b.Proofs[indx] != other.Proofs[indx] {
+			return false
+		}
+	}
+	if len(b.Blobs) != len(other.Blobs) {
+		return false
+	}
+	for indx := range b.Blobs {
+		if b.Blobs[indx] != other.Blobs[indx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ExecutionPayloadAndBlobsBundle object
+func (e *ExecutionPayloadAndBlobsBundle) Copy() *ExecutionPayloadAndBlobsBundle {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	cpy.ExecutionPayload = e.ExecutionPayload.Copy()
+	cpy.BlobsBundle = e.BlobsBundle.Copy()
+	return &cpy
+}
+
+// Equal returns whether two ExecutionPayloadAndBlobsBundle objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ExecutionPayloadAndBlobsBundle) Equal(other *ExecutionPayloadAndBlobsBundle) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ExecutionPayloadAndBlobsBundle)
+	}
+	if other == nil {
+		other = new(ExecutionPayloadAndBlobsBundle)
+	}
+	if !e.ExecutionPayload.Equal(other.ExecutionPayload) {
+		return false
+	}
+	if !e.BlobsBundle.Equal(other.BlobsBundle) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientHeaderDeneb object
+func (l *LightClientHeaderDeneb) Copy() *LightClientHeaderDeneb {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.Header = l.Header.Copy()
+	cpy.Execution = l.Execution.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientHeaderDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientHeaderDeneb) Equal(other *LightClientHeaderDeneb) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientHeaderDeneb)
+	}
+	if other == nil {
+		other = new(LightClientHeaderDeneb)
+	}
+	if !l.Header.Equal(other.Header) {
+		return false
+	}
+	if !l.Execution.Equal(other.Execution) {
+		return false
+	}
+	if l.ExecutionBranch != other.ExecutionBranch {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientBootstrapDeneb object
+func (l *LightClientBootstrapDeneb) Copy() *LightClientBootstrapDeneb {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.Header = l.Header.Copy()
+	cpy.CurrentSyncCommittee = l.CurrentSyncCommittee.Copy()
+	if l.CurrentSyncCommitteeBranch != nil {
+		cpy.CurrentSyncCommitteeBranch = make([][32]byte, len(l.CurrentSyncCommitteeBranch))
+		copy(cpy.CurrentSyncCommitteeBranch, l.CurrentSyncCommitteeBranch)
+	}
+	return &cpy
+}
+
+// Equal returns whether two LightClientBootstrapDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientBootstrapDeneb) Equal(other *LightClientBootstrapDeneb) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientBootstrapDeneb)
+	}
+	if other == nil {
+		other = new(LightClientBootstrapDeneb)
+	}
+	if !l.Header.Equal(other.Header) {
+		return false
+	}
+	if !l.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if len(l.CurrentSyncCommitteeBranch) != len(other.CurrentSyncCommitteeBranch) {
+		return false
+	}
+	for indx := range l.CurrentSyncCommitteeBranch {
+		if l.CurrentSyncCommitteeBranch[indx] != other.CurrentSyncCommitteeBranch[indx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientFinalityUpdateDeneb object
+func (l *LightClientFinalityUpdateDeneb) Copy() *LightClientFinalityUpdateDeneb {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.FinalizedHeader = l.FinalizedHeader.Copy()
+	if l.FinalityBranch != nil {
+		cpy.FinalityBranch = make([][32]byte, len(l.FinalityBranch))
+		copy(cpy.FinalityBranch, l.FinalityBranch)
+	}
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientFinalityUpdateDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientFinalityUpdateDeneb) Equal(other *LightClientFinalityUpdateDeneb) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientFinalityUpdateDeneb)
+	}
+	if other == nil {
+		other = new(LightClientFinalityUpdateDeneb)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.FinalizedHeader.Equal(other.FinalizedHeader) {
+		return false
+	}
+	if len(l.FinalityBranch) != len(other.FinalityBranch) {
+		return false
+	}
+	for indx := range l.FinalityBranch {
+		if l.FinalityBranch[indx] != other.FinalityBranch[indx] {
+			return false
+		}
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientOptimisticUpdateDeneb object
+func (l *LightClientOptimisticUpdateDeneb) Copy() *LightClientOptimisticUpdateDeneb {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientOptimisticUpdateDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientOptimisticUpdateDeneb) Equal(other *LightClientOptimisticUpdateDeneb) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientOptimisticUpdateDeneb)
+	}
+	if other == nil {
+		other = new(LightClientOptimisticUpdateDeneb)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientUpdateDeneb object
+func (l *LightClientUpdateDeneb) Copy() *LightClientUpdateDeneb {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.NextSyncCommittee = l.NextSyncCommittee.Copy()
+	if l.NextSyncCommitteeBranch != nil {
+		cpy.NextSyncCommitteeBranch = make([][32]byte, len(l.NextSyncCommitteeBranch))
+		copy(cpy.NextSyncCommitteeBranch, l.NextSyncCommitteeBranch)
+	}
+	cpy.FinalizedHeader = l.FinalizedHeader.Copy()
+	if l.FinalityBranch != nil {
+		cpy.FinalityBranch = make([][32]byte, len(l.FinalityBranch))
+		copy(cpy.FinalityBranch, l.FinalityBranch)
+	}
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientUpdateDeneb objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientUpdateDeneb) Equal(other *LightClientUpdateDeneb) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientUpdateDeneb)
+	}
+	if other == nil {
+		other = new(LightClientUpdateDeneb)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	if len(l.NextSyncCommitteeBranch) != len(other.NextSyncCommitteeBranch) {
+		return false
+	}
+	for indx := range l.NextSyncCommitteeBranch {
+		if l.NextSyncCommitteeBranch[indx] != other.NextSyncCommitteeBranch[indx] {
+			return false
+		}
+	}
+	if !l.FinalizedHeader.Equal(other.FinalizedHeader) {
+		return false
+	}
+	if len(l.FinalityBranch) != len(other.FinalityBranch) {
+		return false
+	}
+	for indx := range l.FinalityBranch {
+		if l.FinalityBranch[indx] != other.FinalityBranch[indx] {
+			return false
+		}
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the DepositRequest object
+func (d *DepositRequest) Copy() *DepositRequest {
+	if d == nil {
+		return nil
+	}
+	cpy := *d
+	return &cpy
+}
+
+// Equal returns whether two DepositRequest objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (d *DepositRequest) Equal(other *DepositRequest) bool {
+	if d == other {
+		return true
+	}
+	if d == nil {
+		d = new(DepositRequest)
+	}
+	if other == nil {
+		other = new(DepositRequest)
+	}
+	if d.Pubkey != other.Pubkey {
+		return false
+	}
+	if d.WithdrawalCredentials != other.WithdrawalCredentials {
+		return false
+	}
+	if d.Amount != other.Amount {
+		return false
+	}
+	if d.Signature != other.Signature {
+		return false
+	}
+	if d.Index != other.Index {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the WithdrawalRequest object
+func (w *WithdrawalRequest) Copy() *WithdrawalRequest {
+	if w == nil {
+		return nil
+	}
+	cpy := *w
+	return &cpy
+}
+
+// Equal returns whether two WithdrawalRequest objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (w *WithdrawalRequest) Equal(other *WithdrawalRequest) bool {
+	if w == other {
+		return true
+	}
+	if w == nil {
+		w = new(WithdrawalRequest)
+	}
+	if other == nil {
+		other = new(WithdrawalRequest)
+	}
+	if w.SourceAddress != other.SourceAddress {
+		return false
+	}
+	if w.ValidatorPubkey != other.ValidatorPubkey {
+		return false
+	}
+	if w.Amount != other.Amount {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ConsolidationRequest object
+func (c *ConsolidationRequest) Copy() *ConsolidationRequest {
+	if c == nil {
+		return nil
+	}
+	cpy := *c
+	return &cpy
+}
+
+// Equal returns whether two ConsolidationRequest objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (c *ConsolidationRequest) Equal(other *ConsolidationRequest) bool {
+	if c == other {
+		return true
+	}
+	if c == nil {
+		c = new(ConsolidationRequest)
+	}
+	if other == nil {
+		other = new(ConsolidationRequest)
+	}
+	if c.SourceAddress != other.SourceAddress {
+		return false
+	}
+	if c.SourcePubkey != other.SourcePubkey {
+		return false
+	}
+	if c.TargetPubkey != other.TargetPubkey {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the ExecutionRequests object
+func (e *ExecutionRequests) Copy() *ExecutionRequests {
+	if e == nil {
+		return nil
+	}
+	cpy := *e
+	if e.Deposits != nil {
+		cpy.Deposits = make([]*DepositRequest, len(e.Deposits))
+		for indx, elem := range e.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if e.Withdrawals != nil {
+		cpy.Withdrawals = make([]*WithdrawalRequest, len(e.Withdrawals))
+		for indx, elem := range e.Withdrawals {
+			cpy.Withdrawals[indx] = elem.Copy()
+		}
+	}
+	if e.Consolidations != nil {
+		cpy.Consolidations = make([]*ConsolidationRequest, len(e.Consolidations))
+		for indx, elem := range e.Consolidations {
+			cpy.Consolidations[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two ExecutionRequests objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (e *ExecutionRequests) Equal(other *ExecutionRequests) bool {
+	if e == other {
+		return true
+	}
+	if e == nil {
+		e = new(ExecutionRequests)
+	}
+	if other == nil {
+		other = new(ExecutionRequests)
+	}
+	if len(e.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range e.Deposits {
+		if !e.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(e.Withdrawals) != len(other.Withdrawals) {
+		return false
+	}
+	for indx := range e.Withdrawals {
+		if !e.Withdrawals[indx].Equal(other.Withdrawals[indx]) {
+			return false
+		}
+	}
+	if len(e.Consolidations) != len(other.Consolidations) {
+		return false
+	}
+	for indx := range e.Consolidations {
+		if !e.Consolidations[indx].Equal(other.Consolidations[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the PendingDeposit object
+func (p *PendingDeposit) Copy() *PendingDeposit {
+	if p == nil {
+		return nil
+	}
+	cpy := *p
+	return &cpy
+}
+
+// Equal returns whether two PendingDeposit objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (p *PendingDeposit) Equal(other *PendingDeposit) bool {
+	if p == other {
+		return true
+	}
+	if p == nil {
+		p = new(PendingDeposit)
+	}
+	if other == nil {
+		other = new(PendingDeposit)
+	}
+	if p.Pubkey != other.Pubkey {
+		return false
+	}
+	if p.WithdrawalCredentials != other.WithdrawalCredentials {
+		return false
+	}
+	if p.Amount != other.Amount {
+		return false
+	}
+	if p.Signature != other.Signature {
+		return false
+	}
+	if p.Slot != other.Slot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the PendingPartialWithdrawal object
+func (p *PendingPartialWithdrawal) Copy() *PendingPartialWithdrawal {
+	if p == nil {
+		return nil
+	}
+	cpy := *p
+	return &cpy
+}
+
+// Equal returns whether two PendingPartialWithdrawal objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (p *PendingPartialWithdrawal) Equal(other *PendingPartialWithdrawal) bool {
+	if p == other {
+		return true
+	}
+	if p == nil {
+		p = new(PendingPartialWithdrawal)
+	}
+	if other == nil {
+		other = new(PendingPartialWithdrawal)
+	}
+	if p.ValidatorIndex != other.ValidatorIndex {
+		return false
+	}
+	if p.Amount != other.Amount {
+		return false
+	}
+	if p.WithdrawableEpoch != other.WithdrawableEpoch {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the PendingConsolidation object
+func (p *PendingConsolidation) Copy() *PendingConsolidation {
+	if p == nil {
+		return nil
+	}
+	cpy := *p
+	return &cpy
+}
+
+// Equal returns whether two PendingConsolidation objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (p *PendingConsolidation) Equal(other *PendingConsolidation) bool {
+	if p == other {
+		return true
+	}
+	if p == nil {
+		p = new(PendingConsolidation)
+	}
+	if other == nil {
+		other = new(PendingConsolidation)
+	}
+	if p.SourceIndex != other.SourceIndex {
+		return false
+	}
+	if p.TargetIndex != other.TargetIndex {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the AttestationElectra object
+func (a *AttestationElectra) Copy() *AttestationElectra {
+	if a == nil {
+		return nil
+	}
+	cpy := *a
+	if a.AggregationBits != nil {
+		cpy.AggregationBits = make([]byte, len(a.AggregationBits))
+		copy(cpy.AggregationBits, a.AggregationBits)
+	}
+	cpy.Data = a.Data.Copy()
+	return &cpy
+}
+
+// Equal returns whether two AttestationElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (a *AttestationElectra) Equal(other *AttestationElectra) bool {
+	if a == other {
+		return true
+	}
+	if a == nil {
+		a = new(AttestationElectra)
+	}
+	if other == nil {
+		other = new(AttestationElectra)
+	}
+	if !bytes.Equal(a.AggregationBits, other.AggregationBits) {
+		return false
+	}
+	if !a.Data.Equal(other.Data) {
+		return false
+	}
+	if a.Signature != other.Signature {
+		return false
+	}
+	if a.CommitteeBits != other.CommitteeBits {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SingleAttestation object
+func (s *SingleAttestation) Copy() *SingleAttestation {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Data = s.Data.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SingleAttestation objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SingleAttestation) Equal(other *SingleAttestation) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SingleAttestation)
+	}
+	if other == nil {
+		other = new(SingleAttestation)
+	}
+	if s.CommitteeIndex != other.CommitteeIndex {
+		return false
+	}
+	if s.AttesterIndex != other.AttesterIndex {
+		return false
+	}
+	if !s.Data.Equal(other.Data) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the IndexedAttestationElectra object
+func (i *IndexedAttestationElectra) Copy() *IndexedAttestationElectra {
+	if i == nil {
+		return nil
+	}
+	cpy := *i
+	if i.AttestationIndices != nil {
+		cpy.AttestationIndices = make([]uint64, len(i.AttestationIndices))
+		copy(cpy.AttestationIndices, i.AttestationIndices)
+	}
+	cpy.Data = i.Data.Copy()
+	return &cpy
+}
+
+// Equal returns whether two IndexedAttestationElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (i *IndexedAttestationElectra) Equal(other *IndexedAttestationElectra) bool {
+	if i == other {
+		return true
+	}
+	if i == nil {
+		i = new(IndexedAttestationElectra)
+	}
+	if other == nil {
+		other = new(IndexedAttestationElectra)
+	}
+	if len(i.AttestationIndices) != len(other.AttestationIndices) {
+		return false
+	}
+	for indx := range i.AttestationIndices {
+		if i.AttestationIndices[indx] != other.AttestationIndices[indx] {
+			return false
+		}
+	}
+	if !i.Data.Equal(other.Data) {
+		return false
+	}
+	if i.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the AttesterSlashingElectra object
+func (a *AttesterSlashingElectra) Copy() *AttesterSlashingElectra {
+	if a == nil {
+		return nil
+	}
+	cpy := *a
+	cpy.Attestation1 = a.Attestation1.Copy()
+	cpy.Attestation2 = a.Attestation2.Copy()
+	return &cpy
+}
+
+// Equal returns whether two AttesterSlashingElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (a *AttesterSlashingElectra) Equal(other *AttesterSlashingElectra) bool {
+	if a == other {
+		return true
+	}
+	if a == nil {
+		a = new(AttesterSlashingElectra)
+	}
+	if other == nil {
+		other = new(AttesterSlashingElectra)
+	}
+	if !a.Attestation1.Equal(other.Attestation1) {
+		return false
+	}
+	if !a.Attestation2.Equal(other.Attestation2) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the AggregateAndProofElectra object
+func (a *AggregateAndProofElectra) Copy() *AggregateAndProofElectra {
+	if a == nil {
+		return nil
+	}
+	cpy := *a
+	cpy.Aggregate = a.Aggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two AggregateAndProofElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (a *AggregateAndProofElectra) Equal(other *AggregateAndProofElectra) bool {
+	if a == other {
+		return true
+	}
+	if a == nil {
+		a = new(AggregateAndProofElectra)
+	}
+	if other == nil {
+		other = new(AggregateAndProofElectra)
+	}
+	if a.Index != other.Index {
+		return false
+	}
+	if !a.Aggregate.Equal(other.Aggregate) {
+		return false
+	}
+	if a.SelectionProof != other.SelectionProof {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedAggregateAndProofElectra object
+func (s *SignedAggregateAndProofElectra) Copy() *SignedAggregateAndProofElectra {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Message = s.Message.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedAggregateAndProofElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedAggregateAndProofElectra) Equal(other *SignedAggregateAndProofElectra) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedAggregateAndProofElectra)
+	}
+	if other == nil {
+		other = new(SignedAggregateAndProofElectra)
+	}
+	if !s.Message.Equal(other.Message) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconStateElectra object
+func (b *BeaconStateElectra) Copy() *BeaconStateElectra {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Fork = b.Fork.Copy()
+	cpy.LatestBlockHeader = b.LatestBlockHeader.Copy()
+	if b.HistoricalRoots != nil {
+		cpy.HistoricalRoots = make([][]byte, len(b.HistoricalRoots))
+		for indx, elem := range b.HistoricalRoots {
+			if elem != nil {
+				cpy.HistoricalRoots[indx] = append([]byte{}, elem...)
+			}
+		}
+	}
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.Eth1DataVotes != nil {
+		cpy.Eth1DataVotes = make([]*Eth1Data, len(b.Eth1DataVotes))
+		for indx, elem := range b.Eth1DataVotes {
+			cpy.Eth1DataVotes[indx] = elem.Copy()
+		}
+	}
+	if b.Validators != nil {
+		cpy.Validators = make([]*Validator, len(b.Validators))
+		for indx, elem := range b.Validators {
+			cpy.Validators[indx] = elem.Copy()
+		}
+	}
+	if b.Balances != nil {
+		cpy.Balances = make([]uint64, len(b.Balances))
+		copy(cpy.Balances, b.Balances)
+	}
+	if b.Slashings != nil {
+		cpy.Slashings = make([]uint64, len(b.Slashings))
+		copy(cpy.Slashings, b.Slashings)
+	}
+	if b.PreviousEpochParticipation != nil {
+		cpy.PreviousEpochParticipation = make([]byte, len(b.PreviousEpochParticipation))
+		copy(cpy.PreviousEpochParticipation, b.PreviousEpochParticipation)
+	}
+	if b.CurrentEpochParticipation != nil {
+		cpy.CurrentEpochParticipation = make([]byte, len(b.CurrentEpochParticipation))
+		copy(cpy.CurrentEpochParticipation, b.CurrentEpochParticipation)
+	}
+	cpy.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint.Copy()
+	cpy.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint.Copy()
+	cpy.FinalizedCheckpoint = b.FinalizedCheckpoint.Copy()
+	if b.InactivityScores != nil {
+		cpy.InactivityScores = make([]uint64, len(b.InactivityScores))
+		copy(cpy.InactivityScores, b.InactivityScores)
+	}
+	cpy.CurrentSyncCommittee = b.CurrentSyncCommittee.Copy()
+	cpy.NextSyncCommittee = b.NextSyncCommittee.Copy()
+	cpy.LatestExecutionPayloadHeader = b.LatestExecutionPayloadHeader.Copy()
+	if b.HistoricalSummaries != nil {
+		cpy.HistoricalSummaries = make([]*HistoricalSummary, len(b.HistoricalSummaries))
+		for indx, elem := range b.HistoricalSummaries {
+			cpy.HistoricalSummaries[indx] = elem.Copy()
+		}
+	}
+	if b.PendingDeposits != nil {
+		cpy.PendingDeposits = make([]*PendingDeposit, len(b.PendingDeposits))
+		for indx, elem := range b.PendingDeposits {
+			cpy.PendingDeposits[indx] = elem.Copy()
+		}
+	}
+	if b.PendingPartialWithdrawals != nil {
+		cpy.PendingPartialWithdrawals = make([]*PendingPartialWithdrawal, len(b.PendingPartialWithdrawals))
+		for indx, elem := range b.PendingPartialWithdrawals {
+			cpy.PendingPartialWithdrawals[indx] = elem.Copy()
+		}
+	}
+	if b.PendingConsolidations != nil {
+		cpy.PendingConsolidations = make([]*PendingConsolidation, len(b.PendingConsolidations))
+		for indx, elem := range b.PendingConsolidations {
+			cpy.PendingConsolidations[indx] = elem.Copy()
+		}
+	}
+	return &cpy
+}
+
+// Equal returns whether two BeaconStateElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconStateElectra) Equal(other *BeaconStateElectra) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconStateElectra)
+	}
+	if other == nil {
+		other = new(BeaconStateElectra)
+	}
+	if b.GenesisTime != other.GenesisTime {
+		return false
+	}
+	if b.GenesisValidatorsRoot != other.GenesisValidatorsRoot {
+		return false
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if !b.Fork.Equal(other.Fork) {
+		return false
+	}
+	if !b.LatestBlockHeader.Equal(other.LatestBlockHeader) {
+		return false
+	}
+	if b.BlockRoots != other.BlockRoots {
+		return false
+	}
+	if b.StateRoots != other.StateRoots {
+		return false
+	}
+	if len(b.HistoricalRoots) != len(other.HistoricalRoots) {
+		return false
+	}
+	for indx := range b.HistoricalRoots {
+		if !bytes.Equal(b.HistoricalRoots[indx], other.HistoricalRoots[indx]) {
+			return false
+		}
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if len(b.Eth1DataVotes) != len(other.Eth1DataVotes) {
+		return false
+	}
+	for indx := range b.Eth1DataVotes {
+		if !b.Eth1DataVotes[indx].Equal(other.Eth1DataVotes[indx]) {
+			return false
+		}
+	}
+	if b.Eth1DepositIndex != other.Eth1DepositIndex {
+		return false
+	}
+	if len(b.Validators) != len(other.Validators) {
+		return false
+	}
+	for indx := range b.Validators {
+		if !b.Validators[indx].Equal(other.Validators[indx]) {
+			return false
+		}
+	}
+	if len(b.Balances) != len(other.Balances) {
+		return false
+	}
+	for indx := range b.Balances {
+		if b.Balances[indx] != other.Balances[indx] {
+			return false
+		}
+	}
+	if b.RandaoMixes != other.RandaoMixes {
+		return false
+	}
+	if len(b.Slashings) != len(other.Slashings) {
+		return false
+	}
+	for indx := range b.Slashings {
+		if b.Slashings[indx] != other.Slashings[indx] {
+			return false
+		}
+	}
+	if !bytes.Equal(b.PreviousEpochParticipation, other.PreviousEpochParticipation) {
+		return false
+	}
+	if !bytes.Equal(b.CurrentEpochParticipation, other.CurrentEpochParticipation) {
+		return false
+	}
+	if b.JustificationBits != other.JustificationBits {
+		return false
+	}
+	if !b.PreviousJustifiedCheckpoint.Equal(other.PreviousJustifiedCheckpoint) {
+		return false
+	}
+	if !b.CurrentJustifiedCheckpoint.Equal(other.CurrentJustifiedCheckpoint) {
+		return false
+	}
+	if !b.FinalizedCheckpoint.Equal(other.FinalizedCheckpoint) {
+		return false
+	}
+	if len(b.InactivityScores) != len(other.InactivityScores) {
+		return false
+	}
+	for indx := range b.InactivityScores {
+		if b.InactivityScores[indx] != other.InactivityScores[indx] {
+			return false
+		}
+	}
+	if !b.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if !b.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	if !b.LatestExecutionPayloadHeader.Equal(other.LatestExecutionPayloadHeader) {
+		return false
+	}
+	if b.NextWithdrawalIndex != other.NextWithdrawalIndex {
+		return false
+	}
+	if b.NextWithdrawalValidatorIndex != other.NextWithdrawalValidatorIndex {
+		return false
+	}
+	if len(b.HistoricalSummaries) != len(other.HistoricalSummaries) {
+		return false
+	}
+	for indx := range b.HistoricalSummaries {
+		if !b.HistoricalSummaries[indx].Equal(other.HistoricalSummaries[indx]) {
+			return false
+		}
+	}
+	if b.DepositRequestsStartIndex != other.DepositRequestsStartIndex {
+		return false
+	}
+	if b.DepositBalanceToConsume != other.DepositBalanceToConsume {
+		return false
+	}
+	if b.ExitBalanceToConsume != other.ExitBalanceToConsume {
+		return false
+	}
+	if b.EarliestExitEpoch != other.EarliestExitEpoch {
+		return false
+	}
+	if b.ConsolidationBalanceToConsume != other.ConsolidationBalanceToConsume {
+		return false
+	}
+	if b.EarliestConsolidationEpoch != other.EarliestConsolidationEpoch {
+		return false
+	}
+	if len(b.PendingDeposits) != len(other.PendingDeposits) {
+		return false
+	}
+	for indx := range b.PendingDeposits {
+		if !b.PendingDeposits[indx].Equal(other.PendingDeposits[indx]) {
+			return false
+		}
+	}
+	if len(b.PendingPartialWithdrawals) != len(other.PendingPartialWithdrawals) {
+		return false
+	}
+	for indx := range b.PendingPartialWithdrawals {
+		if !b.PendingPartialWithdrawals[indx].Equal(other.PendingPartialWithdrawals[indx]) {
+			return false
+		}
+	}
+	if len(b.PendingConsolidations) != len(other.PendingConsolidations) {
+		return false
+	}
+	for indx := range b.PendingConsolidations {
+		if !b.PendingConsolidations[indx].Equal(other.PendingConsolidations[indx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) Copy() *SignedBeaconBlockElectra {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBeaconBlockElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBeaconBlockElectra) Equal(other *SignedBeaconBlockElectra) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBeaconBlockElectra)
+	}
+	if other == nil {
+		other = new(SignedBeaconBlockElectra)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockElectra object
+func (b *BeaconBlockElectra) Copy() *BeaconBlockElectra {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockElectra) Equal(other *BeaconBlockElectra) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockElectra)
+	}
+	if other == nil {
+		other = new(BeaconBlockElectra)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) Copy() *BeaconBlockBodyElectra {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashingElectra, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*AttestationElectra, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	cpy.ExecutionPayload = b.ExecutionPayload.Copy()
+	if b.BlsToExecutionChanges != nil {
+		cpy.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, len(b.BlsToExecutionChanges))
+		for indx, elem := range b.BlsToExecutionChanges {
+			cpy.BlsToExecutionChanges[indx] = elem.Copy()
+		}
+	}
+	if b.BlobKzgCommitments != nil {
+		cpy.BlobKzgCommitments = make([][48]byte, len(b.BlobKzgCommitments))
+		copy(cpy.BlobKzgCommitments, b.BlobKzgCommitments)
+	}
+	cpy.ExecutionRequests = b.ExecutionRequests.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BeaconBlockBodyElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BeaconBlockBodyElectra) Equal(other *BeaconBlockBodyElectra) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BeaconBlockBodyElectra)
+	}
+	if other == nil {
+		other = new(BeaconBlockBodyElectra)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayload.Equal(other.ExecutionPayload) {
+		return false
+	}
+	if len(b.BlsToExecutionChanges) != len(other.BlsToExecutionChanges) {
+		return false
+	}
+	for indx := range b.BlsToExecutionChanges {
+		if !b.BlsToExecutionChanges[indx].Equal(other.BlsToExecutionChanges[indx]) {
+			return false
+		}
+	}
+	if len(b.BlobKzgCommitments) != len(other.BlobKzgCommitments) {
+		return false
+	}
+	for indx := range b.BlobKzgCommitments {
+		if b.BlobKzgCommitments[indx] != other.BlobKzgCommitments[indx] {
+			return false
+		}
+	}
+	if !b.ExecutionRequests.Equal(other.ExecutionRequests) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) Copy() *SignedBlindedBeaconBlockElectra {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Block = s.Block.Copy()
+	return &cpy
+}
+
+// Equal returns whether two SignedBlindedBeaconBlockElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (s *SignedBlindedBeaconBlockElectra) Equal(other *SignedBlindedBeaconBlockElectra) bool {
+	if s == other {
+		return true
+	}
+	if s == nil {
+		s = new(SignedBlindedBeaconBlockElectra)
+	}
+	if other == nil {
+		other = new(SignedBlindedBeaconBlockElectra)
+	}
+	if !s.Block.Equal(other.Block) {
+		return false
+	}
+	if s.Signature != other.Signature {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) Copy() *BlindedBeaconBlockElectra {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Body = b.Body.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BlindedBeaconBlockElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlindedBeaconBlockElectra) Equal(other *BlindedBeaconBlockElectra) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlindedBeaconBlockElectra)
+	}
+	if other == nil {
+		other = new(BlindedBeaconBlockElectra)
+	}
+	if b.Slot != other.Slot {
+		return false
+	}
+	if b.ProposerIndex != other.ProposerIndex {
+		return false
+	}
+	if b.ParentRoot != other.ParentRoot {
+		return false
+	}
+	if b.StateRoot != other.StateRoot {
+		return false
+	}
+	if !b.Body.Equal(other.Body) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) Copy() *BlindedBeaconBlockBodyElectra {
+	if b == nil {
+		return nil
+	}
+	cpy := *b
+	cpy.Eth1Data = b.Eth1Data.Copy()
+	if b.ProposerSlashings != nil {
+		cpy.ProposerSlashings = make([]*ProposerSlashing, len(b.ProposerSlashings))
+		for indx, elem := range b.ProposerSlashings {
+			cpy.ProposerSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.AttesterSlashings != nil {
+		cpy.AttesterSlashings = make([]*AttesterSlashingElectra, len(b.AttesterSlashings))
+		for indx, elem := range b.AttesterSlashings {
+			cpy.AttesterSlashings[indx] = elem.Copy()
+		}
+	}
+	if b.Attestations != nil {
+		cpy.Attestations = make([]*AttestationElectra, len(b.Attestations))
+		for indx, elem := range b.Attestations {
+			cpy.Attestations[indx] = elem.Copy()
+		}
+	}
+	if b.Deposits != nil {
+		cpy.Deposits = make([]*Deposit, len(b.Deposits))
+		for indx, elem := range b.Deposits {
+			cpy.Deposits[indx] = elem.Copy()
+		}
+	}
+	if b.VoluntaryExits != nil {
+		cpy.VoluntaryExits = make([]*SignedVoluntaryExit, len(b.VoluntaryExits))
+		for indx, elem := range b.VoluntaryExits {
+			cpy.VoluntaryExits[indx] = elem.Copy()
+		}
+	}
+	cpy.SyncAggregate = b.SyncAggregate.Copy()
+	cpy.ExecutionPayloadHeader = b.ExecutionPayloadHeader.Copy()
+	if b.BlsToExecutionChanges != nil {
+		cpy.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, len(b.BlsToExecutionChanges))
+		for indx, elem := range b.BlsToExecutionChanges {
+			cpy.BlsToExecutionChanges[indx] = elem.Copy()
+		}
+	}
+	if b.BlobKzgCommitments != nil {
+		cpy.BlobKzgCommitments = make([][48]byte, len(b.BlobKzgCommitments))
+		copy(cpy.BlobKzgCommitments, b.BlobKzgCommitments)
+	}
+	cpy.ExecutionRequests = b.ExecutionRequests.Copy()
+	return &cpy
+}
+
+// Equal returns whether two BlindedBeaconBlockBodyElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (b *BlindedBeaconBlockBodyElectra) Equal(other *BlindedBeaconBlockBodyElectra) bool {
+	if b == other {
+		return true
+	}
+	if b == nil {
+		b = new(BlindedBeaconBlockBodyElectra)
+	}
+	if other == nil {
+		other = new(BlindedBeaconBlockBodyElectra)
+	}
+	if b.RandaoReveal != other.RandaoReveal {
+		return false
+	}
+	if !b.Eth1Data.Equal(other.Eth1Data) {
+		return false
+	}
+	if b.Graffiti != other.Graffiti {
+		return false
+	}
+	if len(b.ProposerSlashings) != len(other.ProposerSlashings) {
+		return false
+	}
+	for indx := range b.ProposerSlashings {
+		if !b.ProposerSlashings[indx].Equal(other.ProposerSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.AttesterSlashings) != len(other.AttesterSlashings) {
+		return false
+	}
+	for indx := range b.AttesterSlashings {
+		if !b.AttesterSlashings[indx].Equal(other.AttesterSlashings[indx]) {
+			return false
+		}
+	}
+	if len(b.Attestations) != len(other.Attestations) {
+		return false
+	}
+	for indx := range b.Attestations {
+		if !b.Attestations[indx].Equal(other.Attestations[indx]) {
+			return false
+		}
+	}
+	if len(b.Deposits) != len(other.Deposits) {
+		return false
+	}
+	for indx := range b.Deposits {
+		if !b.Deposits[indx].Equal(other.Deposits[indx]) {
+			return false
+		}
+	}
+	if len(b.VoluntaryExits) != len(other.VoluntaryExits) {
+		return false
+	}
+	for indx := range b.VoluntaryExits {
+		if !b.VoluntaryExits[indx].Equal(other.VoluntaryExits[indx]) {
+			return false
+		}
+	}
+	if !b.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if !b.ExecutionPayloadHeader.Equal(other.ExecutionPayloadHeader) {
+		return false
+	}
+	if len(b.BlsToExecutionChanges) != len(other.BlsToExecutionChanges) {
+		return false
+	}
+	for indx := range b.BlsToExecutionChanges {
+		if !b.BlsToExecutionChanges[indx].Equal(other.BlsToExecutionChanges[indx]) {
+			return false
+		}
+	}
+	if len(b.BlobKzgCommitments) != len(other.BlobKzgCommitments) {
+		return false
+	}
+	for indx := range b.BlobKzgCommitments {
+		if b.BlobKzgCommitments[indx] != other.BlobKzgCommitments[indx] {
+			return false
+		}
+	}
+	if !b.ExecutionRequests.Equal(other.ExecutionRequests) {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientBootstrapElectra object
+func (l *LightClientBootstrapElectra) Copy() *LightClientBootstrapElectra {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.Header = l.Header.Copy()
+	cpy.CurrentSyncCommittee = l.CurrentSyncCommittee.Copy()
+	if l.CurrentSyncCommitteeBranch != nil {
+		cpy.CurrentSyncCommitteeBranch = make([][32]byte, len(l.CurrentSyncCommitteeBranch))
+		copy(cpy.CurrentSyncCommitteeBranch, l.CurrentSyncCommitteeBranch)
+	}
+	return &cpy
+}
+
+// Equal returns whether two LightClientBootstrapElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientBootstrapElectra) Equal(other *LightClientBootstrapElectra) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientBootstrapElectra)
+	}
+	if other == nil {
+		other = new(LightClientBootstrapElectra)
+	}
+	if !l.Header.Equal(other.Header) {
+		return false
+	}
+	if !l.CurrentSyncCommittee.Equal(other.CurrentSyncCommittee) {
+		return false
+	}
+	if len(l.CurrentSyncCommitteeBranch) != len(other.CurrentSyncCommitteeBranch) {
+		return false
+	}
+	for indx := range l.CurrentSyncCommitteeBranch {
+		if l.CurrentSyncCommitteeBranch[indx] != other.CurrentSyncCommitteeBranch[indx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientFinalityUpdateElectra object
+func (l *LightClientFinalityUpdateElectra) Copy() *LightClientFinalityUpdateElectra {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.FinalizedHeader = l.FinalizedHeader.Copy()
+	if l.FinalityBranch != nil {
+		cpy.FinalityBranch = make([][32]byte, len(l.FinalityBranch))
+		copy(cpy.FinalityBranch, l.FinalityBranch)
+	}
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientFinalityUpdateElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientFinalityUpdateElectra) Equal(other *LightClientFinalityUpdateElectra) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientFinalityUpdateElectra)
+	}
+	if other == nil {
+		other = new(LightClientFinalityUpdateElectra)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.FinalizedHeader.Equal(other.FinalizedHeader) {
+		return false
+	}
+	if len(l.FinalityBranch) != len(other.FinalityBranch) {
+		return false
+	}
+	for indx := range l.FinalityBranch {
+		if l.FinalityBranch[indx] != other.FinalityBranch[indx] {
+			return false
+		}
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
+
+// Copy returns a deep copy of the LightClientUpdateElectra object
+func (l *LightClientUpdateElectra) Copy() *LightClientUpdateElectra {
+	if l == nil {
+		return nil
+	}
+	cpy := *l
+	cpy.AttestedHeader = l.AttestedHeader.Copy()
+	cpy.NextSyncCommittee = l.NextSyncCommittee.Copy()
+	if l.NextSyncCommitteeBranch != nil {
+		cpy.NextSyncCommitteeBranch = make([][32]byte, len(l.NextSyncCommitteeBranch))
+		copy(cpy.NextSyncCommitteeBranch, l.NextSyncCommitteeBranch)
+	}
+	cpy.FinalizedHeader = l.FinalizedHeader.Copy()
+	if l.FinalityBranch != nil {
+		cpy.FinalityBranch = make([][32]byte, len(l.FinalityBranch))
+		copy(cpy.FinalityBranch, l.FinalityBranch)
+	}
+	cpy.SyncAggregate = l.SyncAggregate.Copy()
+	return &cpy
+}
+
+// Equal returns whether two LightClientUpdateElectra objects are equal. As in ssz, a nil object
+// is equal to an empty object and a nil list is equal to an empty list.
+func (l *LightClientUpdateElectra) Equal(other *LightClientUpdateElectra) bool {
+	if l == other {
+		return true
+	}
+	if l == nil {
+		l = new(LightClientUpdateElectra)
+	}
+	if other == nil {
+		other = new(LightClientUpdateElectra)
+	}
+	if !l.AttestedHeader.Equal(other.AttestedHeader) {
+		return false
+	}
+	if !l.NextSyncCommittee.Equal(other.NextSyncCommittee) {
+		return false
+	}
+	if len(l.NextSyncCommitteeBranch) != len(other.NextSyncCommitteeBranch) {
+		return false
+	}
+	for indx := range l.NextSyncCommitteeBranch {
+		if l.NextSyncCommitteeBranch[indx] != other.NextSyncCommitteeBranch[indx] {
+			return false
+		}
+	}
+	if !l.FinalizedHeader.Equal(other.FinalizedHeader) {
+		return false
+	}
+	if len(l.FinalityBranch) != len(other.FinalityBranch) {
+		return false
+	}
+	for indx := range l.FinalityBranch {
+		if l.FinalityBranch[indx] != other.FinalityBranch[indx] {
+			return false
+		}
+	}
+	if !l.SyncAggregate.Equal(other.SyncAggregate) {
+		return false
+	}
+	if l.SignatureSlot != other.SignatureSlot {
+		return false
+	}
+	return true
+}
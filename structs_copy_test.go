@@ -0,0 +1,106 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStructs_Copy(t *testing.T) {
+	var empty *BeaconBlockBodyDeneb
+	assert.Nil(t, empty.Copy())
+
+	body := &BeaconBlockBodyDeneb{
+		Eth1Data: &Eth1Data{DepositCount: 1},
+		Attestations: []*Attestation{
+			{AggregationBits: []byte{0x1}, Data: &AttestationData{Slot: 1}},
+		},
+		ExecutionPayload: &ExecutionPayloadDeneb{
+			ExtraData:    []byte{0x1},
+			Transactions: [][]byte{{0x1, 0x2}},
+		},
+		BlobKzgCommitments: [][48]byte{{0x1}},
+	}
+
+	cpy := body.Copy()
+	assert.True(t, body.Equal(cpy))
+
+	// nil fields are kept
+	assert.Nil(t, cpy.SyncAggregate)
+	assert.Nil(t, cpy.Deposits)
+
+	// the copy does not share memory
+	cpy.Eth1Data.DepositCount = 2
+	cpy.Attestations[0].Data.Slot = 2
+	cpy.Attestations[0].AggregationBits[0] = 0x2
+	cpy.ExecutionPayload.Transactions[0][0] = 0x2
+	cpy.BlobKzgCommitments[0] = [48]byte{0x2}
+
+	assert.Equal(t, uint64(1), body.Eth1Data.DepositCount)
+	assert.Equal(t, uint64(1), body.Attestations[0].Data.Slot)
+	assert.Equal(t, byte(0x1), body.Attestations[0].AggregationBits[0])
+	assert.Equal(t, byte(0x1), body.ExecutionPayload.Transactions[0][0])
+	assert.Equal(t, [48]byte{0x1}, body.BlobKzgCommitments[0])
+	assert.False(t, body.Equal(cpy))
+}
+
+func TestStructs_Equal(t *testing.T) {
+	cases := []struct {
+		a, b  *BeaconBlockBodyDeneb
+		equal bool
+	}{
+		{
+			// nil object and empty object
+			nil,
+			&BeaconBlockBodyDeneb{},
+			true,
+		},
+		{
+			// nil field and empty field
+			&BeaconBlockBodyDeneb{Eth1Data: &Eth1Data{}},
+			&BeaconBlockBodyDeneb{},
+			true,
+		},
+		{
+			// nil list and empty list
+			&BeaconBlockBodyDeneb{Attestations: []*Attestation{}, BlobKzgCommitments: [][48]byte{}},
+			&BeaconBlockBodyDeneb{},
+			true,
+		},
+		{
+			// nil byte list and empty byte list
+			&BeaconBlockBodyDeneb{ExecutionPayload: &ExecutionPayloadDeneb{ExtraData: []byte{}}},
+			&BeaconBlockBodyDeneb{ExecutionPayload: &ExecutionPayloadDeneb{}},
+			true,
+		},
+		{
+			// nil element in a list
+			&BeaconBlockBodyDeneb{Attestations: []*Attestation{nil}},
+			&BeaconBlockBodyDeneb{Attestations: []*Attestation{{}}},
+			true,
+		},
+		{
+			&BeaconBlockBodyDeneb{Attestations: []*Attestation{{}}},
+			&BeaconBlockBodyDeneb{},
+			false,
+		},
+		{
+			&BeaconBlockBodyDeneb{Eth1Data: &Eth1Data{DepositCount: 1}},
+			&BeaconBlockBodyDeneb{},
+			false,
+		},
+		{
+			&BeaconBlockBodyDeneb{BlobKzgCommitments: [][48]byte{{0x1}}},
+			&BeaconBlockBodyDeneb{BlobKzgCommitments: [][48]byte{{0x2}}},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.equal, c.a.Equal(c.b))
+		assert.Equal(t, c.equal, c.b.Equal(c.a))
+	}
+
+	// fields that are not part of the ssz object are not compared
+	assert.True(t, (&DepositData{Root: [32]byte{0x1}}).Equal(&DepositData{}))
+}
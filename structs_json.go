@@ -0,0 +1,4924 @@
+// Code generated by jsongen. DO NOT EDIT.
+package consensus
+
+import "encoding/json"
+
+type jsonAggregateAndProof struct {
+	Index          jsonUint64   `json:"aggregator_index"`
+	Aggregate      *Attestation `json:"aggregate"`
+	SelectionProof jsonBytes    `json:"selection_proof"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (a *AggregateAndProof) MarshalJSON() ([]byte, error) {
+	enc := &jsonAggregateAndProof{}
+	enc.Index = jsonUint64(a.Index)
+	enc.Aggregate = jsonObj(a.Aggregate)
+	enc.SelectionProof = a.SelectionProof[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (a *AggregateAndProof) UnmarshalJSON(data []byte) error {
+	var dec jsonAggregateAndProof
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	a.Index = uint64(dec.Index)
+	a.Aggregate = dec.Aggregate
+	if err := copyFixedBytes(a.SelectionProof[:], dec.SelectionProof, "selection_proof"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonCheckpoint struct {
+	Epoch jsonUint64 `json:"epoch"`
+	Root  jsonBytes  `json:"root"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (c *Checkpoint) MarshalJSON() ([]byte, error) {
+	enc := &jsonCheckpoint{}
+	enc.Epoch = jsonUint64(c.Epoch)
+	enc.Root = c.Root[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (c *Checkpoint) UnmarshalJSON(data []byte) error {
+	var dec jsonCheckpoint
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	c.Epoch = uint64(dec.Epoch)
+	if err := copyFixedBytes(c.Root[:], dec.Root, "root"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonAttestationData struct {
+	Slot            jsonUint64  `json:"slot"`
+	Index           jsonUint64  `json:"index"`
+	BeaconBlockHash jsonBytes   `json:"beacon_block_root"`
+	Source          *Checkpoint `json:"source"`
+	Target          *Checkpoint `json:"target"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (a *AttestationData) MarshalJSON() ([]byte, error) {
+	enc := &jsonAttestationData{}
+	enc.Slot = jsonUint64(a.Slot)
+	enc.Index = jsonUint64(a.Index)
+	enc.BeaconBlockHash = a.BeaconBlockHash[:]
+	enc.Source = jsonObj(a.Source)
+	enc.Target = jsonObj(a.Target)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (a *AttestationData) UnmarshalJSON(data []byte) error {
+	var dec jsonAttestationData
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	a.Slot = uint64(dec.Slot)
+	a.Index = uint64(dec.Index)
+	if err := copyFixedBytes(a.BeaconBlockHash[:], dec.BeaconBlockHash, "beacon_block_root"); err != nil {
+		return err
+	}
+	a.Source = dec.Source
+	a.Target = dec.Target
+	return nil
+}
+
+type jsonAttestation struct {
+	AggregationBits jsonBytes        `json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	Signature       jsonBytes        `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (a *Attestation) MarshalJSON() ([]byte, error) {
+	enc := &jsonAttestation{}
+	enc.AggregationBits = a.AggregationBits
+	enc.Data = jsonObj(a.Data)
+	enc.Signature = a.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (a *Attestation) UnmarshalJSON(data []byte) error {
+	var dec jsonAttestation
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	a.AggregationBits = dec.AggregationBits
+	a.Data = dec.Data
+	if err := copyFixedBytes(a.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonDepositData struct {
+	Pubkey                jsonBytes  `json:"pubkey"`
+	WithdrawalCredentials jsonBytes  `json:"withdrawal_credentials"`
+	Amount                jsonUint64 `json:"amount"`
+	Signature             jsonBytes  `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d *DepositData) MarshalJSON() ([]byte, error) {
+	enc := &jsonDepositData{}
+	enc.Pubkey = d.Pubkey[:]
+	enc.WithdrawalCredentials = d.WithdrawalCredentials[:]
+	enc.Amount = jsonUint64(d.Amount)
+	enc.Signature = d.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *DepositData) UnmarshalJSON(data []byte) error {
+	var dec jsonDepositData
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(d.Pubkey[:], dec.Pubkey, "pubkey"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(d.WithdrawalCredentials[:], dec.WithdrawalCredentials, "withdrawal_credentials"); err != nil {
+		return err
+	}
+	d.Amount = uint64(dec.Amount)
+	if err := copyFixedBytes(d.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonDeposit struct {
+	Proof jsonBytesList `json:"proof"`
+	Data  *DepositData  `json:"data"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d *Deposit) MarshalJSON() ([]byte, error) {
+	enc := &jsonDeposit{}
+	enc.Proof = make(jsonBytesList, len(d.Proof))
+	for indx := range d.Proof {
+		enc.Proof[indx] = d.Proof[indx][:]
+	}
+	enc.Data = jsonObj(d.Data)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *Deposit) UnmarshalJSON(data []byte) error {
+	var dec jsonDeposit
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := checkVectorLength(len(d.Proof), len(dec.Proof), "proof"); err != nil {
+		return err
+	}
+	for indx := range dec.Proof {
+		if err := copyFixedBytes(d.Proof[indx][:], dec.Proof[indx], "proof"); err != nil {
+			return err
+		}
+	}
+	d.Data = dec.Data
+	return nil
+}
+
+type jsonDepositMessage struct {
+	Pubkey                jsonBytes  `json:"pubkey"`
+	WithdrawalCredentials jsonBytes  `json:"withdrawal_credentials"`
+	Amount                jsonUint64 `json:"amount"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d *DepositMessage) MarshalJSON() ([]byte, error) {
+	enc := &jsonDepositMessage{}
+	enc.Pubkey = d.Pubkey[:]
+	enc.WithdrawalCredentials = d.WithdrawalCredentials[:]
+	enc.Amount = jsonUint64(d.Amount)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *DepositMessage) UnmarshalJSON(data []byte) error {
+	var dec jsonDepositMessage
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(d.Pubkey[:], dec.Pubkey, "pubkey"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(d.WithdrawalCredentials[:], dec.WithdrawalCredentials, "withdrawal_credentials"); err != nil {
+		return err
+	}
+	d.Amount = uint64(dec.Amount)
+	return nil
+}
+
+type jsonIndexedAttestation struct {
+	AttestationIndices jsonUint64List   `json:"attesting_indices"`
+	Data               *AttestationData `json:"data"`
+	Signature          jsonBytes        `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (i *IndexedAttestation) MarshalJSON() ([]byte, error) {
+	enc := &jsonIndexedAttestation{}
+	enc.AttestationIndices = i.AttestationIndices
+	enc.Data = jsonObj(i.Data)
+	enc.Signature = i.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *IndexedAttestation) UnmarshalJSON(data []byte) error {
+	var dec jsonIndexedAttestation
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	i.AttestationIndices = dec.AttestationIndices
+	i.Data = dec.Data
+	if err := copyFixedBytes(i.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonPendingAttestation struct {
+	AggregationBits jsonBytes        `json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	InclusionDelay  jsonUint64       `json:"inclusion_delay"`
+	ProposerIndex   jsonUint64       `json:"proposer_index"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *PendingAttestation) MarshalJSON() ([]byte, error) {
+	enc := &jsonPendingAttestation{}
+	enc.AggregationBits = p.AggregationBits
+	enc.Data = jsonObj(p.Data)
+	enc.InclusionDelay = jsonUint64(p.InclusionDelay)
+	enc.ProposerIndex = jsonUint64(p.ProposerIndex)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *PendingAttestation) UnmarshalJSON(data []byte) error {
+	var dec jsonPendingAttestation
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	p.AggregationBits = dec.AggregationBits
+	p.Data = dec.Data
+	p.InclusionDelay = uint64(dec.InclusionDelay)
+	p.ProposerIndex = uint64(dec.ProposerIndex)
+	return nil
+}
+
+type jsonFork struct {
+	PreviousVersion jsonBytes  `json:"previous_version"`
+	CurrentVersion  jsonBytes  `json:"current_version"`
+	Epoch           jsonUint64 `json:"epoch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (f *Fork) MarshalJSON() ([]byte, error) {
+	enc := &jsonFork{}
+	enc.PreviousVersion = f.PreviousVersion[:]
+	enc.CurrentVersion = f.CurrentVersion[:]
+	enc.Epoch = jsonUint64(f.Epoch)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (f *Fork) UnmarshalJSON(data []byte) error {
+	var dec jsonFork
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(f.PreviousVersion[:], dec.PreviousVersion, "previous_version"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(f.CurrentVersion[:], dec.CurrentVersion, "current_version"); err != nil {
+		return err
+	}
+	f.Epoch = uint64(dec.Epoch)
+	return nil
+}
+
+type jsonValidator struct {
+	Pubkey                     jsonBytes  `json:"pubkey"`
+	WithdrawalCredentials      jsonBytes  `json:"withdrawal_credentials"`
+	EffectiveBalance           jsonUint64 `json:"effective_balance"`
+	Slashed                    bool       `json:"slashed"`
+	ActivationEligibilityEpoch jsonUint64 `json:"activation_eligibility_epoch"`
+	ActivationEpoch            jsonUint64 `json:"activation_epoch"`
+	ExitEpoch                  jsonUint64 `json:"exit_epoch"`
+	WithdrawableEpoch          jsonUint64 `json:"withdrawable_epoch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (v *Validator) MarshalJSON() ([]byte, error) {
+	enc := &jsonValidator{}
+	enc.Pubkey = v.Pubkey[:]
+	enc.WithdrawalCredentials = v.WithdrawalCredentials[:]
+	enc.EffectiveBalance = jsonUint64(v.EffectiveBalance)
+	enc.Slashed = v.Slashed
+	enc.ActivationEligibilityEpoch = jsonUint64(v.ActivationEligibilityEpoch)
+	enc.ActivationEpoch = jsonUint64(v.ActivationEpoch)
+	enc.ExitEpoch = jsonUint64(v.ExitEpoch)
+	enc.WithdrawableEpoch = jsonUint64(v.WithdrawableEpoch)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (v *Validator) UnmarshalJSON(data []byte) error {
+	var dec jsonValidator
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(v.Pubkey[:], dec.Pubkey, "pubkey"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(v.WithdrawalCredentials[:], dec.WithdrawalCredentials, "withdrawal_credentials"); err != nil {
+		return err
+	}
+	v.EffectiveBalance = uint64(dec.EffectiveBalance)
+	v.Slashed = dec.Slashed
+	v.ActivationEligibilityEpoch = uint64(dec.ActivationEligibilityEpoch)
+	v.ActivationEpoch = uint64(dec.ActivationEpoch)
+	v.ExitEpoch = uint64(dec.ExitEpoch)
+	v.WithdrawableEpoch = uint64(dec.WithdrawableEpoch)
+	return nil
+}
+
+type jsonVoluntaryExit struct {
+	Epoch          jsonUint64 `json:"epoch"`
+	ValidatorIndex jsonUint64 `json:"validator_index"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (v *VoluntaryExit) MarshalJSON() ([]byte, error) {
+	enc := &jsonVoluntaryExit{}
+	enc.Epoch = jsonUint64(v.Epoch)
+	enc.ValidatorIndex = jsonUint64(v.ValidatorIndex)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (v *VoluntaryExit) UnmarshalJSON(data []byte) error {
+	var dec jsonVoluntaryExit
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	v.Epoch = uint64(dec.Epoch)
+	v.ValidatorIndex = uint64(dec.ValidatorIndex)
+	return nil
+}
+
+type jsonSignedVoluntaryExit struct {
+	Exit      *VoluntaryExit `json:"message"`
+	Signature jsonBytes      `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedVoluntaryExit) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedVoluntaryExit{}
+	enc.Exit = jsonObj(s.Exit)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedVoluntaryExit) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedVoluntaryExit
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Exit = dec.Exit
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonHistoricalBatch struct {
+	BlockRoots jsonBytesList `json:"block_roots"`
+	StateRoots jsonBytesList `json:"state_roots"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *HistoricalBatch) MarshalJSON() ([]byte, error) {
+	enc := &jsonHistoricalBatch{}
+	enc.BlockRoots = make(jsonBytesList, len(h.BlockRoots))
+	for indx := range h.BlockRoots {
+		enc.BlockRoots[indx] = h.BlockRoots[indx][:]
+	}
+	enc.StateRoots = make(jsonBytesList, len(h.StateRoots))
+	for indx := range h.StateRoots {
+		enc.StateRoots[indx] = h.StateRoots[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (h *HistoricalBatch) UnmarshalJSON(data []byte) error {
+	var dec jsonHistoricalBatch
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := checkVectorLength(len(h.BlockRoots), len(dec.BlockRoots), "block_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.BlockRoots {
+		if err := copyFixedBytes(h.BlockRoots[indx][:], dec.BlockRoots[indx], "block_roots"); err != nil {
+			return err
+		}
+	}
+	if err := checkVectorLength(len(h.StateRoots), len(dec.StateRoots), "state_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.StateRoots {
+		if err := copyFixedBytes(h.StateRoots[indx][:], dec.StateRoots[indx], "state_roots"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonEth1Data struct {
+	DepositRoot  jsonBytes  `json:"deposit_root"`
+	DepositCount jsonUint64 `json:"deposit_count"`
+	BlockHash    jsonBytes  `json:"block_hash"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *Eth1Data) MarshalJSON() ([]byte, error) {
+	enc := &jsonEth1Data{}
+	enc.DepositRoot = e.DepositRoot[:]
+	enc.DepositCount = jsonUint64(e.DepositCount)
+	enc.BlockHash = e.BlockHash[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *Eth1Data) UnmarshalJSON(data []byte) error {
+	var dec jsonEth1Data
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.DepositRoot[:], dec.DepositRoot, "deposit_root"); err != nil {
+		return err
+	}
+	e.DepositCount = uint64(dec.DepositCount)
+	if err := copyFixedBytes(e.BlockHash[:], dec.BlockHash, "block_hash"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSigningRoot struct {
+	ObjectRoot jsonBytes `json:"object_root"`
+	Domain     jsonBytes `json:"domain"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SigningRoot) MarshalJSON() ([]byte, error) {
+	enc := &jsonSigningRoot{}
+	enc.ObjectRoot = s.ObjectRoot[:]
+	enc.Domain = s.Domain
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SigningRoot) UnmarshalJSON(data []byte) error {
+	var dec jsonSigningRoot
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(s.ObjectRoot[:], dec.ObjectRoot, "object_root"); err != nil {
+		return err
+	}
+	s.Domain = dec.Domain
+	return nil
+}
+
+type jsonProposerSlashing struct {
+	Header1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	Header2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *ProposerSlashing) MarshalJSON() ([]byte, error) {
+	enc := &jsonProposerSlashing{}
+	enc.Header1 = jsonObj(p.Header1)
+	enc.Header2 = jsonObj(p.Header2)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *ProposerSlashing) UnmarshalJSON(data []byte) error {
+	var dec jsonProposerSlashing
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	p.Header1 = dec.Header1
+	p.Header2 = dec.Header2
+	return nil
+}
+
+type jsonAttesterSlashing struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (a *AttesterSlashing) MarshalJSON() ([]byte, error) {
+	enc := &jsonAttesterSlashing{}
+	enc.Attestation1 = jsonObj(a.Attestation1)
+	enc.Attestation2 = jsonObj(a.Attestation2)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (a *AttesterSlashing) UnmarshalJSON(data []byte) error {
+	var dec jsonAttesterSlashing
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	a.Attestation1 = dec.Attestation1
+	a.Attestation2 = dec.Attestation2
+	return nil
+}
+
+type jsonTransfer struct {
+	Sender    jsonUint64 `json:"sender"`
+	Recipient jsonUint64 `json:"recipient"`
+	Amount    jsonUint64 `json:"amount"`
+	Fee       jsonUint64 `json:"fee"`
+	Slot      jsonUint64 `json:"slot"`
+	Pubkey    jsonBytes  `json:"pubkey"`
+	Signature jsonBytes  `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (t *Transfer) MarshalJSON() ([]byte, error) {
+	enc := &jsonTransfer{}
+	enc.Sender = jsonUint64(t.Sender)
+	enc.Recipient = jsonUint64(t.Recipient)
+	enc.Amount = jsonUint64(t.Amount)
+	enc.Fee = jsonUint64(t.Fee)
+	enc.Slot = jsonUint64(t.Slot)
+	enc.Pubkey = t.Pubkey[:]
+	enc.Signature = t.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (t *Transfer) UnmarshalJSON(data []byte) error {
+	var dec jsonTransfer
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	t.Sender = uint64(dec.Sender)
+	t.Recipient = uint64(dec.Recipient)
+	t.Amount = uint64(dec.Amount)
+	t.Fee = uint64(dec.Fee)
+	t.Slot = uint64(dec.Slot)
+	if err := copyFixedBytes(t.Pubkey[:], dec.Pubkey, "pubkey"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(t.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconStatePhase0 struct {
+	GenesisTime                 jsonUint64            `json:"genesis_time"`
+	GenesisValidatorsRoot       jsonBytes             `json:"genesis_validators_root"`
+	Slot                        jsonUint64            `json:"slot"`
+	Fork                        *Fork                 `json:"fork"`
+	LatestBlockHeader           *BeaconBlockHeader    `json:"latest_block_header"`
+	BlockRoots                  jsonBytesList         `json:"block_roots"`
+	StateRoots                  jsonBytesList         `json:"state_roots"`
+	HistoricalRoots             jsonBytesList         `json:"historical_roots"`
+	Eth1Data                    *Eth1Data             `json:"eth1_data"`
+	Eth1DataVotes               []*Eth1Data           `json:"eth1_data_votes"`
+	Eth1DepositIndex            jsonUint64            `json:"eth1_deposit_index"`
+	Validators                  []*Validator          `json:"validators"`
+	Balances                    jsonUint64List        `json:"balances"`
+	RandaoMixes                 jsonBytesList         `json:"randao_mixes"`
+	Slashings                   jsonUint64List        `json:"slashings"`
+	PreviousEpochAttestations   []*PendingAttestation `json:"previous_epoch_attestations"`
+	CurrentEpochAttestations    []*PendingAttestation `json:"current_epoch_attestations"`
+	JustificationBits           jsonBytes             `json:"justification_bits"`
+	PreviousJustifiedCheckpoint *Checkpoint           `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint  *Checkpoint           `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint         *Checkpoint           `json:"finalized_checkpoint"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconStatePhase0) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconStatePhase0{}
+	enc.GenesisTime = jsonUint64(b.GenesisTime)
+	enc.GenesisValidatorsRoot = b.GenesisValidatorsRoot[:]
+	enc.Slot = jsonUint64(b.Slot)
+	enc.Fork = jsonObj(b.Fork)
+	enc.LatestBlockHeader = jsonObj(b.LatestBlockHeader)
+	enc.BlockRoots = make(jsonBytesList, len(b.BlockRoots))
+	for indx := range b.BlockRoots {
+		enc.BlockRoots[indx] = b.BlockRoots[indx][:]
+	}
+	enc.StateRoots = make(jsonBytesList, len(b.StateRoots))
+	for indx := range b.StateRoots {
+		enc.StateRoots[indx] = b.StateRoots[indx][:]
+	}
+	enc.HistoricalRoots = make(jsonBytesList, len(b.HistoricalRoots))
+	for indx := range b.HistoricalRoots {
+		enc.HistoricalRoots[indx] = b.HistoricalRoots[indx][:]
+	}
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Eth1DataVotes = jsonList(b.Eth1DataVotes)
+	enc.Eth1DepositIndex = jsonUint64(b.Eth1DepositIndex)
+	enc.Validators = jsonList(b.Validators)
+	enc.Balances = b.Balances
+	enc.RandaoMixes = make(jsonBytesList, len(b.RandaoMixes))
+	for indx := range b.RandaoMixes {
+		enc.RandaoMixes[indx] = b.RandaoMixes[indx][:]
+	}
+	enc.Slashings = b.Slashings
+	enc.PreviousEpochAttestations = jsonList(b.PreviousEpochAttestations)
+	enc.CurrentEpochAttestations = jsonList(b.CurrentEpochAttestations)
+	enc.JustificationBits = b.JustificationBits[:]
+	enc.PreviousJustifiedCheckpoint = jsonObj(b.PreviousJustifiedCheckpoint)
+	enc.CurrentJustifiedCheckpoint = jsonObj(b.CurrentJustifiedCheckpoint)
+	enc.FinalizedCheckpoint = jsonObj(b.FinalizedCheckpoint)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconStatePhase0) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconStatePhase0
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.GenesisTime = uint64(dec.GenesisTime)
+	if err := copyFixedBytes(b.GenesisValidatorsRoot[:], dec.GenesisValidatorsRoot, "genesis_validators_root"); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.Fork = dec.Fork
+	b.LatestBlockHeader = dec.LatestBlockHeader
+	if err := checkVectorLength(len(b.BlockRoots), len(dec.BlockRoots), "block_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.BlockRoots {
+		if err := copyFixedBytes(b.BlockRoots[indx][:], dec.BlockRoots[indx], "block_roots"); err != nil {
+			return err
+		}
+	}
+	if err := checkVectorLength(len(b.StateRoots), len(dec.StateRoots), "state_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.StateRoots {
+		if err := copyFixedBytes(b.StateRoots[indx][:], dec.StateRoots[indx], "state_roots"); err != nil {
+			return err
+		}
+	}
+	b.HistoricalRoots = make([][32]byte, len(dec.HistoricalRoots))
+	for indx := range dec.HistoricalRoots {
+		if err := copyFixedBytes(b.HistoricalRoots[indx][:], dec.HistoricalRoots[indx], "historical_roots"); err != nil {
+			return err
+		}
+	}
+	b.Eth1Data = dec.Eth1Data
+	b.Eth1DataVotes = dec.Eth1DataVotes
+	b.Eth1DepositIndex = uint64(dec.Eth1DepositIndex)
+	b.Validators = dec.Validators
+	b.Balances = dec.Balances
+	if err := checkVectorLength(len(b.RandaoMixes), len(dec.RandaoMixes), "randao_mixes"); err != nil {
+		return err
+	}
+	for indx := range dec.RandaoMixes {
+		if err := copyFixedBytes(b.RandaoMixes[indx][:], dec.RandaoMixes[indx], "randao_mixes"); err != nil {
+			return err
+		}
+	}
+	b.Slashings = dec.Slashings
+	b.PreviousEpochAttestations = dec.PreviousEpochAttestations
+	b.CurrentEpochAttestations = dec.CurrentEpochAttestations
+	if err := copyFixedBytes(b.JustificationBits[:], dec.JustificationBits, "justification_bits"); err != nil {
+		return err
+	}
+	b.PreviousJustifiedCheckpoint = dec.PreviousJustifiedCheckpoint
+	b.CurrentJustifiedCheckpoint = dec.CurrentJustifiedCheckpoint
+	b.FinalizedCheckpoint = dec.FinalizedCheckpoint
+	return nil
+}
+
+type jsonSignedBeaconBlockPhase0 struct {
+	Block     *BeaconBlockPhase0 `json:"message"`
+	Signature jsonBytes          `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBeaconBlockPhase0) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBeaconBlockPhase0{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBeaconBlockPhase0) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBeaconBlockPhase0
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconBlockPhase0 struct {
+	Slot          jsonUint64             `json:"slot"`
+	ProposerIndex jsonUint64             `json:"proposer_index"`
+	ParentRoot    jsonBytes              `json:"parent_root"`
+	StateRoot     jsonBytes              `json:"state_root"`
+	Body          *BeaconBlockBodyPhase0 `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockPhase0) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockPhase0{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockPhase0) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockPhase0
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBeaconBlockBodyPhase0 struct {
+	RandaoReveal      jsonBytes              `json:"randao_reveal"`
+	Eth1Data          *Eth1Data              `json:"eth1_data"`
+	Graffiti          jsonBytes              `json:"graffiti"`
+	ProposerSlashings []*ProposerSlashing    `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashing    `json:"attester_slashings"`
+	Attestations      []*Attestation         `json:"attestations"`
+	Deposits          []*Deposit             `json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExit `json:"voluntary_exits"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockBodyPhase0) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockBodyPhase0{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockBodyPhase0) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockBodyPhase0
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	return nil
+}
+
+type jsonSignedBeaconBlockHeader struct {
+	Header    *BeaconBlockHeader `json:"message"`
+	Signature jsonBytes          `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBeaconBlockHeader) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBeaconBlockHeader{}
+	enc.Header = jsonObj(s.Header)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBeaconBlockHeader) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBeaconBlockHeader
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Header = dec.Header
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconBlockHeader struct {
+	Slot          jsonUint64 `json:"slot"`
+	ProposerIndex jsonUint64 `json:"proposer_index"`
+	ParentRoot    jsonBytes  `json:"parent_root"`
+	StateRoot     jsonBytes  `json:"state_root"`
+	BodyRoot      jsonBytes  `json:"body_root"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockHeader) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockHeader{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.BodyRoot = b.BodyRoot[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockHeader) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockHeader
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.BodyRoot[:], dec.BodyRoot, "body_root"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonForkData struct {
+	CurrentVersion        jsonBytes `json:"current_version"`
+	GenesisValidatorsRoot jsonBytes `json:"genesis_validators_root"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (f *ForkData) MarshalJSON() ([]byte, error) {
+	enc := &jsonForkData{}
+	enc.CurrentVersion = f.CurrentVersion[:]
+	enc.GenesisValidatorsRoot = f.GenesisValidatorsRoot[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (f *ForkData) UnmarshalJSON(data []byte) error {
+	var dec jsonForkData
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(f.CurrentVersion[:], dec.CurrentVersion, "current_version"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(f.GenesisValidatorsRoot[:], dec.GenesisValidatorsRoot, "genesis_validators_root"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonENRForkID struct {
+	ForkDigest      jsonBytes  `json:"fork_digest"`
+	NextForkVersion jsonBytes  `json:"next_fork_version"`
+	NextForkEpoch   jsonUint64 `json:"next_fork_epoch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ENRForkID) MarshalJSON() ([]byte, error) {
+	enc := &jsonENRForkID{}
+	enc.ForkDigest = e.ForkDigest[:]
+	enc.NextForkVersion = e.NextForkVersion[:]
+	enc.NextForkEpoch = jsonUint64(e.NextForkEpoch)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ENRForkID) UnmarshalJSON(data []byte) error {
+	var dec jsonENRForkID
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ForkDigest[:], dec.ForkDigest, "fork_digest"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.NextForkVersion[:], dec.NextForkVersion, "next_fork_version"); err != nil {
+		return err
+	}
+	e.NextForkEpoch = uint64(dec.NextForkEpoch)
+	return nil
+}
+
+type jsonSigningData struct {
+	ObjectRoot jsonBytes `json:"object_root"`
+	Domain     jsonBytes `json:"domain"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SigningData) MarshalJSON() ([]byte, error) {
+	enc := &jsonSigningData{}
+	enc.ObjectRoot = s.ObjectRoot[:]
+	enc.Domain = s.Domain[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SigningData) UnmarshalJSON(data []byte) error {
+	var dec jsonSigningData
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(s.ObjectRoot[:], dec.ObjectRoot, "object_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(s.Domain[:], dec.Domain, "domain"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonLightClientHeader struct {
+	Header *BeaconBlockHeader `json:"beacon"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientHeader) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientHeader{}
+	enc.Header = jsonObj(l.Header)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientHeader) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientHeader
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.Header = dec.Header
+	return nil
+}
+
+type jsonLightClientBootstrap struct {
+	Header                     *LightClientHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch jsonBytesList      `json:"current_sync_committee_branch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientBootstrap) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientBootstrap{}
+	enc.Header = jsonObj(l.Header)
+	enc.CurrentSyncCommittee = jsonObj(l.CurrentSyncCommittee)
+	enc.CurrentSyncCommitteeBranch = make(jsonBytesList, len(l.CurrentSyncCommitteeBranch))
+	for indx := range l.CurrentSyncCommitteeBranch {
+		enc.CurrentSyncCommitteeBranch[indx] = l.CurrentSyncCommitteeBranch[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientBootstrap) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientBootstrap
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.Header = dec.Header
+	l.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	l.CurrentSyncCommitteeBranch = make([][32]byte, len(dec.CurrentSyncCommitteeBranch))
+	for indx := range dec.CurrentSyncCommitteeBranch {
+		if err := copyFixedBytes(l.CurrentSyncCommitteeBranch[indx][:], dec.CurrentSyncCommitteeBranch[indx], "current_sync_committee_branch"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonLightClientFinalityUpdate struct {
+	AttestedHeader  *LightClientHeader `json:"attested_header"`
+	FinalizedHeader *LightClientHeader `json:"finalized_header"`
+	FinalityBranch  jsonBytesList      `json:"finality_branch"`
+	SyncAggregate   *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot   jsonUint64         `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientFinalityUpdate) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientFinalityUpdate{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.FinalizedHeader = jsonObj(l.FinalizedHeader)
+	enc.FinalityBranch = make(jsonBytesList, len(l.FinalityBranch))
+	for indx := range l.FinalityBranch {
+		enc.FinalityBranch[indx] = l.FinalityBranch[indx][:]
+	}
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientFinalityUpdate) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientFinalityUpdate
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.FinalizedHeader = dec.FinalizedHeader
+	l.FinalityBranch = make([][32]byte, len(dec.FinalityBranch))
+	for indx := range dec.FinalityBranch {
+		if err := copyFixedBytes(l.FinalityBranch[indx][:], dec.FinalityBranch[indx], "finality_branch"); err != nil {
+			return err
+		}
+	}
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonLightClientOptimisticUpdate struct {
+	AttestedHeader *LightClientHeader `json:"attested_header"`
+	SyncAggregate  *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot  jsonUint64         `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientOptimisticUpdate) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientOptimisticUpdate{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientOptimisticUpdate) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientOptimisticUpdate
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonLightClientUpdate struct {
+	AttestedHeader          *LightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch jsonBytesList      `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader `json:"finalized_header"`
+	FinalityBranch          jsonBytesList      `json:"finality_branch"`
+	SyncAggregate           *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           jsonUint64         `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientUpdate) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientUpdate{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.NextSyncCommittee = jsonObj(l.NextSyncCommittee)
+	enc.NextSyncCommitteeBranch = make(jsonBytesList, len(l.NextSyncCommitteeBranch))
+	for indx := range l.NextSyncCommitteeBranch {
+		enc.NextSyncCommitteeBranch[indx] = l.NextSyncCommitteeBranch[indx][:]
+	}
+	enc.FinalizedHeader = jsonObj(l.FinalizedHeader)
+	enc.FinalityBranch = make(jsonBytesList, len(l.FinalityBranch))
+	for indx := range l.FinalityBranch {
+		enc.FinalityBranch[indx] = l.FinalityBranch[indx][:]
+	}
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientUpdate) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientUpdate
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.NextSyncCommittee = dec.NextSyncCommittee
+	l.NextSyncCommitteeBranch = make([][32]byte, len(dec.NextSyncCommitteeBranch))
+	for indx := range dec.NextSyncCommitteeBranch {
+		if err := copyFixedBytes(l.NextSyncCommitteeBranch[indx][:], dec.NextSyncCommitteeBranch[indx], "next_sync_committee_branch"); err != nil {
+			return err
+		}
+	}
+	l.FinalizedHeader = dec.FinalizedHeader
+	l.FinalityBranch = make([][32]byte, len(dec.FinalityBranch))
+	for indx := range dec.FinalityBranch {
+		if err := copyFixedBytes(l.FinalityBranch[indx][:], dec.FinalityBranch[indx], "finality_branch"); err != nil {
+			return err
+		}
+	}
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonBeaconStateAltair struct {
+	GenesisTime                 jsonUint64         `json:"genesis_time"`
+	GenesisValidatorsRoot       jsonBytes          `json:"genesis_validators_root"`
+	Slot                        jsonUint64         `json:"slot"`
+	Fork                        *Fork              `json:"fork"`
+	LatestBlockHeader           *BeaconBlockHeader `json:"latest_block_header"`
+	BlockRoots                  jsonBytesList      `json:"block_roots"`
+	StateRoots                  jsonBytesList      `json:"state_roots"`
+	HistoricalRoots             jsonBytesList      `json:"historical_roots"`
+	Eth1Data                    *Eth1Data          `json:"eth1_data"`
+	Eth1DataVotes               []*Eth1Data        `json:"eth1_data_votes"`
+	Eth1DepositIndex            jsonUint64         `json:"eth1_deposit_index"`
+	Validators                  []*Validator       `json:"validators"`
+	Balances                    jsonUint64List     `json:"balances"`
+	RandaoMixes                 jsonBytesList      `json:"randao_mixes"`
+	Slashings                   jsonUint64List     `json:"slashings"`
+	PreviousEpochParticipation  jsonUint8List      `json:"previous_epoch_participation"`
+	CurrentEpochParticipation   jsonUint8List      `json:"current_epoch_participation"`
+	JustificationBits           jsonBytes          `json:"justification_bits"`
+	PreviousJustifiedCheckpoint *Checkpoint        `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint  *Checkpoint        `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint         *Checkpoint        `json:"finalized_checkpoint"`
+	InactivityScores            jsonUint64List     `json:"inactivity_scores"`
+	CurrentSyncCommittee        *SyncCommittee     `json:"current_sync_committee"`
+	NextSyncCommittee           *SyncCommittee     `json:"next_sync_committee"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconStateAltair) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconStateAltair{}
+	enc.GenesisTime = jsonUint64(b.GenesisTime)
+	enc.GenesisValidatorsRoot = b.GenesisValidatorsRoot[:]
+	enc.Slot = jsonUint64(b.Slot)
+	enc.Fork = jsonObj(b.Fork)
+	enc.LatestBlockHeader = jsonObj(b.LatestBlockHeader)
+	enc.BlockRoots = make(jsonBytesList, len(b.BlockRoots))
+	for indx := range b.BlockRoots {
+		enc.BlockRoots[indx] = b.BlockRoots[indx][:]
+	}
+	enc.StateRoots = make(jsonBytesList, len(b.StateRoots))
+	for indx := range b.StateRoots {
+		enc.StateRoots[indx] = b.StateRoots[indx][:]
+	}
+	enc.HistoricalRoots = make(jsonBytesList, len(b.HistoricalRoots))
+	for indx := range b.HistoricalRoots {
+		enc.HistoricalRoots[indx] = b.HistoricalRoots[indx][:]
+	}
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Eth1DataVotes = jsonList(b.Eth1DataVotes)
+	enc.Eth1DepositIndex = jsonUint64(b.Eth1DepositIndex)
+	enc.Validators = jsonList(b.Validators)
+	enc.Balances = b.Balances
+	enc.RandaoMixes = make(jsonBytesList, len(b.RandaoMixes))
+	for indx := range b.RandaoMixes {
+		enc.RandaoMixes[indx] = b.RandaoMixes[indx][:]
+	}
+	enc.Slashings = b.Slashings
+	enc.PreviousEpochParticipation = b.PreviousEpochParticipation
+	enc.CurrentEpochParticipation = b.CurrentEpochParticipation
+	enc.JustificationBits = b.JustificationBits[:]
+	enc.PreviousJustifiedCheckpoint = jsonObj(b.PreviousJustifiedCheckpoint)
+	enc.CurrentJustifiedCheckpoint = jsonObj(b.CurrentJustifiedCheckpoint)
+	enc.FinalizedCheckpoint = jsonObj(b.FinalizedCheckpoint)
+	enc.InactivityScores = b.InactivityScores
+	enc.CurrentSyncCommittee = jsonObj(b.CurrentSyncCommittee)
+	enc.NextSyncCommittee = jsonObj(b.NextSyncCommittee)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconStateAltair) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconStateAltair
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.GenesisTime = uint64(dec.GenesisTime)
+	if err := copyFixedBytes(b.GenesisValidatorsRoot[:], dec.GenesisValidatorsRoot, "genesis_validators_root"); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.Fork = dec.Fork
+	b.LatestBlockHeader = dec.LatestBlockHeader
+	if err := checkVectorLength(len(b.BlockRoots), len(dec.BlockRoots), "block_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.BlockRoots {
+		if err := copyFixedBytes(b.BlockRoots[indx][:], dec.BlockRoots[indx], "block_roots"); err != nil {
+			return err
+		}
+	}
+	if err := checkVectorLength(len(b.StateRoots), len(dec.StateRoots), "state_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.StateRoots {
+		if err := copyFixedBytes(b.StateRoots[indx][:], dec.StateRoots[indx], "state_roots"); err != nil {
+			return err
+		}
+	}
+	b.HistoricalRoots = make([][32]byte, len(dec.HistoricalRoots))
+	for indx := range dec.HistoricalRoots {
+		if err := copyFixedBytes(b.HistoricalRoots[indx][:], dec.HistoricalRoots[indx], "historical_roots"); err != nil {
+			return err
+		}
+	}
+	b.Eth1Data = dec.Eth1Data
+	b.Eth1DataVotes = dec.Eth1DataVotes
+	b.Eth1DepositIndex = uint64(dec.Eth1DepositIndex)
+	b.Validators = dec.Validators
+	b.Balances = dec.Balances
+	if err := checkVectorLength(len(b.RandaoMixes), len(dec.RandaoMixes), "randao_mixes"); err != nil {
+		return err
+	}
+	for indx := range dec.RandaoMixes {
+		if err := copyFixedBytes(b.RandaoMixes[indx][:], dec.RandaoMixes[indx], "randao_mixes"); err != nil {
+			return err
+		}
+	}
+	b.Slashings = dec.Slashings
+	b.PreviousEpochParticipation = dec.PreviousEpochParticipation
+	b.CurrentEpochParticipation = dec.CurrentEpochParticipation
+	if err := copyFixedBytes(b.JustificationBits[:], dec.JustificationBits, "justification_bits"); err != nil {
+		return err
+	}
+	b.PreviousJustifiedCheckpoint = dec.PreviousJustifiedCheckpoint
+	b.CurrentJustifiedCheckpoint = dec.CurrentJustifiedCheckpoint
+	b.FinalizedCheckpoint = dec.FinalizedCheckpoint
+	b.InactivityScores = dec.InactivityScores
+	b.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	b.NextSyncCommittee = dec.NextSyncCommittee
+	return nil
+}
+
+type jsonSignedBeaconBlockAltair struct {
+	Block     *BeaconBlockAltair `json:"message"`
+	Signature jsonBytes          `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBeaconBlockAltair) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBeaconBlockAltair{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBeaconBlockAltair) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBeaconBlockAltair
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconBlockAltair struct {
+	Slot          jsonUint64             `json:"slot"`
+	ProposerIndex jsonUint64             `json:"proposer_index"`
+	ParentRoot    jsonBytes              `json:"parent_root"`
+	StateRoot     jsonBytes              `json:"state_root"`
+	Body          *BeaconBlockBodyAltair `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockAltair) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockAltair{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockAltair) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockAltair
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBeaconBlockBodyAltair struct {
+	RandaoReveal      jsonBytes              `json:"randao_reveal"`
+	Eth1Data          *Eth1Data              `json:"eth1_data"`
+	Graffiti          jsonBytes              `json:"graffiti"`
+	ProposerSlashings []*ProposerSlashing    `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashing    `json:"attester_slashings"`
+	Attestations      []*Attestation         `json:"attestations"`
+	Deposits          []*Deposit             `json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExit `json:"voluntary_exits"`
+	SyncAggregate     *SyncAggregate         `json:"sync_aggregate"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockBodyAltair) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockBodyAltair{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockBodyAltair) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockBodyAltair
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	return nil
+}
+
+type jsonSyncAggregate struct {
+	SyncCommiteeBits      jsonBytes `json:"sync_committee_bits"`
+	SyncCommiteeSignature jsonBytes `json:"sync_committee_signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SyncAggregate) MarshalJSON() ([]byte, error) {
+	enc := &jsonSyncAggregate{}
+	enc.SyncCommiteeBits = s.SyncCommiteeBits[:]
+	enc.SyncCommiteeSignature = s.SyncCommiteeSignature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SyncAggregate) UnmarshalJSON(data []byte) error {
+	var dec jsonSyncAggregate
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(s.SyncCommiteeBits[:], dec.SyncCommiteeBits, "sync_committee_bits"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(s.SyncCommiteeSignature[:], dec.SyncCommiteeSignature, "sync_committee_signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSyncCommittee struct {
+	PubKeys         jsonBytesList `json:"pubkeys"`
+	AggregatePubKey jsonBytes     `json:"aggregate_pubkey"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SyncCommittee) MarshalJSON() ([]byte, error) {
+	enc := &jsonSyncCommittee{}
+	enc.PubKeys = make(jsonBytesList, len(s.PubKeys))
+	for indx := range s.PubKeys {
+		enc.PubKeys[indx] = s.PubKeys[indx][:]
+	}
+	enc.AggregatePubKey = s.AggregatePubKey[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SyncCommittee) UnmarshalJSON(data []byte) error {
+	var dec jsonSyncCommittee
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := checkVectorLength(len(s.PubKeys), len(dec.PubKeys), "pubkeys"); err != nil {
+		return err
+	}
+	for indx := range dec.PubKeys {
+		if err := copyFixedBytes(s.PubKeys[indx][:], dec.PubKeys[indx], "pubkeys"); err != nil {
+			return err
+		}
+	}
+	if err := copyFixedBytes(s.AggregatePubKey[:], dec.AggregatePubKey, "aggregate_pubkey"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconStateBellatrix struct {
+	GenesisTime                  jsonUint64              `json:"genesis_time"`
+	GenesisValidatorsRoot        jsonBytes               `json:"genesis_validators_root"`
+	Slot                         jsonUint64              `json:"slot"`
+	Fork                         *Fork                   `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeader      `json:"latest_block_header"`
+	BlockRoots                   jsonBytesList           `json:"block_roots"`
+	StateRoots                   jsonBytesList           `json:"state_roots"`
+	HistoricalRoots              jsonBytesList           `json:"historical_roots"`
+	Eth1Data                     *Eth1Data               `json:"eth1_data"`
+	Eth1DataVotes                []*Eth1Data             `json:"eth1_data_votes"`
+	Eth1DepositIndex             jsonUint64              `json:"eth1_deposit_index"`
+	Validators                   []*Validator            `json:"validators"`
+	Balances                     jsonUint64List          `json:"balances"`
+	RandaoMixes                  jsonBytesList           `json:"randao_mixes"`
+	Slashings                    jsonUint64List          `json:"slashings"`
+	PreviousEpochParticipation   jsonUint8List           `json:"previous_epoch_participation"`
+	CurrentEpochParticipation    jsonUint8List           `json:"current_epoch_participation"`
+	JustificationBits            jsonBytes               `json:"justification_bits"`
+	PreviousJustifiedCheckpoint  *Checkpoint             `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *Checkpoint             `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *Checkpoint             `json:"finalized_checkpoint"`
+	InactivityScores             jsonUint64List          `json:"inactivity_scores"`
+	CurrentSyncCommittee         *SyncCommittee          `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommittee          `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeader `json:"latest_execution_payload_header"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconStateBellatrix) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconStateBellatrix{}
+	enc.GenesisTime = jsonUint64(b.GenesisTime)
+	enc.GenesisValidatorsRoot = b.GenesisValidatorsRoot[:]
+	enc.Slot = jsonUint64(b.Slot)
+	enc.Fork = jsonObj(b.Fork)
+	enc.LatestBlockHeader = jsonObj(b.LatestBlockHeader)
+	enc.BlockRoots = make(jsonBytesList, len(b.BlockRoots))
+	for indx := range b.BlockRoots {
+		enc.BlockRoots[indx] = b.BlockRoots[indx][:]
+	}
+	enc.StateRoots = make(jsonBytesList, len(b.StateRoots))
+	for indx := range b.StateRoots {
+		enc.StateRoots[indx] = b.StateRoots[indx][:]
+	}
+	enc.HistoricalRoots = b.HistoricalRoots
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Eth1DataVotes = jsonList(b.Eth1DataVotes)
+	enc.Eth1DepositIndex = jsonUint64(b.Eth1DepositIndex)
+	enc.Validators = jsonList(b.Validators)
+	enc.Balances = b.Balances
+	enc.RandaoMixes = make(jsonBytesList, len(b.RandaoMixes))
+	for indx := range b.RandaoMixes {
+		enc.RandaoMixes[indx] = b.RandaoMixes[indx][:]
+	}
+	enc.Slashings = b.Slashings
+	enc.PreviousEpochParticipation = b.PreviousEpochParticipation
+	enc.CurrentEpochParticipation = b.CurrentEpochParticipation
+	enc.JustificationBits = b.JustificationBits[:]
+	enc.PreviousJustifiedCheckpoint = jsonObj(b.PreviousJustifiedCheckpoint)
+	enc.CurrentJustifiedCheckpoint = jsonObj(b.CurrentJustifiedCheckpoint)
+	enc.FinalizedCheckpoint = jsonObj(b.FinalizedCheckpoint)
+	enc.InactivityScores = b.InactivityScores
+	enc.CurrentSyncCommittee = jsonObj(b.CurrentSyncCommittee)
+	enc.NextSyncCommittee = jsonObj(b.NextSyncCommittee)
+	enc.LatestExecutionPayloadHeader = jsonObj(b.LatestExecutionPayloadHeader)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconStateBellatrix) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconStateBellatrix
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.GenesisTime = uint64(dec.GenesisTime)
+	if err := copyFixedBytes(b.GenesisValidatorsRoot[:], dec.GenesisValidatorsRoot, "genesis_validators_root"); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.Fork = dec.Fork
+	b.LatestBlockHeader = dec.LatestBlockHeader
+	if err := checkVectorLength(len(b.BlockRoots), len(dec.BlockRoots), "block_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.BlockRoots {
+		if err := copyFixedBytes(b.BlockRoots[indx][:], dec.BlockRoots[indx], "block_roots"); err != nil {
+			return err
+		}
+	}
+	if err := checkVectorLength(len(b.StateRoots), len(dec.StateRoots), "state_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.StateRoots {
+		if err := copyFixedBytes(b.StateRoots[indx][:], dec.StateRoots[indx], "state_roots"); err != nil {
+			return err
+		}
+	}
+	b.HistoricalRoots = dec.HistoricalRoots
+	b.Eth1Data = dec.Eth1Data
+	b.Eth1DataVotes = dec.Eth1DataVotes
+	b.Eth1DepositIndex = uint64(dec.Eth1DepositIndex)
+	b.Validators = dec.Validators
+	b.Balances = dec.Balances
+	if err := checkVectorLength(len(b.RandaoMixes), len(dec.RandaoMixes), "randao_mixes"); err != nil {
+		return err
+	}
+	for indx := range dec.RandaoMixes {
+		if err := copyFixedBytes(b.RandaoMixes[indx][:], dec.RandaoMixes[indx], "randao_mixes"); err != nil {
+			return err
+		}
+	}
+	b.Slashings = dec.Slashings
+	b.PreviousEpochParticipation = dec.PreviousEpochParticipation
+	b.CurrentEpochParticipation = dec.CurrentEpochParticipation
+	if err := copyFixedBytes(b.JustificationBits[:], dec.JustificationBits, "justification_bits"); err != nil {
+		return err
+	}
+	b.PreviousJustifiedCheckpoint = dec.PreviousJustifiedCheckpoint
+	b.CurrentJustifiedCheckpoint = dec.CurrentJustifiedCheckpoint
+	b.FinalizedCheckpoint = dec.FinalizedCheckpoint
+	b.InactivityScores = dec.InactivityScores
+	b.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	b.NextSyncCommittee = dec.NextSyncCommittee
+	b.LatestExecutionPayloadHeader = dec.LatestExecutionPayloadHeader
+	return nil
+}
+
+type jsonSignedBeaconBlockBellatrix struct {
+	Block     *BeaconBlockBellatrix `json:"message"`
+	Signature jsonBytes             `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBeaconBlockBellatrix) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBeaconBlockBellatrix{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBeaconBlockBellatrix) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBeaconBlockBellatrix
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconBlockBellatrix struct {
+	Slot          jsonUint64                `json:"slot"`
+	ProposerIndex jsonUint64                `json:"proposer_index"`
+	ParentRoot    jsonBytes                 `json:"parent_root"`
+	StateRoot     jsonBytes                 `json:"state_root"`
+	Body          *BeaconBlockBodyBellatrix `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockBellatrix) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockBellatrix{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockBellatrix) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockBellatrix
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBeaconBlockBodyBellatrix struct {
+	RandaoReveal      jsonBytes              `json:"randao_reveal"`
+	Eth1Data          *Eth1Data              `json:"eth1_data"`
+	Graffiti          jsonBytes              `json:"graffiti"`
+	ProposerSlashings []*ProposerSlashing    `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashing    `json:"attester_slashings"`
+	Attestations      []*Attestation         `json:"attestations"`
+	Deposits          []*Deposit             `json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExit `json:"voluntary_exits"`
+	SyncAggregate     *SyncAggregate         `json:"sync_aggregate"`
+	ExecutionPayload  *ExecutionPayload      `json:"execution_payload"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockBodyBellatrix) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockBodyBellatrix{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	enc.ExecutionPayload = jsonObj(b.ExecutionPayload)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockBodyBellatrix) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockBodyBellatrix
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	b.ExecutionPayload = dec.ExecutionPayload
+	return nil
+}
+
+type jsonSignedBlindedBeaconBlock struct {
+	Block     *BlindedBeaconBlock `json:"message"`
+	Signature jsonBytes           `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBlindedBeaconBlock) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBlindedBeaconBlock{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBlindedBeaconBlock) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBlindedBeaconBlock
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBlindedBeaconBlock struct {
+	Slot          jsonUint64              `json:"slot"`
+	ProposerIndex jsonUint64              `json:"proposer_index"`
+	ParentRoot    jsonBytes               `json:"parent_root"`
+	StateRoot     jsonBytes               `json:"state_root"`
+	Body          *BlindedBeaconBlockBody `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlindedBeaconBlock) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlindedBeaconBlock{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlindedBeaconBlock) UnmarshalJSON(data []byte) error {
+	var dec jsonBlindedBeaconBlock
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBlindedBeaconBlockBody struct {
+	RandaoReveal           jsonBytes               `json:"randao_reveal"`
+	Eth1Data               *Eth1Data               `json:"eth1_data"`
+	Graffiti               jsonBytes               `json:"graffiti"`
+	ProposerSlashings      []*ProposerSlashing     `json:"proposer_slashings"`
+	AttesterSlashings      []*AttesterSlashing     `json:"attester_slashings"`
+	Attestations           []*Attestation          `json:"attestations"`
+	Deposits               []*Deposit              `json:"deposits"`
+	VoluntaryExits         []*SignedVoluntaryExit  `json:"voluntary_exits"`
+	SyncAggregate          *SyncAggregate          `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlindedBeaconBlockBody) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlindedBeaconBlockBody{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	enc.ExecutionPayloadHeader = jsonObj(b.ExecutionPayloadHeader)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlindedBeaconBlockBody) UnmarshalJSON(data []byte) error {
+	var dec jsonBlindedBeaconBlockBody
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	b.ExecutionPayloadHeader = dec.ExecutionPayloadHeader
+	return nil
+}
+
+type jsonExecutionPayload struct {
+	ParentHash    jsonBytes     `json:"parent_hash"`
+	FeeRecipient  jsonBytes     `json:"fee_recipient"`
+	StateRoot     jsonBytes     `json:"state_root"`
+	ReceiptsRoot  jsonBytes     `json:"receipts_root"`
+	LogsBloom     jsonBytes     `json:"logs_bloom"`
+	PrevRandao    jsonBytes     `json:"prev_randao"`
+	BlockNumber   jsonUint64    `json:"block_number"`
+	GasLimit      jsonUint64    `json:"gas_limit"`
+	GasUsed       jsonUint64    `json:"gas_used"`
+	Timestamp     jsonUint64    `json:"timestamp"`
+	ExtraData     jsonBytes     `json:"extra_data"`
+	BaseFeePerGas Uint256       `json:"base_fee_per_gas"`
+	BlockHash     jsonBytes     `json:"block_hash"`
+	Transactions  jsonBytesList `json:"transactions"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ExecutionPayload) MarshalJSON() ([]byte, error) {
+	enc := &jsonExecutionPayload{}
+	enc.ParentHash = e.ParentHash[:]
+	enc.FeeRecipient = e.FeeRecipient[:]
+	enc.StateRoot = e.StateRoot[:]
+	enc.ReceiptsRoot = e.ReceiptsRoot[:]
+	enc.LogsBloom = e.LogsBloom[:]
+	enc.PrevRandao = e.PrevRandao[:]
+	enc.BlockNumber = jsonUint64(e.BlockNumber)
+	enc.GasLimit = jsonUint64(e.GasLimit)
+	enc.GasUsed = jsonUint64(e.GasUsed)
+	enc.Timestamp = jsonUint64(e.Timestamp)
+	enc.ExtraData = e.ExtraData
+	enc.BaseFeePerGas = e.BaseFeePerGas
+	enc.BlockHash = e.BlockHash[:]
+	enc.Transactions = e.Transactions
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ExecutionPayload) UnmarshalJSON(data []byte) error {
+	var dec jsonExecutionPayload
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ParentHash[:], dec.ParentHash, "parent_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.FeeRecipient[:], dec.FeeRecipient, "fee_recipient"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ReceiptsRoot[:], dec.ReceiptsRoot, "receipts_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.LogsBloom[:], dec.LogsBloom, "logs_bloom"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.PrevRandao[:], dec.PrevRandao, "prev_randao"); err != nil {
+		return err
+	}
+	e.BlockNumber = uint64(dec.BlockNumber)
+	e.GasLimit = uint64(dec.GasLimit)
+	e.GasUsed = uint64(dec.GasUsed)
+	e.Timestamp = uint64(dec.Timestamp)
+	e.ExtraData = dec.ExtraData
+	e.BaseFeePerGas = dec.BaseFeePerGas
+	if err := copyFixedBytes(e.BlockHash[:], dec.BlockHash, "block_hash"); err != nil {
+		return err
+	}
+	e.Transactions = dec.Transactions
+	return nil
+}
+
+type jsonExecutionPayloadHeader struct {
+	ParentHash       jsonBytes  `json:"parent_hash"`
+	FeeRecipient     jsonBytes  `json:"fee_recipient"`
+	StateRoot        jsonBytes  `json:"state_root"`
+	ReceiptsRoot     jsonBytes  `json:"receipts_root"`
+	LogsBloom        jsonBytes  `json:"logs_bloom"`
+	PrevRandao       jsonBytes  `json:"prev_randao"`
+	BlockNumber      jsonUint64 `json:"block_number"`
+	GasLimit         jsonUint64 `json:"gas_limit"`
+	GasUsed          jsonUint64 `json:"gas_used"`
+	Timestamp        jsonUint64 `json:"timestamp"`
+	ExtraData        jsonBytes  `json:"extra_data"`
+	BaseFeePerGas    Uint256    `json:"base_fee_per_gas"`
+	BlockHash        jsonBytes  `json:"block_hash"`
+	TransactionsRoot jsonBytes  `json:"transactions_root"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ExecutionPayloadHeader) MarshalJSON() ([]byte, error) {
+	enc := &jsonExecutionPayloadHeader{}
+	enc.ParentHash = e.ParentHash[:]
+	enc.FeeRecipient = e.FeeRecipient[:]
+	enc.StateRoot = e.StateRoot[:]
+	enc.ReceiptsRoot = e.ReceiptsRoot[:]
+	enc.LogsBloom = e.LogsBloom[:]
+	enc.PrevRandao = e.PrevRandao[:]
+	enc.BlockNumber = jsonUint64(e.BlockNumber)
+	enc.GasLimit = jsonUint64(e.GasLimit)
+	enc.GasUsed = jsonUint64(e.GasUsed)
+	enc.Timestamp = jsonUint64(e.Timestamp)
+	enc.ExtraData = e.ExtraData
+	enc.BaseFeePerGas = e.BaseFeePerGas
+	enc.BlockHash = e.BlockHash[:]
+	enc.TransactionsRoot = e.TransactionsRoot[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ExecutionPayloadHeader) UnmarshalJSON(data []byte) error {
+	var dec jsonExecutionPayloadHeader
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ParentHash[:], dec.ParentHash, "parent_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.FeeRecipient[:], dec.FeeRecipient, "fee_recipient"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ReceiptsRoot[:], dec.ReceiptsRoot, "receipts_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.LogsBloom[:], dec.LogsBloom, "logs_bloom"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.PrevRandao[:], dec.PrevRandao, "prev_randao"); err != nil {
+		return err
+	}
+	e.BlockNumber = uint64(dec.BlockNumber)
+	e.GasLimit = uint64(dec.GasLimit)
+	e.GasUsed = uint64(dec.GasUsed)
+	e.Timestamp = uint64(dec.Timestamp)
+	e.ExtraData = dec.ExtraData
+	e.BaseFeePerGas = dec.BaseFeePerGas
+	if err := copyFixedBytes(e.BlockHash[:], dec.BlockHash, "block_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.TransactionsRoot[:], dec.TransactionsRoot, "transactions_root"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSyncAggregatorSelectionData struct {
+	Slot              jsonUint64 `json:"slot"`
+	SubCommitteeIndex jsonUint64 `json:"subcommittee_index"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SyncAggregatorSelectionData) MarshalJSON() ([]byte, error) {
+	enc := &jsonSyncAggregatorSelectionData{}
+	enc.Slot = jsonUint64(s.Slot)
+	enc.SubCommitteeIndex = jsonUint64(s.SubCommitteeIndex)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SyncAggregatorSelectionData) UnmarshalJSON(data []byte) error {
+	var dec jsonSyncAggregatorSelectionData
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Slot = uint64(dec.Slot)
+	s.SubCommitteeIndex = uint64(dec.SubCommitteeIndex)
+	return nil
+}
+
+type jsonSyncCommitteeContribution struct {
+	Slot              jsonUint64 `json:"slot"`
+	BeaconBlockRoot   jsonBytes  `json:"beacon_block_root"`
+	SubcommitteeIndex jsonUint64 `json:"subcommittee_index"`
+	AggregationBits   jsonBytes  `json:"aggregation_bits"`
+	Signature         jsonBytes  `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SyncCommitteeContribution) MarshalJSON() ([]byte, error) {
+	enc := &jsonSyncCommitteeContribution{}
+	enc.Slot = jsonUint64(s.Slot)
+	enc.BeaconBlockRoot = s.BeaconBlockRoot[:]
+	enc.SubcommitteeIndex = jsonUint64(s.SubcommitteeIndex)
+	enc.AggregationBits = s.AggregationBits
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SyncCommitteeContribution) UnmarshalJSON(data []byte) error {
+	var dec jsonSyncCommitteeContribution
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Slot = uint64(dec.Slot)
+	if err := copyFixedBytes(s.BeaconBlockRoot[:], dec.BeaconBlockRoot, "beacon_block_root"); err != nil {
+		return err
+	}
+	s.SubcommitteeIndex = uint64(dec.SubcommitteeIndex)
+	s.AggregationBits = dec.AggregationBits
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonContributionAndProof struct {
+	AggregatorIndex jsonUint64                 `json:"aggregator_index"`
+	Contribution    *SyncCommitteeContribution `json:"contribution"`
+	SelectionProof  jsonBytes                  `json:"selection_proof"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (c *ContributionAndProof) MarshalJSON() ([]byte, error) {
+	enc := &jsonContributionAndProof{}
+	enc.AggregatorIndex = jsonUint64(c.AggregatorIndex)
+	enc.Contribution = jsonObj(c.Contribution)
+	enc.SelectionProof = c.SelectionProof[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (c *ContributionAndProof) UnmarshalJSON(data []byte) error {
+	var dec jsonContributionAndProof
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	c.AggregatorIndex = uint64(dec.AggregatorIndex)
+	c.Contribution = dec.Contribution
+	if err := copyFixedBytes(c.SelectionProof[:], dec.SelectionProof, "selection_proof"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSignedContributionAndProof struct {
+	Message   *ContributionAndProof `json:"message"`
+	Signature jsonBytes             `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedContributionAndProof) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedContributionAndProof{}
+	enc.Message = jsonObj(s.Message)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedContributionAndProof) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedContributionAndProof
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Message = dec.Message
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSyncCommitteeMessage struct {
+	Slot           jsonUint64 `json:"slot"`
+	BlockRoot      jsonBytes  `json:"beacon_block_root"`
+	ValidatorIndex jsonUint64 `json:"validator_index"`
+	Signature      jsonBytes  `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SyncCommitteeMessage) MarshalJSON() ([]byte, error) {
+	enc := &jsonSyncCommitteeMessage{}
+	enc.Slot = jsonUint64(s.Slot)
+	enc.BlockRoot = s.BlockRoot[:]
+	enc.ValidatorIndex = jsonUint64(s.ValidatorIndex)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SyncCommitteeMessage) UnmarshalJSON(data []byte) error {
+	var dec jsonSyncCommitteeMessage
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Slot = uint64(dec.Slot)
+	if err := copyFixedBytes(s.BlockRoot[:], dec.BlockRoot, "beacon_block_root"); err != nil {
+		return err
+	}
+	s.ValidatorIndex = uint64(dec.ValidatorIndex)
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSignedAggregateAndProof struct {
+	Message   *AggregateAndProof `json:"message"`
+	Signature jsonBytes          `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedAggregateAndProof) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedAggregateAndProof{}
+	enc.Message = jsonObj(s.Message)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedAggregateAndProof) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedAggregateAndProof
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Message = dec.Message
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonEth1Block struct {
+	Timestamp    jsonUint64 `json:"timestamp"`
+	DepositRoot  jsonBytes  `json:"deposit_root"`
+	DepositCount jsonUint64 `json:"deposit_count"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *Eth1Block) MarshalJSON() ([]byte, error) {
+	enc := &jsonEth1Block{}
+	enc.Timestamp = jsonUint64(e.Timestamp)
+	enc.DepositRoot = e.DepositRoot[:]
+	enc.DepositCount = jsonUint64(e.DepositCount)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *Eth1Block) UnmarshalJSON(data []byte) error {
+	var dec jsonEth1Block
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	e.Timestamp = uint64(dec.Timestamp)
+	if err := copyFixedBytes(e.DepositRoot[:], dec.DepositRoot, "deposit_root"); err != nil {
+		return err
+	}
+	e.DepositCount = uint64(dec.DepositCount)
+	return nil
+}
+
+type jsonPowBlock struct {
+	BlockHash       jsonBytes `json:"block_hash"`
+	ParentHash      jsonBytes `json:"parent_hash"`
+	TotalDifficulty jsonBytes `json:"total_difficulty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *PowBlock) MarshalJSON() ([]byte, error) {
+	enc := &jsonPowBlock{}
+	enc.BlockHash = p.BlockHash[:]
+	enc.ParentHash = p.ParentHash[:]
+	enc.TotalDifficulty = p.TotalDifficulty[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *PowBlock) UnmarshalJSON(data []byte) error {
+	var dec jsonPowBlock
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(p.BlockHash[:], dec.BlockHash, "block_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(p.ParentHash[:], dec.ParentHash, "parent_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(p.TotalDifficulty[:], dec.TotalDifficulty, "total_difficulty"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonExecutionPayloadCapella struct {
+	ParentHash    jsonBytes     `json:"parent_hash"`
+	FeeRecipient  jsonBytes     `json:"fee_recipient"`
+	StateRoot     jsonBytes     `json:"state_root"`
+	ReceiptsRoot  jsonBytes     `json:"receipts_root"`
+	LogsBloom     jsonBytes     `json:"logs_bloom"`
+	PrevRandao    jsonBytes     `json:"prev_randao"`
+	BlockNumber   jsonUint64    `json:"block_number"`
+	GasLimit      jsonUint64    `json:"gas_limit"`
+	GasUsed       jsonUint64    `json:"gas_used"`
+	Timestamp     jsonUint64    `json:"timestamp"`
+	ExtraData     jsonBytes     `json:"extra_data"`
+	BaseFeePerGas Uint256       `json:"base_fee_per_gas"`
+	BlockHash     jsonBytes     `json:"block_hash"`
+	Transactions  jsonBytesList `json:"transactions"`
+	Withdrawals   []*Withdrawal `json:"withdrawals"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ExecutionPayloadCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonExecutionPayloadCapella{}
+	enc.ParentHash = e.ParentHash[:]
+	enc.FeeRecipient = e.FeeRecipient[:]
+	enc.StateRoot = e.StateRoot[:]
+	enc.ReceiptsRoot = e.ReceiptsRoot[:]
+	enc.LogsBloom = e.LogsBloom[:]
+	enc.PrevRandao = e.PrevRandao[:]
+	enc.BlockNumber = jsonUint64(e.BlockNumber)
+	enc.GasLimit = jsonUint64(e.GasLimit)
+	enc.GasUsed = jsonUint64(e.GasUsed)
+	enc.Timestamp = jsonUint64(e.Timestamp)
+	enc.ExtraData = e.ExtraData
+	enc.BaseFeePerGas = e.BaseFeePerGas
+	enc.BlockHash = e.BlockHash[:]
+	enc.Transactions = e.Transactions
+	enc.Withdrawals = jsonList(e.Withdrawals)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ExecutionPayloadCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonExecutionPayloadCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ParentHash[:], dec.ParentHash, "parent_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.FeeRecipient[:], dec.FeeRecipient, "fee_recipient"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ReceiptsRoot[:], dec.ReceiptsRoot, "receipts_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.LogsBloom[:], dec.LogsBloom, "logs_bloom"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.PrevRandao[:], dec.PrevRandao, "prev_randao"); err != nil {
+		return err
+	}
+	e.BlockNumber = uint64(dec.BlockNumber)
+	e.GasLimit = uint64(dec.GasLimit)
+	e.GasUsed = uint64(dec.GasUsed)
+	e.Timestamp = uint64(dec.Timestamp)
+	e.ExtraData = dec.ExtraData
+	e.BaseFeePerGas = dec.BaseFeePerGas
+	if err := copyFixedBytes(e.BlockHash[:], dec.BlockHash, "block_hash"); err != nil {
+		return err
+	}
+	e.Transactions = dec.Transactions
+	e.Withdrawals = dec.Withdrawals
+	return nil
+}
+
+type jsonExecutionPayloadHeaderCapella struct {
+	ParentHash       jsonBytes  `json:"parent_hash"`
+	FeeRecipient     jsonBytes  `json:"fee_recipient"`
+	StateRoot        jsonBytes  `json:"state_root"`
+	ReceiptsRoot     jsonBytes  `json:"receipts_root"`
+	LogsBloom        jsonBytes  `json:"logs_bloom"`
+	PrevRandao       jsonBytes  `json:"prev_randao"`
+	BlockNumber      jsonUint64 `json:"block_number"`
+	GasLimit         jsonUint64 `json:"gas_limit"`
+	GasUsed          jsonUint64 `json:"gas_used"`
+	Timestamp        jsonUint64 `json:"timestamp"`
+	ExtraData        jsonBytes  `json:"extra_data"`
+	BaseFeePerGas    Uint256    `json:"base_fee_per_gas"`
+	BlockHash        jsonBytes  `json:"block_hash"`
+	TransactionsRoot jsonBytes  `json:"transactions_root"`
+	WithdrawalRoot   jsonBytes  `json:"withdrawals_root"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ExecutionPayloadHeaderCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonExecutionPayloadHeaderCapella{}
+	enc.ParentHash = e.ParentHash[:]
+	enc.FeeRecipient = e.FeeRecipient[:]
+	enc.StateRoot = e.StateRoot[:]
+	enc.ReceiptsRoot = e.ReceiptsRoot[:]
+	enc.LogsBloom = e.LogsBloom[:]
+	enc.PrevRandao = e.PrevRandao[:]
+	enc.BlockNumber = jsonUint64(e.BlockNumber)
+	enc.GasLimit = jsonUint64(e.GasLimit)
+	enc.GasUsed = jsonUint64(e.GasUsed)
+	enc.Timestamp = jsonUint64(e.Timestamp)
+	enc.ExtraData = e.ExtraData
+	enc.BaseFeePerGas = e.BaseFeePerGas
+	enc.BlockHash = e.BlockHash[:]
+	enc.TransactionsRoot = e.TransactionsRoot[:]
+	enc.WithdrawalRoot = e.WithdrawalRoot[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ExecutionPayloadHeaderCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonExecutionPayloadHeaderCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ParentHash[:], dec.ParentHash, "parent_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.FeeRecipient[:], dec.FeeRecipient, "fee_recipient"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ReceiptsRoot[:], dec.ReceiptsRoot, "receipts_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.LogsBloom[:], dec.LogsBloom, "logs_bloom"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.PrevRandao[:], dec.PrevRandao, "prev_randao"); err != nil {
+		return err
+	}
+	e.BlockNumber = uint64(dec.BlockNumber)
+	e.GasLimit = uint64(dec.GasLimit)
+	e.GasUsed = uint64(dec.GasUsed)
+	e.Timestamp = uint64(dec.Timestamp)
+	e.ExtraData = dec.ExtraData
+	e.BaseFeePerGas = dec.BaseFeePerGas
+	if err := copyFixedBytes(e.BlockHash[:], dec.BlockHash, "block_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.TransactionsRoot[:], dec.TransactionsRoot, "transactions_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.WithdrawalRoot[:], dec.WithdrawalRoot, "withdrawals_root"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBLSToExecutionChange struct {
+	ValidatorIndex     jsonUint64 `json:"validator_index"`
+	FromBLSPubKey      jsonBytes  `json:"from_bls_pubkey"`
+	ToExecutionAddress jsonBytes  `json:"to_execution_address"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BLSToExecutionChange) MarshalJSON() ([]byte, error) {
+	enc := &jsonBLSToExecutionChange{}
+	enc.ValidatorIndex = jsonUint64(b.ValidatorIndex)
+	enc.FromBLSPubKey = b.FromBLSPubKey[:]
+	enc.ToExecutionAddress = b.ToExecutionAddress[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BLSToExecutionChange) UnmarshalJSON(data []byte) error {
+	var dec jsonBLSToExecutionChange
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.ValidatorIndex = uint64(dec.ValidatorIndex)
+	if err := copyFixedBytes(b.FromBLSPubKey[:], dec.FromBLSPubKey, "from_bls_pubkey"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.ToExecutionAddress[:], dec.ToExecutionAddress, "to_execution_address"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonHistoricalSummary struct {
+	BlockSummaryRoot jsonBytes `json:"block_summary_root"`
+	StateSummaryRoot jsonBytes `json:"state_summary_root"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *HistoricalSummary) MarshalJSON() ([]byte, error) {
+	enc := &jsonHistoricalSummary{}
+	enc.BlockSummaryRoot = h.BlockSummaryRoot[:]
+	enc.StateSummaryRoot = h.StateSummaryRoot[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (h *HistoricalSummary) UnmarshalJSON(data []byte) error {
+	var dec jsonHistoricalSummary
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(h.BlockSummaryRoot[:], dec.BlockSummaryRoot, "block_summary_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(h.StateSummaryRoot[:], dec.StateSummaryRoot, "state_summary_root"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSignedBLSToExecutionChange struct {
+	Message   *BLSToExecutionChange `json:"message"`
+	Signature jsonBytes             `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBLSToExecutionChange) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBLSToExecutionChange{}
+	enc.Message = jsonObj(s.Message)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBLSToExecutionChange) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBLSToExecutionChange
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Message = dec.Message
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonWithdrawal struct {
+	Index          jsonUint64 `json:"index"`
+	ValidatorIndex jsonUint64 `json:"validator_index"`
+	Address        jsonBytes  `json:"address"`
+	Amount         jsonUint64 `json:"amount"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (w *Withdrawal) MarshalJSON() ([]byte, error) {
+	enc := &jsonWithdrawal{}
+	enc.Index = jsonUint64(w.Index)
+	enc.ValidatorIndex = jsonUint64(w.ValidatorIndex)
+	enc.Address = w.Address[:]
+	enc.Amount = jsonUint64(w.Amount)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (w *Withdrawal) UnmarshalJSON(data []byte) error {
+	var dec jsonWithdrawal
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	w.Index = uint64(dec.Index)
+	w.ValidatorIndex = uint64(dec.ValidatorIndex)
+	if err := copyFixedBytes(w.Address[:], dec.Address, "address"); err != nil {
+		return err
+	}
+	w.Amount = uint64(dec.Amount)
+	return nil
+}
+
+type jsonBeaconStateCapella struct {
+	GenesisTime                  jsonUint64                     `json:"genesis_time"`
+	GenesisValidatorsRoot        jsonBytes                      `json:"genesis_validators_root"`
+	Slot                         jsonUint64                     `json:"slot"`
+	Fork                         *Fork                          `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeader             `json:"latest_block_header"`
+	BlockRoots                   jsonBytesList                  `json:"block_roots"`
+	StateRoots                   jsonBytesList                  `json:"state_roots"`
+	HistoricalRoots              jsonBytesList                  `json:"historical_roots"`
+	Eth1Data                     *Eth1Data                      `json:"eth1_data"`
+	Eth1DataVotes                []*Eth1Data                    `json:"eth1_data_votes"`
+	Eth1DepositIndex             jsonUint64                     `json:"eth1_deposit_index"`
+	Validators                   []*Validator                   `json:"validators"`
+	Balances                     jsonUint64List                 `json:"balances"`
+	RandaoMixes                  jsonBytesList                  `json:"randao_mixes"`
+	Slashings                    jsonUint64List                 `json:"slashings"`
+	PreviousEpochParticipation   jsonUint8List                  `json:"previous_epoch_participation"`
+	CurrentEpochParticipation    jsonUint8List                  `json:"current_epoch_participation"`
+	JustificationBits            jsonBytes                      `json:"justification_bits"`
+	PreviousJustifiedCheckpoint  *Checkpoint                    `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *Checkpoint                    `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *Checkpoint                    `json:"finalized_checkpoint"`
+	InactivityScores             jsonUint64List                 `json:"inactivity_scores"`
+	CurrentSyncCommittee         *SyncCommittee                 `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommittee                 `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeaderCapella `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex          jsonUint64                     `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex jsonUint64                     `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries          []*HistoricalSummary           `json:"historical_summaries"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconStateCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconStateCapella{}
+	enc.GenesisTime = jsonUint64(b.GenesisTime)
+	enc.GenesisValidatorsRoot = b.GenesisValidatorsRoot[:]
+	enc.Slot = jsonUint64(b.Slot)
+	enc.Fork = jsonObj(b.Fork)
+	enc.LatestBlockHeader = jsonObj(b.LatestBlockHeader)
+	enc.BlockRoots = make(jsonBytesList, len(b.BlockRoots))
+	for indx := range b.BlockRoots {
+		enc.BlockRoots[indx] = b.BlockRoots[indx][:]
+	}
+	enc.StateRoots = make(jsonBytesList, len(b.StateRoots))
+	for indx := range b.StateRoots {
+		enc.StateRoots[indx] = b.StateRoots[indx][:]
+	}
+	enc.HistoricalRoots = b.HistoricalRoots
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Eth1DataVotes = jsonList(b.Eth1DataVotes)
+	enc.Eth1DepositIndex = jsonUint64(b.Eth1DepositIndex)
+	enc.Validators = jsonList(b.Validators)
+	enc.Balances = b.Balances
+	enc.RandaoMixes = make(jsonBytesList, len(b.RandaoMixes))
+	for indx := range b.RandaoMixes {
+		enc.RandaoMixes[indx] = b.RandaoMixes[indx][:]
+	}
+	enc.Slashings = b.Slashings
+	enc.PreviousEpochParticipation = b.PreviousEpochParticipation
+	enc.CurrentEpochParticipation = b.CurrentEpochParticipation
+	enc.JustificationBits = b.JustificationBits[:]
+	enc.PreviousJustifiedCheckpoint = jsonObj(b.PreviousJustifiedCheckpoint)
+	enc.CurrentJustifiedCheckpoint = jsonObj(b.CurrentJustifiedCheckpoint)
+	enc.FinalizedCheckpoint = jsonObj(b.FinalizedCheckpoint)
+	enc.InactivityScores = b.InactivityScores
+	enc.CurrentSyncCommittee = jsonObj(b.CurrentSyncCommittee)
+	enc.NextSyncCommittee = jsonObj(b.NextSyncCommittee)
+	enc.LatestExecutionPayloadHeader = jsonObj(b.LatestExecutionPayloadHeader)
+	enc.NextWithdrawalIndex = jsonUint64(b.NextWithdrawalIndex)
+	enc.NextWithdrawalValidatorIndex = jsonUint64(b.NextWithdrawalValidatorIndex)
+	enc.HistoricalSummaries = jsonList(b.HistoricalSummaries)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconStateCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconStateCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.GenesisTime = uint64(dec.GenesisTime)
+	if err := copyFixedBytes(b.GenesisValidatorsRoot[:], dec.GenesisValidatorsRoot, "genesis_validators_root"); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.Fork = dec.Fork
+	b.LatestBlockHeader = dec.LatestBlockHeader
+	if err := checkVectorLength(len(b.BlockRoots), len(dec.BlockRoots), "block_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.BlockRoots {
+		if err := copyFixedBytes(b.BlockRoots[indx][:], dec.BlockRoots[indx], "block_roots"); err != nil {
+			return err
+		}
+	}
+	if err := checkVectorLength(len(b.StateRoots), len(dec.StateRoots), "state_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.StateRoots {
+		if err := copyFixedBytes(b.StateRoots[indx][:], dec.StateRoots[indx], "state_roots"); err != nil {
+			return err
+		}
+	}
+	b.HistoricalRoots = dec.HistoricalRoots
+	b.Eth1Data = dec.Eth1Data
+	b.Eth1DataVotes = dec.Eth1DataVotes
+	b.Eth1DepositIndex = uint64(dec.Eth1DepositIndex)
+	b.Validators = dec.Validators
+	b.Balances = dec.Balances
+	if err := checkVectorLength(len(b.RandaoMixes), len(dec.RandaoMixes), "randao_mixes"); err != nil {
+		return err
+	}
+	for indx := range dec.RandaoMixes {
+		if err := copyFixedBytes(b.RandaoMixes[indx][:], dec.RandaoMixes[indx], "randao_mixes"); err != nil {
+			return err
+		}
+	}
+	b.Slashings = dec.Slashings
+	b.PreviousEpochParticipation = dec.PreviousEpochParticipation
+	b.CurrentEpochParticipation = dec.CurrentEpochParticipation
+	if err := copyFixedBytes(b.JustificationBits[:], dec.JustificationBits, "justification_bits"); err != nil {
+		return err
+	}
+	b.PreviousJustifiedCheckpoint = dec.PreviousJustifiedCheckpoint
+	b.CurrentJustifiedCheckpoint = dec.CurrentJustifiedCheckpoint
+	b.FinalizedCheckpoint = dec.FinalizedCheckpoint
+	b.InactivityScores = dec.InactivityScores
+	b.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	b.NextSyncCommittee = dec.NextSyncCommittee
+	b.LatestExecutionPayloadHeader = dec.LatestExecutionPayloadHeader
+	b.NextWithdrawalIndex = uint64(dec.NextWithdrawalIndex)
+	b.NextWithdrawalValidatorIndex = uint64(dec.NextWithdrawalValidatorIndex)
+	b.HistoricalSummaries = dec.HistoricalSummaries
+	return nil
+}
+
+type jsonSignedBeaconBlockCapella struct {
+	Block     *BeaconBlockCapella `json:"message"`
+	Signature jsonBytes           `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBeaconBlockCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBeaconBlockCapella{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBeaconBlockCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBeaconBlockCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconBlockCapella struct {
+	Slot          jsonUint64              `json:"slot"`
+	ProposerIndex jsonUint64              `json:"proposer_index"`
+	ParentRoot    jsonBytes               `json:"parent_root"`
+	StateRoot     jsonBytes               `json:"state_root"`
+	Body          *BeaconBlockBodyCapella `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockCapella{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBeaconBlockBodyCapella struct {
+	RandaoReveal          jsonBytes                     `json:"randao_reveal"`
+	Eth1Data              *Eth1Data                     `json:"eth1_data"`
+	Graffiti              jsonBytes                     `json:"graffiti"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings"`
+	AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings"`
+	Attestations          []*Attestation                `json:"attestations"`
+	Deposits              []*Deposit                    `json:"deposits"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayloadCapella      `json:"execution_payload"`
+	BlsToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockBodyCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockBodyCapella{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	enc.ExecutionPayload = jsonObj(b.ExecutionPayload)
+	enc.BlsToExecutionChanges = jsonList(b.BlsToExecutionChanges)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockBodyCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockBodyCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	b.ExecutionPayload = dec.ExecutionPayload
+	b.BlsToExecutionChanges = dec.BlsToExecutionChanges
+	return nil
+}
+
+type jsonSignedBlindedBeaconBlockCapella struct {
+	Block     *BlindedBeaconBlockCapella `json:"message"`
+	Signature jsonBytes                  `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBlindedBeaconBlockCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBlindedBeaconBlockCapella{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBlindedBeaconBlockCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBlindedBeaconBlockCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBlindedBeaconBlockCapella struct {
+	Slot          jsonUint64                     `json:"slot"`
+	ProposerIndex jsonUint64                     `json:"proposer_index"`
+	ParentRoot    jsonBytes                      `json:"parent_root"`
+	StateRoot     jsonBytes                      `json:"state_root"`
+	Body          *BlindedBeaconBlockBodyCapella `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlindedBeaconBlockCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlindedBeaconBlockCapella{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlindedBeaconBlockCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonBlindedBeaconBlockCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBlindedBeaconBlockBodyCapella struct {
+	RandaoReveal           jsonBytes                      `json:"randao_reveal"`
+	Eth1Data               *Eth1Data                      `json:"eth1_data"`
+	Graffiti               jsonBytes                      `json:"graffiti"`
+	ProposerSlashings      []*ProposerSlashing            `json:"proposer_slashings"`
+	AttesterSlashings      []*AttesterSlashing            `json:"attester_slashings"`
+	Attestations           []*Attestation                 `json:"attestations"`
+	Deposits               []*Deposit                     `json:"deposits"`
+	VoluntaryExits         []*SignedVoluntaryExit         `json:"voluntary_exits"`
+	SyncAggregate          *SyncAggregate                 `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeaderCapella `json:"execution_payload_header"`
+	BlsToExecutionChanges  []*SignedBLSToExecutionChange  `json:"bls_to_execution_changes"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlindedBeaconBlockBodyCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlindedBeaconBlockBodyCapella{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	enc.ExecutionPayloadHeader = jsonObj(b.ExecutionPayloadHeader)
+	enc.BlsToExecutionChanges = jsonList(b.BlsToExecutionChanges)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlindedBeaconBlockBodyCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonBlindedBeaconBlockBodyCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	b.ExecutionPayloadHeader = dec.ExecutionPayloadHeader
+	b.BlsToExecutionChanges = dec.BlsToExecutionChanges
+	return nil
+}
+
+type jsonLightClientHeaderCapella struct {
+	Header          *BeaconBlockHeader             `json:"beacon"`
+	Execution       *ExecutionPayloadHeaderCapella `json:"execution"`
+	ExecutionBranch jsonBytesList                  `json:"execution_branch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientHeaderCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientHeaderCapella{}
+	enc.Header = jsonObj(l.Header)
+	enc.Execution = jsonObj(l.Execution)
+	enc.ExecutionBranch = make(jsonBytesList, len(l.ExecutionBranch))
+	for indx := range l.ExecutionBranch {
+		enc.ExecutionBranch[indx] = l.ExecutionBranch[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientHeaderCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientHeaderCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.Header = dec.Header
+	l.Execution = dec.Execution
+	if err := checkVectorLength(len(l.ExecutionBranch), len(dec.ExecutionBranch), "execution_branch"); err != nil {
+		return err
+	}
+	for indx := range dec.ExecutionBranch {
+		if err := copyFixedBytes(l.ExecutionBranch[indx][:], dec.ExecutionBranch[indx], "execution_branch"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonLightClientBootstrapCapella struct {
+	Header                     *LightClientHeaderCapella `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee            `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch jsonBytesList             `json:"current_sync_committee_branch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientBootstrapCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientBootstrapCapella{}
+	enc.Header = jsonObj(l.Header)
+	enc.CurrentSyncCommittee = jsonObj(l.CurrentSyncCommittee)
+	enc.CurrentSyncCommitteeBranch = make(jsonBytesList, len(l.CurrentSyncCommitteeBranch))
+	for indx := range l.CurrentSyncCommitteeBranch {
+		enc.CurrentSyncCommitteeBranch[indx] = l.CurrentSyncCommitteeBranch[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientBootstrapCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientBootstrapCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.Header = dec.Header
+	l.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	l.CurrentSyncCommitteeBranch = make([][32]byte, len(dec.CurrentSyncCommitteeBranch))
+	for indx := range dec.CurrentSyncCommitteeBranch {
+		if err := copyFixedBytes(l.CurrentSyncCommitteeBranch[indx][:], dec.CurrentSyncCommitteeBranch[indx], "current_sync_committee_branch"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonLightClientFinalityUpdateCapella struct {
+	AttestedHeader  *LightClientHeaderCapella `json:"attested_header"`
+	FinalizedHeader *LightClientHeaderCapella `json:"finalized_header"`
+	FinalityBranch  jsonBytesList             `json:"finality_branch"`
+	SyncAggregate   *SyncAggregate            `json:"sync_aggregate"`
+	SignatureSlot   jsonUint64                `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientFinalityUpdateCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientFinalityUpdateCapella{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.FinalizedHeader = jsonObj(l.FinalizedHeader)
+	enc.FinalityBranch = make(jsonBytesList, len(l.FinalityBranch))
+	for indx := range l.FinalityBranch {
+		enc.FinalityBranch[indx] = l.FinalityBranch[indx][:]
+	}
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientFinalityUpdateCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientFinalityUpdateCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.FinalizedHeader = dec.FinalizedHeader
+	l.FinalityBranch = make([][32]byte, len(dec.FinalityBranch))
+	for indx := range dec.FinalityBranch {
+		if err := copyFixedBytes(l.FinalityBranch[indx][:], dec.FinalityBranch[indx], "finality_branch"); err != nil {
+			return err
+		}
+	}
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonLightClientOptimisticUpdateCapella struct {
+	AttestedHeader *LightClientHeaderCapella `json:"attested_header"`
+	SyncAggregate  *SyncAggregate            `json:"sync_aggregate"`
+	SignatureSlot  jsonUint64                `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientOptimisticUpdateCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientOptimisticUpdateCapella{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientOptimisticUpdateCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientOptimisticUpdateCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonLightClientUpdateCapella struct {
+	AttestedHeader          *LightClientHeaderCapella `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee            `json:"next_sync_committee"`
+	NextSyncCommitteeBranch jsonBytesList             `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeaderCapella `json:"finalized_header"`
+	FinalityBranch          jsonBytesList             `json:"finality_branch"`
+	SyncAggregate           *SyncAggregate            `json:"sync_aggregate"`
+	SignatureSlot           jsonUint64                `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientUpdateCapella) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientUpdateCapella{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.NextSyncCommittee = jsonObj(l.NextSyncCommittee)
+	enc.NextSyncCommitteeBranch = make(jsonBytesList, len(l.NextSyncCommitteeBranch))
+	for indx := range l.NextSyncCommitteeBranch {
+		enc.NextSyncCommitteeBranch[indx] = l.NextSyncCommitteeBranch[indx][:]
+	}
+	enc.FinalizedHeader = jsonObj(l.FinalizedHeader)
+	enc.FinalityBranch = make(jsonBytesList, len(l.FinalityBranch))
+	for indx := range l.FinalityBranch {
+		enc.FinalityBranch[indx] = l.FinalityBranch[indx][:]
+	}
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientUpdateCapella) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientUpdateCapella
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.NextSyncCommittee = dec.NextSyncCommittee
+	l.NextSyncCommitteeBranch = make([][32]byte, len(dec.NextSyncCommitteeBranch))
+	for indx := range dec.NextSyncCommitteeBranch {
+		if err := copyFixedBytes(l.NextSyncCommitteeBranch[indx][:], dec.NextSyncCommitteeBranch[indx], "next_sync_committee_branch"); err != nil {
+			return err
+		}
+	}
+	l.FinalizedHeader = dec.FinalizedHeader
+	l.FinalityBranch = make([][32]byte, len(dec.FinalityBranch))
+	for indx := range dec.FinalityBranch {
+		if err := copyFixedBytes(l.FinalityBranch[indx][:], dec.FinalityBranch[indx], "finality_branch"); err != nil {
+			return err
+		}
+	}
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonExecutionPayloadDeneb struct {
+	ParentHash    jsonBytes     `json:"parent_hash"`
+	FeeRecipient  jsonBytes     `json:"fee_recipient"`
+	StateRoot     jsonBytes     `json:"state_root"`
+	ReceiptsRoot  jsonBytes     `json:"receipts_root"`
+	LogsBloom     jsonBytes     `json:"logs_bloom"`
+	PrevRandao    jsonBytes     `json:"prev_randao"`
+	BlockNumber   jsonUint64    `json:"block_number"`
+	GasLimit      jsonUint64    `json:"gas_limit"`
+	GasUsed       jsonUint64    `json:"gas_used"`
+	Timestamp     jsonUint64    `json:"timestamp"`
+	ExtraData     jsonBytes     `json:"extra_data"`
+	BaseFeePerGas Uint256       `json:"base_fee_per_gas"`
+	BlockHash     jsonBytes     `json:"block_hash"`
+	Transactions  jsonBytesList `json:"transactions"`
+	Withdrawals   []*Withdrawal `json:"withdrawals"`
+	BlobGasUsed   jsonUint64    `json:"blob_gas_used"`
+	ExcessBlobGas jsonUint64    `json:"excess_blob_gas"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ExecutionPayloadDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonExecutionPayloadDeneb{}
+	enc.ParentHash = e.ParentHash[:]
+	enc.FeeRecipient = e.FeeRecipient[:]
+	enc.StateRoot = e.StateRoot[:]
+	enc.ReceiptsRoot = e.ReceiptsRoot[:]
+	enc.LogsBloom = e.LogsBloom[:]
+	enc.PrevRandao = e.PrevRandao[:]
+	enc.BlockNumber = jsonUint64(e.BlockNumber)
+	enc.GasLimit = jsonUint64(e.GasLimit)
+	enc.GasUsed = jsonUint64(e.GasUsed)
+	enc.Timestamp = jsonUint64(e.Timestamp)
+	enc.ExtraData = e.ExtraData
+	enc.BaseFeePerGas = e.BaseFeePerGas
+	enc.BlockHash = e.BlockHash[:]
+	enc.Transactions = e.Transactions
+	enc.Withdrawals = jsonList(e.Withdrawals)
+	enc.BlobGasUsed = jsonUint64(e.BlobGasUsed)
+	enc.ExcessBlobGas = jsonUint64(e.ExcessBlobGas)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ExecutionPayloadDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonExecutionPayloadDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ParentHash[:], dec.ParentHash, "parent_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.FeeRecipient[:], dec.FeeRecipient, "fee_recipient"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ReceiptsRoot[:], dec.ReceiptsRoot, "receipts_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.LogsBloom[:], dec.LogsBloom, "logs_bloom"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.PrevRandao[:], dec.PrevRandao, "prev_randao"); err != nil {
+		return err
+	}
+	e.BlockNumber = uint64(dec.BlockNumber)
+	e.GasLimit = uint64(dec.GasLimit)
+	e.GasUsed = uint64(dec.GasUsed)
+	e.Timestamp = uint64(dec.Timestamp)
+	e.ExtraData = dec.ExtraData
+	e.BaseFeePerGas = dec.BaseFeePerGas
+	if err := copyFixedBytes(e.BlockHash[:], dec.BlockHash, "block_hash"); err != nil {
+		return err
+	}
+	e.Transactions = dec.Transactions
+	e.Withdrawals = dec.Withdrawals
+	e.BlobGasUsed = uint64(dec.BlobGasUsed)
+	e.ExcessBlobGas = uint64(dec.ExcessBlobGas)
+	return nil
+}
+
+type jsonExecutionPayloadHeaderDeneb struct {
+	ParentHash       jsonBytes  `json:"parent_hash"`
+	FeeRecipient     jsonBytes  `json:"fee_recipient"`
+	StateRoot        jsonBytes  `json:"state_root"`
+	ReceiptsRoot     jsonBytes  `json:"receipts_root"`
+	LogsBloom        jsonBytes  `json:"logs_bloom"`
+	PrevRandao       jsonBytes  `json:"prev_randao"`
+	BlockNumber      jsonUint64 `json:"block_number"`
+	GasLimit         jsonUint64 `json:"gas_limit"`
+	GasUsed          jsonUint64 `json:"gas_used"`
+	Timestamp        jsonUint64 `json:"timestamp"`
+	ExtraData        jsonBytes  `json:"extra_data"`
+	BaseFeePerGas    Uint256    `json:"base_fee_per_gas"`
+	BlockHash        jsonBytes  `json:"block_hash"`
+	TransactionsRoot jsonBytes  `json:"transactions_root"`
+	WithdrawalRoot   jsonBytes  `json:"withdrawals_root"`
+	BlobGasUsed      jsonUint64 `json:"blob_gas_used"`
+	ExcessBlobGas    jsonUint64 `json:"excess_blob_gas"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ExecutionPayloadHeaderDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonExecutionPayloadHeaderDeneb{}
+	enc.ParentHash = e.ParentHash[:]
+	enc.FeeRecipient = e.FeeRecipient[:]
+	enc.StateRoot = e.StateRoot[:]
+	enc.ReceiptsRoot = e.ReceiptsRoot[:]
+	enc.LogsBloom = e.LogsBloom[:]
+	enc.PrevRandao = e.PrevRandao[:]
+	enc.BlockNumber = jsonUint64(e.BlockNumber)
+	enc.GasLimit = jsonUint64(e.GasLimit)
+	enc.GasUsed = jsonUint64(e.GasUsed)
+	enc.Timestamp = jsonUint64(e.Timestamp)
+	enc.ExtraData = e.ExtraData
+	enc.BaseFeePerGas = e.BaseFeePerGas
+	enc.BlockHash = e.BlockHash[:]
+	enc.TransactionsRoot = e.TransactionsRoot[:]
+	enc.WithdrawalRoot = e.WithdrawalRoot[:]
+	enc.BlobGasUsed = jsonUint64(e.BlobGasUsed)
+	enc.ExcessBlobGas = jsonUint64(e.ExcessBlobGas)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ExecutionPayloadHeaderDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonExecutionPayloadHeaderDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ParentHash[:], dec.ParentHash, "parent_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.FeeRecipient[:], dec.FeeRecipient, "fee_recipient"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.ReceiptsRoot[:], dec.ReceiptsRoot, "receipts_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.LogsBloom[:], dec.LogsBloom, "logs_bloom"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.PrevRandao[:], dec.PrevRandao, "prev_randao"); err != nil {
+		return err
+	}
+	e.BlockNumber = uint64(dec.BlockNumber)
+	e.GasLimit = uint64(dec.GasLimit)
+	e.GasUsed = uint64(dec.GasUsed)
+	e.Timestamp = uint64(dec.Timestamp)
+	e.ExtraData = dec.ExtraData
+	e.BaseFeePerGas = dec.BaseFeePerGas
+	if err := copyFixedBytes(e.BlockHash[:], dec.BlockHash, "block_hash"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.TransactionsRoot[:], dec.TransactionsRoot, "transactions_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(e.WithdrawalRoot[:], dec.WithdrawalRoot, "withdrawals_root"); err != nil {
+		return err
+	}
+	e.BlobGasUsed = uint64(dec.BlobGasUsed)
+	e.ExcessBlobGas = uint64(dec.ExcessBlobGas)
+	return nil
+}
+
+type jsonBeaconStateDeneb struct {
+	GenesisTime                  jsonUint64                   `json:"genesis_time"`
+	GenesisValidatorsRoot        jsonBytes                    `json:"genesis_validators_root"`
+	Slot                         jsonUint64                   `json:"slot"`
+	Fork                         *Fork                        `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeader           `json:"latest_block_header"`
+	BlockRoots                   jsonBytesList                `json:"block_roots"`
+	StateRoots                   jsonBytesList                `json:"state_roots"`
+	HistoricalRoots              jsonBytesList                `json:"historical_roots"`
+	Eth1Data                     *Eth1Data                    `json:"eth1_data"`
+	Eth1DataVotes                []*Eth1Data                  `json:"eth1_data_votes"`
+	Eth1DepositIndex             jsonUint64                   `json:"eth1_deposit_index"`
+	Validators                   []*Validator                 `json:"validators"`
+	Balances                     jsonUint64List               `json:"balances"`
+	RandaoMixes                  jsonBytesList                `json:"randao_mixes"`
+	Slashings                    jsonUint64List               `json:"slashings"`
+	PreviousEpochParticipation   jsonUint8List                `json:"previous_epoch_participation"`
+	CurrentEpochParticipation    jsonUint8List                `json:"current_epoch_participation"`
+	JustificationBits            jsonBytes                    `json:"justification_bits"`
+	PreviousJustifiedCheckpoint  *Checkpoint                  `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *Checkpoint                  `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *Checkpoint                  `json:"finalized_checkpoint"`
+	InactivityScores             jsonUint64List               `json:"inactivity_scores"`
+	CurrentSyncCommittee         *SyncCommittee               `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommittee               `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeaderDeneb `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex          jsonUint64                   `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex jsonUint64                   `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries          []*HistoricalSummary         `json:"historical_summaries"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconStateDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconStateDeneb{}
+	enc.GenesisTime = jsonUint64(b.GenesisTime)
+	enc.GenesisValidatorsRoot = b.GenesisValidatorsRoot[:]
+	enc.Slot = jsonUint64(b.Slot)
+	enc.Fork = jsonObj(b.Fork)
+	enc.LatestBlockHeader = jsonObj(b.LatestBlockHeader)
+	enc.BlockRoots = make(jsonBytesList, len(b.BlockRoots))
+	for indx := range b.BlockRoots {
+		enc.BlockRoots[indx] = b.BlockRoots[indx][:]
+	}
+	enc.StateRoots = make(jsonBytesList, len(b.StateRoots))
+	for indx := range b.StateRoots {
+		enc.StateRoots[indx] = b.StateRoots[indx][:]
+	}
+	enc.HistoricalRoots = b.HistoricalRoots
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Eth1DataVotes = jsonList(b.Eth1DataVotes)
+	enc.Eth1DepositIndex = jsonUint64(b.Eth1DepositIndex)
+	enc.Validators = jsonList(b.Validators)
+	enc.Balances = b.Balances
+	enc.RandaoMixes = make(jsonBytesList, len(b.RandaoMixes))
+	for indx := range b.RandaoMixes {
+		enc.RandaoMixes[indx] = b.RandaoMixes[indx][:]
+	}
+	enc.Slashings = b.Slashings
+	enc.PreviousEpochParticipation = b.PreviousEpochParticipation
+	enc.CurrentEpochParticipation = b.CurrentEpochParticipation
+	enc.JustificationBits = b.JustificationBits[:]
+	enc.PreviousJustifiedCheckpoint = jsonObj(b.PreviousJustifiedCheckpoint)
+	enc.CurrentJustifiedCheckpoint = jsonObj(b.CurrentJustifiedCheckpoint)
+	enc.FinalizedCheckpoint = jsonObj(b.FinalizedCheckpoint)
+	enc.InactivityScores = b.InactivityScores
+	enc.CurrentSyncCommittee = jsonObj(b.CurrentSyncCommittee)
+	enc.NextSyncCommittee = jsonObj(b.NextSyncCommittee)
+	enc.LatestExecutionPayloadHeader = jsonObj(b.LatestExecutionPayloadHeader)
+	enc.NextWithdrawalIndex = jsonUint64(b.NextWithdrawalIndex)
+	enc.NextWithdrawalValidatorIndex = jsonUint64(b.NextWithdrawalValidatorIndex)
+	enc.HistoricalSummaries = jsonList(b.HistoricalSummaries)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconStateDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconStateDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.GenesisTime = uint64(dec.GenesisTime)
+	if err := copyFixedBytes(b.GenesisValidatorsRoot[:], dec.GenesisValidatorsRoot, "genesis_validators_root"); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.Fork = dec.Fork
+	b.LatestBlockHeader = dec.LatestBlockHeader
+	if err := checkVectorLength(len(b.BlockRoots), len(dec.BlockRoots), "block_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.BlockRoots {
+		if err := copyFixedBytes(b.BlockRoots[indx][:], dec.BlockRoots[indx], "block_roots"); err != nil {
+			return err
+		}
+	}
+	if err := checkVectorLength(len(b.StateRoots), len(dec.StateRoots), "state_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.StateRoots {
+		if err := copyFixedBytes(b.StateRoots[indx][:], dec.StateRoots[indx], "state_roots"); err != nil {
+			return err
+		}
+	}
+	b.HistoricalRoots = dec.HistoricalRoots
+	b.Eth1Data = dec.Eth1Data
+	b.Eth1DataVotes = dec.Eth1DataVotes
+	b.Eth1DepositIndex = uint64(dec.Eth1DepositIndex)
+	b.Validators = dec.Validators
+	b.Balances = dec.Balances
+	if err := checkVectorLength(len(b.RandaoMixes), len(dec.RandaoMixes), "randao_mixes"); err != nil {
+		return err
+	}
+	for indx := range dec.RandaoMixes {
+		if err := copyFixedBytes(b.RandaoMixes[indx][:], dec.RandaoMixes[indx], "randao_mixes"); err != nil {
+			return err
+		}
+	}
+	b.Slashings = dec.Slashings
+	b.PreviousEpochParticipation = dec.PreviousEpochParticipation
+	b.CurrentEpochParticipation = dec.CurrentEpochParticipation
+	if err := copyFixedBytes(b.JustificationBits[:], dec.JustificationBits, "justification_bits"); err != nil {
+		return err
+	}
+	b.PreviousJustifiedCheckpoint = dec.PreviousJustifiedCheckpoint
+	b.CurrentJustifiedCheckpoint = dec.CurrentJustifiedCheckpoint
+	b.FinalizedCheckpoint = dec.FinalizedCheckpoint
+	b.InactivityScores = dec.InactivityScores
+	b.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	b.NextSyncCommittee = dec.NextSyncCommittee
+	b.LatestExecutionPayloadHeader = dec.LatestExecutionPayloadHeader
+	b.NextWithdrawalIndex = uint64(dec.NextWithdrawalIndex)
+	b.NextWithdrawalValidatorIndex = uint64(dec.NextWithdrawalValidatorIndex)
+	b.HistoricalSummaries = dec.HistoricalSummaries
+	return nil
+}
+
+type jsonSignedBeaconBlockDeneb struct {
+	Block     *BeaconBlockDeneb `json:"message"`
+	Signature jsonBytes         `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBeaconBlockDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBeaconBlockDeneb{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBeaconBlockDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBeaconBlockDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconBlockDeneb struct {
+	Slot          jsonUint64            `json:"slot"`
+	ProposerIndex jsonUint64            `json:"proposer_index"`
+	ParentRoot    jsonBytes             `json:"parent_root"`
+	StateRoot     jsonBytes             `json:"state_root"`
+	Body          *BeaconBlockBodyDeneb `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockDeneb{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBeaconBlockBodyDeneb struct {
+	RandaoReveal          jsonBytes                     `json:"randao_reveal"`
+	Eth1Data              *Eth1Data                     `json:"eth1_data"`
+	Graffiti              jsonBytes                     `json:"graffiti"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings"`
+	AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings"`
+	Attestations          []*Attestation                `json:"attestations"`
+	Deposits              []*Deposit                    `json:"deposits"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayloadDeneb        `json:"execution_payload"`
+	BlsToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+	BlobKzgCommitments    jsonBytesList                 `json:"blob_kzg_commitments"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockBodyDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockBodyDeneb{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	enc.ExecutionPayload = jsonObj(b.ExecutionPayload)
+	enc.BlsToExecutionChanges = jsonList(b.BlsToExecutionChanges)
+	enc.BlobKzgCommitments = make(jsonBytesList, len(b.BlobKzgCommitments))
+	for indx := range b.BlobKzgCommitments {
+		enc.BlobKzgCommitments[indx] = b.BlobKzgCommitments[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockBodyDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockBodyDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	b.ExecutionPayload = dec.ExecutionPayload
+	b.BlsToExecutionChanges = dec.BlsToExecutionChanges
+	b.BlobKzgCommitments = make([][48]byte, len(dec.BlobKzgCommitments))
+	for indx := range dec.BlobKzgCommitments {
+		if err := copyFixedBytes(b.BlobKzgCommitments[indx][:], dec.BlobKzgCommitments[indx], "blob_kzg_commitments"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonBlobSidecar struct {
+	Index                       jsonUint64               `json:"index"`
+	Blob                        jsonBytes                `json:"blob"`
+	KzgCommitment               jsonBytes                `json:"kzg_commitment"`
+	KzgProof                    jsonBytes                `json:"kzg_proof"`
+	SignedBlockHeader           *SignedBeaconBlockHeader `json:"signed_block_header"`
+	KzgCommitmentInclusionProof jsonBytesList            `json:"kzg_commitment_inclusion_proof"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlobSidecar) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlobSidecar{}
+	enc.Index = jsonUint64(b.Index)
+	enc.Blob = b.Blob[:]
+	enc.KzgCommitment = b.KzgCommitment[:]
+	enc.KzgProof = b.KzgProof[:]
+	enc.SignedBlockHeader = jsonObj(b.SignedBlockHeader)
+	enc.KzgCommitmentInclusionProof = make(jsonBytesList, len(b.KzgCommitmentInclusionProof))
+	for indx := range b.KzgCommitmentInclusionProof {
+		enc.KzgCommitmentInclusionProof[indx] = b.KzgCommitmentInclusionProof[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlobSidecar) UnmarshalJSON(data []byte) error {
+	var dec jsonBlobSidecar
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Index = uint64(dec.Index)
+	if err := copyFixedBytes(b.Blob[:], dec.Blob, "blob"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.KzgCommitment[:], dec.KzgCommitment, "kzg_commitment"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.KzgProof[:], dec.KzgProof, "kzg_proof"); err != nil {
+		return err
+	}
+	b.SignedBlockHeader = dec.SignedBlockHeader
+	if err := checkVectorLength(len(b.KzgCommitmentInclusionProof), len(dec.KzgCommitmentInclusionProof), "kzg_commitment_inclusion_proof"); err != nil {
+		return err
+	}
+	for indx := range dec.KzgCommitmentInclusionProof {
+		if err := copyFixedBytes(b.KzgCommitmentInclusionProof[indx][:], dec.KzgCommitmentInclusionProof[indx], "kzg_commitment_inclusion_proof"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonBlobIdentifier struct {
+	BlockRoot jsonBytes  `json:"block_root"`
+	Index     jsonUint64 `json:"index"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlobIdentifier) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlobIdentifier{}
+	enc.BlockRoot = b.BlockRoot[:]
+	enc.Index = jsonUint64(b.Index)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlobIdentifier) UnmarshalJSON(data []byte) error {
+	var dec jsonBlobIdentifier
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.BlockRoot[:], dec.BlockRoot, "block_root"); err != nil {
+		return err
+	}
+	b.Index = uint64(dec.Index)
+	return nil
+}
+
+type jsonSignedBlindedBeaconBlockDeneb struct {
+	Block     *BlindedBeaconBlockDeneb `json:"message"`
+	Signature jsonBytes                `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBlindedBeaconBlockDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBlindedBeaconBlockDeneb{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBlindedBeaconBlockDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBlindedBeaconBlockDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBlindedBeaconBlockDeneb struct {
+	Slot          jsonUint64                   `json:"slot"`
+	ProposerIndex jsonUint64                   `json:"proposer_index"`
+	ParentRoot    jsonBytes                    `json:"parent_root"`
+	StateRoot     jsonBytes                    `json:"state_root"`
+	Body          *BlindedBeaconBlockBodyDeneb `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlindedBeaconBlockDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlindedBeaconBlockDeneb{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlindedBeaconBlockDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonBlindedBeaconBlockDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBlindedBeaconBlockBodyDeneb struct {
+	RandaoReveal           jsonBytes                     `json:"randao_reveal"`
+	Eth1Data               *Eth1Data                     `json:"eth1_data"`
+	Graffiti               jsonBytes                     `json:"graffiti"`
+	ProposerSlashings      []*ProposerSlashing           `json:"proposer_slashings"`
+	AttesterSlashings      []*AttesterSlashing           `json:"attester_slashings"`
+	Attestations           []*Attestation                `json:"attestations"`
+	Deposits               []*Deposit                    `json:"deposits"`
+	VoluntaryExits         []*SignedVoluntaryExit        `json:"voluntary_exits"`
+	SyncAggregate          *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeaderDeneb  `json:"execution_payload_header"`
+	BlsToExecutionChanges  []*SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+	BlobKzgCommitments     jsonBytesList                 `json:"blob_kzg_commitments"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlindedBeaconBlockBodyDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlindedBeaconBlockBodyDeneb{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	enc.ExecutionPayloadHeader = jsonObj(b.ExecutionPayloadHeader)
+	enc.BlsToExecutionChanges = jsonList(b.BlsToExecutionChanges)
+	enc.BlobKzgCommitments = make(jsonBytesList, len(b.BlobKzgCommitments))
+	for indx := range b.BlobKzgCommitments {
+		enc.BlobKzgCommitments[indx] = b.BlobKzgCommitments[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlindedBeaconBlockBodyDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonBlindedBeaconBlockBodyDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	b.ExecutionPayloadHeader = dec.ExecutionPayloadHeader
+	b.BlsToExecutionChanges = dec.BlsToExecutionChanges
+	b.BlobKzgCommitments = make([][48]byte, len(dec.BlobKzgCommitments))
+	for indx := range dec.BlobKzgCommitments {
+		if err := copyFixedBytes(b.BlobKzgCommitments[indx][:], dec.BlobKzgCommitments[indx], "blob_kzg_commitments"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonBlobsBundle struct {
+	Commitments jsonBytesList `json:"commitments"`
+	Proofs      jsonBytesList `json:"proofs"`
+	Blobs       jsonBytesList `json:"blobs"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlobsBundle) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlobsBundle{}
+	enc.Commitments = make(jsonBytesList, len(b.Commitments))
+	for indx := range b.Commitments {
+		enc.Commitments[indx] = b.Commitments[indx][:]
+	}
+	enc.Proofs = make(jsonBytesList, len(b.Proofs))
+	for indx := range b.Proofs {
+		enc.Proofs[indx] = b.Proofs[indx][:]
+	}
+	enc.Blobs = make(jsonBytesList, len(b.Blobs))
+	for indx := range b.Blobs {
+		enc.Blobs[indx] = b.Blobs[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlobsBundle) UnmarshalJSON(data []byte) error {
+	var dec jsonBlobsBundle
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Commitments = make([][48]byte, len(dec.Commitments))
+	for indx := range dec.Commitments {
+		if err := copyFixedBytes(b.Commitments[indx][:], dec.Commitments[indx], "commitments"); err != nil {
+			return err
+		}
+	}
+	b.Proofs = make([][48]byte, len(dec.Proofs))
+	for indx := range dec.Proofs {
+		if err := copyFixedBytes(b.Proofs[indx][:], dec.Proofs[indx], "proofs"); err != nil {
+			return err
+		}
+	}
+	b.Blobs = make([][131072]byte, len(dec.Blobs))
+	for indx := range dec.Blobs {
+		if err := copyFixedBytes(b.Blobs[indx][:], dec.Blobs[indx], "blobs"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonExecutionPayloadAndBlobsBundle struct {
+	ExecutionPayload *ExecutionPayloadDeneb `json:"execution_payload"`
+	BlobsBundle      *BlobsBundle           `json:"blobs_bundle"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ExecutionPayloadAndBlobsBundle) MarshalJSON() ([]byte, error) {
+	enc := &jsonExecutionPayloadAndBlobsBundle{}
+	enc.ExecutionPayload = jsonObj(e.ExecutionPayload)
+	enc.BlobsBundle = jsonObj(e.BlobsBundle)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ExecutionPayloadAndBlobsBundle) UnmarshalJSON(data []byte) error {
+	var dec jsonExecutionPayloadAndBlobsBundle
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	e.ExecutionPayload = dec.ExecutionPayload
+	e.BlobsBundle = dec.BlobsBundle
+	return nil
+}
+
+type jsonLightClientHeaderDeneb struct {
+	Header          *BeaconBlockHeader           `json:"beacon"`
+	Execution       *ExecutionPayloadHeaderDeneb `json:"execution"`
+	ExecutionBranch jsonBytesList                `json:"execution_branch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientHeaderDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientHeaderDeneb{}
+	enc.Header = jsonObj(l.Header)
+	enc.Execution = jsonObj(l.Execution)
+	enc.ExecutionBranch = make(jsonBytesList, len(l.ExecutionBranch))
+	for indx := range l.ExecutionBranch {
+		enc.ExecutionBranch[indx] = l.ExecutionBranch[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientHeaderDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientHeaderDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.Header = dec.Header
+	l.Execution = dec.Execution
+	if err := checkVectorLength(len(l.ExecutionBranch), len(dec.ExecutionBranch), "execution_branch"); err != nil {
+		return err
+	}
+	for indx := range dec.ExecutionBranch {
+		if err := copyFixedBytes(l.ExecutionBranch[indx][:], dec.ExecutionBranch[indx], "execution_branch"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonLightClientBootstrapDeneb struct {
+	Header                     *LightClientHeaderDeneb `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee          `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch jsonBytesList           `json:"current_sync_committee_branch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientBootstrapDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientBootstrapDeneb{}
+	enc.Header = jsonObj(l.Header)
+	enc.CurrentSyncCommittee = jsonObj(l.CurrentSyncCommittee)
+	enc.CurrentSyncCommitteeBranch = make(jsonBytesList, len(l.CurrentSyncCommitteeBranch))
+	for indx := range l.CurrentSyncCommitteeBranch {
+		enc.CurrentSyncCommitteeBranch[indx] = l.CurrentSyncCommitteeBranch[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientBootstrapDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientBootstrapDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.Header = dec.Header
+	l.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	l.CurrentSyncCommitteeBranch = make([][32]byte, len(dec.CurrentSyncCommitteeBranch))
+	for indx := range dec.CurrentSyncCommitteeBranch {
+		if err := copyFixedBytes(l.CurrentSyncCommitteeBranch[indx][:], dec.CurrentSyncCommitteeBranch[indx], "current_sync_committee_branch"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonLightClientFinalityUpdateDeneb struct {
+	AttestedHeader  *LightClientHeaderDeneb `json:"attested_header"`
+	FinalizedHeader *LightClientHeaderDeneb `json:"finalized_header"`
+	FinalityBranch  jsonBytesList           `json:"finality_branch"`
+	SyncAggregate   *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot   jsonUint64              `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientFinalityUpdateDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientFinalityUpdateDeneb{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.FinalizedHeader = jsonObj(l.FinalizedHeader)
+	enc.FinalityBranch = make(jsonBytesList, len(l.FinalityBranch))
+	for indx := range l.FinalityBranch {
+		enc.FinalityBranch[indx] = l.FinalityBranch[indx][:]
+	}
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientFinalityUpdateDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientFinalityUpdateDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.FinalizedHeader = dec.FinalizedHeader
+	l.FinalityBranch = make([][32]byte, len(dec.FinalityBranch))
+	for indx := range dec.FinalityBranch {
+		if err := copyFixedBytes(l.FinalityBranch[indx][:], dec.FinalityBranch[indx], "finality_branch"); err != nil {
+			return err
+		}
+	}
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonLightClientOptimisticUpdateDeneb struct {
+	AttestedHeader *LightClientHeaderDeneb `json:"attested_header"`
+	SyncAggregate  *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot  jsonUint64              `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientOptimisticUpdateDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientOptimisticUpdateDeneb{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientOptimisticUpdateDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientOptimisticUpdateDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonLightClientUpdateDeneb struct {
+	AttestedHeader          *LightClientHeaderDeneb `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee          `json:"next_sync_committee"`
+	NextSyncCommitteeBranch jsonBytesList           `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeaderDeneb `json:"finalized_header"`
+	FinalityBranch          jsonBytesList           `json:"finality_branch"`
+	SyncAggregate           *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot           jsonUint64              `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientUpdateDeneb) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientUpdateDeneb{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.NextSyncCommittee = jsonObj(l.NextSyncCommittee)
+	enc.NextSyncCommitteeBranch = make(jsonBytesList, len(l.NextSyncCommitteeBranch))
+	for indx := range l.NextSyncCommitteeBranch {
+		enc.NextSyncCommitteeBranch[indx] = l.NextSyncCommitteeBranch[indx][:]
+	}
+	enc.FinalizedHeader = jsonObj(l.FinalizedHeader)
+	enc.FinalityBranch = make(jsonBytesList, len(l.FinalityBranch))
+	for indx := range l.FinalityBranch {
+		enc.FinalityBranch[indx] = l.FinalityBranch[indx][:]
+	}
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientUpdateDeneb) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientUpdateDeneb
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.NextSyncCommittee = dec.NextSyncCommittee
+	l.NextSyncCommitteeBranch = make([][32]byte, len(dec.NextSyncCommitteeBranch))
+	for indx := range dec.NextSyncCommitteeBranch {
+		if err := copyFixedBytes(l.NextSyncCommitteeBranch[indx][:], dec.NextSyncCommitteeBranch[indx], "next_sync_committee_branch"); err != nil {
+			return err
+		}
+	}
+	l.FinalizedHeader = dec.FinalizedHeader
+	l.FinalityBranch = make([][32]byte, len(dec.FinalityBranch))
+	for indx := range dec.FinalityBranch {
+		if err := copyFixedBytes(l.FinalityBranch[indx][:], dec.FinalityBranch[indx], "finality_branch"); err != nil {
+			return err
+		}
+	}
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonDepositRequest struct {
+	Pubkey                jsonBytes  `json:"pubkey"`
+	WithdrawalCredentials jsonBytes  `json:"withdrawal_credentials"`
+	Amount                jsonUint64 `json:"amount"`
+	Signature             jsonBytes  `json:"signature"`
+	Index                 jsonUint64 `json:"index"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d *DepositRequest) MarshalJSON() ([]byte, error) {
+	enc := &jsonDepositRequest{}
+	enc.Pubkey = d.Pubkey[:]
+	enc.WithdrawalCredentials = d.WithdrawalCredentials[:]
+	enc.Amount = jsonUint64(d.Amount)
+	enc.Signature = d.Signature[:]
+	enc.Index = jsonUint64(d.Index)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *DepositRequest) UnmarshalJSON(data []byte) error {
+	var dec jsonDepositRequest
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(d.Pubkey[:], dec.Pubkey, "pubkey"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(d.WithdrawalCredentials[:], dec.WithdrawalCredentials, "withdrawal_credentials"); err != nil {
+		return err
+	}
+	d.Amount = uint64(dec.Amount)
+	if err := copyFixedBytes(d.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	d.Index = uint64(dec.Index)
+	return nil
+}
+
+type jsonWithdrawalRequest struct {
+	SourceAddress   jsonBytes  `json:"source_address"`
+	ValidatorPubkey jsonBytes  `json:"validator_pubkey"`
+	Amount          jsonUint64 `json:"amount"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (w *WithdrawalRequest) MarshalJSON() ([]byte, error) {
+	enc := &jsonWithdrawalRequest{}
+	enc.SourceAddress = w.SourceAddress[:]
+	enc.ValidatorPubkey = w.ValidatorPubkey[:]
+	enc.Amount = jsonUint64(w.Amount)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (w *WithdrawalRequest) UnmarshalJSON(data []byte) error {
+	var dec jsonWithdrawalRequest
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(w.SourceAddress[:], dec.SourceAddress, "source_address"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(w.ValidatorPubkey[:], dec.ValidatorPubkey, "validator_pubkey"); err != nil {
+		return err
+	}
+	w.Amount = uint64(dec.Amount)
+	return nil
+}
+
+type jsonConsolidationRequest struct {
+	SourceAddress jsonBytes `json:"source_address"`
+	SourcePubkey  jsonBytes `json:"source_pubkey"`
+	TargetPubkey  jsonBytes `json:"target_pubkey"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (c *ConsolidationRequest) MarshalJSON() ([]byte, error) {
+	enc := &jsonConsolidationRequest{}
+	enc.SourceAddress = c.SourceAddress[:]
+	enc.SourcePubkey = c.SourcePubkey[:]
+	enc.TargetPubkey = c.TargetPubkey[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (c *ConsolidationRequest) UnmarshalJSON(data []byte) error {
+	var dec jsonConsolidationRequest
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(c.SourceAddress[:], dec.SourceAddress, "source_address"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(c.SourcePubkey[:], dec.SourcePubkey, "source_pubkey"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(c.TargetPubkey[:], dec.TargetPubkey, "target_pubkey"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonExecutionRequests struct {
+	Deposits       []*DepositRequest       `json:"deposits"`
+	Withdrawals    []*WithdrawalRequest    `json:"withdrawals"`
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (e *ExecutionRequests) MarshalJSON() ([]byte, error) {
+	enc := &jsonExecutionRequests{}
+	enc.Deposits = jsonList(e.Deposits)
+	enc.Withdrawals = jsonList(e.Withdrawals)
+	enc.Consolidations = jsonList(e.Consolidations)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (e *ExecutionRequests) UnmarshalJSON(data []byte) error {
+	var dec jsonExecutionRequests
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	e.Deposits = dec.Deposits
+	e.Withdrawals = dec.Withdrawals
+	e.Consolidations = dec.Consolidations
+	return nil
+}
+
+type jsonPendingDeposit struct {
+	Pubkey                jsonBytes  `json:"pubkey"`
+	WithdrawalCredentials jsonBytes  `json:"withdrawal_credentials"`
+	Amount                jsonUint64 `json:"amount"`
+	Signature             jsonBytes  `json:"signature"`
+	Slot                  jsonUint64 `json:"slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *PendingDeposit) MarshalJSON() ([]byte, error) {
+	enc := &jsonPendingDeposit{}
+	enc.Pubkey = p.Pubkey[:]
+	enc.WithdrawalCredentials = p.WithdrawalCredentials[:]
+	enc.Amount = jsonUint64(p.Amount)
+	enc.Signature = p.Signature[:]
+	enc.Slot = jsonUint64(p.Slot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *PendingDeposit) UnmarshalJSON(data []byte) error {
+	var dec jsonPendingDeposit
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(p.Pubkey[:], dec.Pubkey, "pubkey"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(p.WithdrawalCredentials[:], dec.WithdrawalCredentials, "withdrawal_credentials"); err != nil {
+		return err
+	}
+	p.Amount = uint64(dec.Amount)
+	if err := copyFixedBytes(p.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	p.Slot = uint64(dec.Slot)
+	return nil
+}
+
+type jsonPendingPartialWithdrawal struct {
+	ValidatorIndex    jsonUint64 `json:"validator_index"`
+	Amount            jsonUint64 `json:"amount"`
+	WithdrawableEpoch jsonUint64 `json:"withdrawable_epoch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *PendingPartialWithdrawal) MarshalJSON() ([]byte, error) {
+	enc := &jsonPendingPartialWithdrawal{}
+	enc.ValidatorIndex = jsonUint64(p.ValidatorIndex)
+	enc.Amount = jsonUint64(p.Amount)
+	enc.WithdrawableEpoch = jsonUint64(p.WithdrawableEpoch)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *PendingPartialWithdrawal) UnmarshalJSON(data []byte) error {
+	var dec jsonPendingPartialWithdrawal
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	p.ValidatorIndex = uint64(dec.ValidatorIndex)
+	p.Amount = uint64(dec.Amount)
+	p.WithdrawableEpoch = uint64(dec.WithdrawableEpoch)
+	return nil
+}
+
+type jsonPendingConsolidation struct {
+	SourceIndex jsonUint64 `json:"source_index"`
+	TargetIndex jsonUint64 `json:"target_index"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *PendingConsolidation) MarshalJSON() ([]byte, error) {
+	enc := &jsonPendingConsolidation{}
+	enc.SourceIndex = jsonUint64(p.SourceIndex)
+	enc.TargetIndex = jsonUint64(p.TargetIndex)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (p *PendingConsolidation) UnmarshalJSON(data []byte) error {
+	var dec jsonPendingConsolidation
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	p.SourceIndex = uint64(dec.SourceIndex)
+	p.TargetIndex = uint64(dec.TargetIndex)
+	return nil
+}
+
+type jsonAttestationElectra struct {
+	AggregationBits jsonBytes        `json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	Signature       jsonBytes        `json:"signature"`
+	CommitteeBits   jsonBytes        `json:"committee_bits"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (a *AttestationElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonAttestationElectra{}
+	enc.AggregationBits = a.AggregationBits
+	enc.Data = jsonObj(a.Data)
+	enc.Signature = a.Signature[:]
+	enc.CommitteeBits = a.CommitteeBits[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (a *AttestationElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonAttestationElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	a.AggregationBits = dec.AggregationBits
+	a.Data = dec.Data
+	if err := copyFixedBytes(a.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(a.CommitteeBits[:], dec.CommitteeBits, "committee_bits"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSingleAttestation struct {
+	CommitteeIndex jsonUint64       `json:"committee_index"`
+	AttesterIndex  jsonUint64       `json:"attester_index"`
+	Data           *AttestationData `json:"data"`
+	Signature      jsonBytes        `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SingleAttestation) MarshalJSON() ([]byte, error) {
+	enc := &jsonSingleAttestation{}
+	enc.CommitteeIndex = jsonUint64(s.CommitteeIndex)
+	enc.AttesterIndex = jsonUint64(s.AttesterIndex)
+	enc.Data = jsonObj(s.Data)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SingleAttestation) UnmarshalJSON(data []byte) error {
+	var dec jsonSingleAttestation
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.CommitteeIndex = uint64(dec.CommitteeIndex)
+	s.AttesterIndex = uint64(dec.AttesterIndex)
+	s.Data = dec.Data
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonIndexedAttestationElectra struct {
+	AttestationIndices jsonUint64List   `json:"attesting_indices"`
+	Data               *AttestationData `json:"data"`
+	Signature          jsonBytes        `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (i *IndexedAttestationElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonIndexedAttestationElectra{}
+	enc.AttestationIndices = i.AttestationIndices
+	enc.Data = jsonObj(i.Data)
+	enc.Signature = i.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *IndexedAttestationElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonIndexedAttestationElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	i.AttestationIndices = dec.AttestationIndices
+	i.Data = dec.Data
+	if err := copyFixedBytes(i.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonAttesterSlashingElectra struct {
+	Attestation1 *IndexedAttestationElectra `json:"attestation_1"`
+	Attestation2 *IndexedAttestationElectra `json:"attestation_2"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (a *AttesterSlashingElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonAttesterSlashingElectra{}
+	enc.Attestation1 = jsonObj(a.Attestation1)
+	enc.Attestation2 = jsonObj(a.Attestation2)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (a *AttesterSlashingElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonAttesterSlashingElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	a.Attestation1 = dec.Attestation1
+	a.Attestation2 = dec.Attestation2
+	return nil
+}
+
+type jsonAggregateAndProofElectra struct {
+	Index          jsonUint64          `json:"aggregator_index"`
+	Aggregate      *AttestationElectra `json:"aggregate"`
+	SelectionProof jsonBytes           `json:"selection_proof"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (a *AggregateAndProofElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonAggregateAndProofElectra{}
+	enc.Index = jsonUint64(a.Index)
+	enc.Aggregate = jsonObj(a.Aggregate)
+	enc.SelectionProof = a.SelectionProof[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (a *AggregateAndProofElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonAggregateAndProofElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	a.Index = uint64(dec.Index)
+	a.Aggregate = dec.Aggregate
+	if err := copyFixedBytes(a.SelectionProof[:], dec.SelectionProof, "selection_proof"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonSignedAggregateAndProofElectra struct {
+	Message   *AggregateAndProofElectra `json:"message"`
+	Signature jsonBytes                 `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedAggregateAndProofElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedAggregateAndProofElectra{}
+	enc.Message = jsonObj(s.Message)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedAggregateAndProofElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedAggregateAndProofElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Message = dec.Message
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconStateElectra struct {
+	GenesisTime                   jsonUint64                   `json:"genesis_time"`
+	GenesisValidatorsRoot         jsonBytes                    `json:"genesis_validators_root"`
+	Slot                          jsonUint64                   `json:"slot"`
+	Fork                          *Fork                        `json:"fork"`
+	LatestBlockHeader             *BeaconBlockHeader           `json:"latest_block_header"`
+	BlockRoots                    jsonBytesList                `json:"block_roots"`
+	StateRoots                    jsonBytesList                `json:"state_roots"`
+	HistoricalRoots               jsonBytesList                `json:"historical_roots"`
+	Eth1Data                      *Eth1Data                    `json:"eth1_data"`
+	Eth1DataVotes                 []*Eth1Data                  `json:"eth1_data_votes"`
+	Eth1DepositIndex              jsonUint64                   `json:"eth1_deposit_index"`
+	Validators                    []*Validator                 `json:"validators"`
+	Balances                      jsonUint64List               `json:"balances"`
+	RandaoMixes                   jsonBytesList                `json:"randao_mixes"`
+	Slashings                     jsonUint64List               `json:"slashings"`
+	PreviousEpochParticipation    jsonUint8List                `json:"previous_epoch_participation"`
+	CurrentEpochParticipation     jsonUint8List                `json:"current_epoch_participation"`
+	JustificationBits             jsonBytes                    `json:"justification_bits"`
+	PreviousJustifiedCheckpoint   *Checkpoint                  `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint    *Checkpoint                  `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint           *Checkpoint                  `json:"finalized_checkpoint"`
+	InactivityScores              jsonUint64List               `json:"inactivity_scores"`
+	CurrentSyncCommittee          *SyncCommittee               `json:"current_sync_committee"`
+	NextSyncCommittee             *SyncCommittee               `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader  *ExecutionPayloadHeaderDeneb `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex           jsonUint64                   `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex  jsonUint64                   `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries           []*HistoricalSummary         `json:"historical_summaries"`
+	DepositRequestsStartIndex     jsonUint64                   `json:"deposit_requests_start_index"`
+	DepositBalanceToConsume       jsonUint64                   `json:"deposit_balance_to_consume"`
+	ExitBalanceToConsume          jsonUint64                   `json:"exit_balance_to_consume"`
+	EarliestExitEpoch             jsonUint64                   `json:"earliest_exit_epoch"`
+	ConsolidationBalanceToConsume jsonUint64                   `json:"consolidation_balance_to_consume"`
+	EarliestConsolidationEpoch    jsonUint64                   `json:"earliest_consolidation_epoch"`
+	PendingDeposits               []*PendingDeposit            `json:"pending_deposits"`
+	PendingPartialWithdrawals     []*PendingPartialWithdrawal  `json:"pending_partial_withdrawals"`
+	PendingConsolidations         []*PendingConsolidation      `json:"pending_consolidations"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconStateElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconStateElectra{}
+	enc.GenesisTime = jsonUint64(b.GenesisTime)
+	enc.GenesisValidatorsRoot = b.GenesisValidatorsRoot[:]
+	enc.Slot = jsonUint64(b.Slot)
+	enc.Fork = jsonObj(b.Fork)
+	enc.LatestBlockHeader = jsonObj(b.LatestBlockHeader)
+	enc.BlockRoots = make(jsonBytesList, len(b.BlockRoots))
+	for indx := range b.BlockRoots {
+		enc.BlockRoots[indx] = b.BlockRoots[indx][:]
+	}
+	enc.StateRoots = make(jsonBytesList, len(b.StateRoots))
+	for indx := range b.StateRoots {
+		enc.StateRoots[indx] = b.StateRoots[indx][:]
+	}
+	enc.HistoricalRoots = b.HistoricalRoots
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Eth1DataVotes = jsonList(b.Eth1DataVotes)
+	enc.Eth1DepositIndex = jsonUint64(b.Eth1DepositIndex)
+	enc.Validators = jsonList(b.Validators)
+	enc.Balances = b.Balances
+	enc.RandaoMixes = make(jsonBytesList, len(b.RandaoMixes))
+	for indx := range b.RandaoMixes {
+		enc.RandaoMixes[indx] = b.RandaoMixes[indx][:]
+	}
+	enc.Slashings = b.Slashings
+	enc.PreviousEpochParticipation = b.PreviousEpochParticipation
+	enc.CurrentEpochParticipation = b.CurrentEpochParticipation
+	enc.JustificationBits = b.JustificationBits[:]
+	enc.PreviousJustifiedCheckpoint = jsonObj(b.PreviousJustifiedCheckpoint)
+	enc.CurrentJustifiedCheckpoint = jsonObj(b.CurrentJustifiedCheckpoint)
+	enc.FinalizedCheckpoint = jsonObj(b.FinalizedCheckpoint)
+	enc.InactivityScores = b.InactivityScores
+	enc.CurrentSyncCommittee = jsonObj(b.CurrentSyncCommittee)
+	enc.NextSyncCommittee = jsonObj(b.NextSyncCommittee)
+	enc.LatestExecutionPayloadHeader = jsonObj(b.LatestExecutionPayloadHeader)
+	enc.NextWithdrawalIndex = jsonUint64(b.NextWithdrawalIndex)
+	enc.NextWithdrawalValidatorIndex = jsonUint64(b.NextWithdrawalValidatorIndex)
+	enc.HistoricalSummaries = jsonList(b.HistoricalSummaries)
+	enc.DepositRequestsStartIndex = jsonUint64(b.DepositRequestsStartIndex)
+	enc.DepositBalanceToConsume = jsonUint64(b.DepositBalanceToConsume)
+	enc.ExitBalanceToConsume = jsonUint64(b.ExitBalanceToConsume)
+	enc.EarliestExitEpoch = jsonUint64(b.EarliestExitEpoch)
+	enc.ConsolidationBalanceToConsume = jsonUint64(b.ConsolidationBalanceToConsume)
+	enc.EarliestConsolidationEpoch = jsonUint64(b.EarliestConsolidationEpoch)
+	enc.PendingDeposits = jsonList(b.PendingDeposits)
+	enc.PendingPartialWithdrawals = jsonList(b.PendingPartialWithdrawals)
+	enc.PendingConsolidations = jsonList(b.PendingConsolidations)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconStateElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconStateElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.GenesisTime = uint64(dec.GenesisTime)
+	if err := copyFixedBytes(b.GenesisValidatorsRoot[:], dec.GenesisValidatorsRoot, "genesis_validators_root"); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.Fork = dec.Fork
+	b.LatestBlockHeader = dec.LatestBlockHeader
+	if err := checkVectorLength(len(b.BlockRoots), len(dec.BlockRoots), "block_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.BlockRoots {
+		if err := copyFixedBytes(b.BlockRoots[indx][:], dec.BlockRoots[indx], "block_roots"); err != nil {
+			return err
+		}
+	}
+	if err := checkVectorLength(len(b.StateRoots), len(dec.StateRoots), "state_roots"); err != nil {
+		return err
+	}
+	for indx := range dec.StateRoots {
+		if err := copyFixedBytes(b.StateRoots[indx][:], dec.StateRoots[indx], "state_roots"); err != nil {
+			return err
+		}
+	}
+	b.HistoricalRoots = dec.HistoricalRoots
+	b.Eth1Data = dec.Eth1Data
+	b.Eth1DataVotes = dec.Eth1DataVotes
+	b.Eth1DepositIndex = uint64(dec.Eth1DepositIndex)
+	b.Validators = dec.Validators
+	b.Balances = dec.Balances
+	if err := checkVectorLength(len(b.RandaoMixes), len(dec.RandaoMixes), "randao_mixes"); err != nil {
+		return err
+	}
+	for indx := range dec.RandaoMixes {
+		if err := copyFixedBytes(b.RandaoMixes[indx][:], dec.RandaoMixes[indx], "randao_mixes"); err != nil {
+			return err
+		}
+	}
+	b.Slashings = dec.Slashings
+	b.PreviousEpochParticipation = dec.PreviousEpochParticipation
+	b.CurrentEpochParticipation = dec.CurrentEpochParticipation
+	if err := copyFixedBytes(b.JustificationBits[:], dec.JustificationBits, "justification_bits"); err != nil {
+		return err
+	}
+	b.PreviousJustifiedCheckpoint = dec.PreviousJustifiedCheckpoint
+	b.CurrentJustifiedCheckpoint = dec.CurrentJustifiedCheckpoint
+	b.FinalizedCheckpoint = dec.FinalizedCheckpoint
+	b.InactivityScores = dec.InactivityScores
+	b.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	b.NextSyncCommittee = dec.NextSyncCommittee
+	b.LatestExecutionPayloadHeader = dec.LatestExecutionPayloadHeader
+	b.NextWithdrawalIndex = uint64(dec.NextWithdrawalIndex)
+	b.NextWithdrawalValidatorIndex = uint64(dec.NextWithdrawalValidatorIndex)
+	b.HistoricalSummaries = dec.HistoricalSummaries
+	b.DepositRequestsStartIndex = uint64(dec.DepositRequestsStartIndex)
+	b.DepositBalanceToConsume = uint64(dec.DepositBalanceToConsume)
+	b.ExitBalanceToConsume = uint64(dec.ExitBalanceToConsume)
+	b.EarliestExitEpoch = uint64(dec.EarliestExitEpoch)
+	b.ConsolidationBalanceToConsume = uint64(dec.ConsolidationBalanceToConsume)
+	b.EarliestConsolidationEpoch = uint64(dec.EarliestConsolidationEpoch)
+	b.PendingDeposits = dec.PendingDeposits
+	b.PendingPartialWithdrawals = dec.PendingPartialWithdrawals
+	b.PendingConsolidations = dec.PendingConsolidations
+	return nil
+}
+
+type jsonSignedBeaconBlockElectra struct {
+	Block     *BeaconBlockElectra `json:"message"`
+	Signature jsonBytes           `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBeaconBlockElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBeaconBlockElectra{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBeaconBlockElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBeaconBlockElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBeaconBlockElectra struct {
+	Slot          jsonUint64              `json:"slot"`
+	ProposerIndex jsonUint64              `json:"proposer_index"`
+	ParentRoot    jsonBytes               `json:"parent_root"`
+	StateRoot     jsonBytes               `json:"state_root"`
+	Body          *BeaconBlockBodyElectra `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockElectra{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBeaconBlockBodyElectra struct {
+	RandaoReveal          jsonBytes                     `json:"randao_reveal"`
+	Eth1Data              *Eth1Data                     `json:"eth1_data"`
+	Graffiti              jsonBytes                     `json:"graffiti"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings"`
+	AttesterSlashings     []*AttesterSlashingElectra    `json:"attester_slashings"`
+	Attestations          []*AttestationElectra         `json:"attestations"`
+	Deposits              []*Deposit                    `json:"deposits"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayloadDeneb        `json:"execution_payload"`
+	BlsToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+	BlobKzgCommitments    jsonBytesList                 `json:"blob_kzg_commitments"`
+	ExecutionRequests     *ExecutionRequests            `json:"execution_requests"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BeaconBlockBodyElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonBeaconBlockBodyElectra{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	enc.ExecutionPayload = jsonObj(b.ExecutionPayload)
+	enc.BlsToExecutionChanges = jsonList(b.BlsToExecutionChanges)
+	enc.BlobKzgCommitments = make(jsonBytesList, len(b.BlobKzgCommitments))
+	for indx := range b.BlobKzgCommitments {
+		enc.BlobKzgCommitments[indx] = b.BlobKzgCommitments[indx][:]
+	}
+	enc.ExecutionRequests = jsonObj(b.ExecutionRequests)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BeaconBlockBodyElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonBeaconBlockBodyElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	b.ExecutionPayload = dec.ExecutionPayload
+	b.BlsToExecutionChanges = dec.BlsToExecutionChanges
+	b.BlobKzgCommitments = make([][48]byte, len(dec.BlobKzgCommitments))
+	for indx := range dec.BlobKzgCommitments {
+		if err := copyFixedBytes(b.BlobKzgCommitments[indx][:], dec.BlobKzgCommitments[indx], "blob_kzg_commitments"); err != nil {
+			return err
+		}
+	}
+	b.ExecutionRequests = dec.ExecutionRequests
+	return nil
+}
+
+type jsonSignedBlindedBeaconBlockElectra struct {
+	Block     *BlindedBeaconBlockElectra `json:"message"`
+	Signature jsonBytes                  `json:"signature"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *SignedBlindedBeaconBlockElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonSignedBlindedBeaconBlockElectra{}
+	enc.Block = jsonObj(s.Block)
+	enc.Signature = s.Signature[:]
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (s *SignedBlindedBeaconBlockElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonSignedBlindedBeaconBlockElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	s.Block = dec.Block
+	if err := copyFixedBytes(s.Signature[:], dec.Signature, "signature"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type jsonBlindedBeaconBlockElectra struct {
+	Slot          jsonUint64                     `json:"slot"`
+	ProposerIndex jsonUint64                     `json:"proposer_index"`
+	ParentRoot    jsonBytes                      `json:"parent_root"`
+	StateRoot     jsonBytes                      `json:"state_root"`
+	Body          *BlindedBeaconBlockBodyElectra `json:"body"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlindedBeaconBlockElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlindedBeaconBlockElectra{}
+	enc.Slot = jsonUint64(b.Slot)
+	enc.ProposerIndex = jsonUint64(b.ProposerIndex)
+	enc.ParentRoot = b.ParentRoot[:]
+	enc.StateRoot = b.StateRoot[:]
+	enc.Body = jsonObj(b.Body)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlindedBeaconBlockElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonBlindedBeaconBlockElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	b.Slot = uint64(dec.Slot)
+	b.ProposerIndex = uint64(dec.ProposerIndex)
+	if err := copyFixedBytes(b.ParentRoot[:], dec.ParentRoot, "parent_root"); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.StateRoot[:], dec.StateRoot, "state_root"); err != nil {
+		return err
+	}
+	b.Body = dec.Body
+	return nil
+}
+
+type jsonBlindedBeaconBlockBodyElectra struct {
+	RandaoReveal           jsonBytes                     `json:"randao_reveal"`
+	Eth1Data               *Eth1Data                     `json:"eth1_data"`
+	Graffiti               jsonBytes                     `json:"graffiti"`
+	ProposerSlashings      []*ProposerSlashing           `json:"proposer_slashings"`
+	AttesterSlashings      []*AttesterSlashingElectra    `json:"attester_slashings"`
+	Attestations           []*AttestationElectra         `json:"attestations"`
+	Deposits               []*Deposit                    `json:"deposits"`
+	VoluntaryExits         []*SignedVoluntaryExit        `json:"voluntary_exits"`
+	SyncAggregate          *SyncAggregate                `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeaderDeneb  `json:"execution_payload_header"`
+	BlsToExecutionChanges  []*SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+	BlobKzgCommitments     jsonBytesList                 `json:"blob_kzg_commitments"`
+	ExecutionRequests      *ExecutionRequests            `json:"execution_requests"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *BlindedBeaconBlockBodyElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonBlindedBeaconBlockBodyElectra{}
+	enc.RandaoReveal = b.RandaoReveal[:]
+	enc.Eth1Data = jsonObj(b.Eth1Data)
+	enc.Graffiti = b.Graffiti[:]
+	enc.ProposerSlashings = jsonList(b.ProposerSlashings)
+	enc.AttesterSlashings = jsonList(b.AttesterSlashings)
+	enc.Attestations = jsonList(b.Attestations)
+	enc.Deposits = jsonList(b.Deposits)
+	enc.VoluntaryExits = jsonList(b.VoluntaryExits)
+	enc.SyncAggregate = jsonObj(b.SyncAggregate)
+	enc.ExecutionPayloadHeader = jsonObj(b.ExecutionPayloadHeader)
+	enc.BlsToExecutionChanges = jsonList(b.BlsToExecutionChanges)
+	enc.BlobKzgCommitments = make(jsonBytesList, len(b.BlobKzgCommitments))
+	for indx := range b.BlobKzgCommitments {
+		enc.BlobKzgCommitments[indx] = b.BlobKzgCommitments[indx][:]
+	}
+	enc.ExecutionRequests = jsonObj(b.ExecutionRequests)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BlindedBeaconBlockBodyElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonBlindedBeaconBlockBodyElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	if err := copyFixedBytes(b.RandaoReveal[:], dec.RandaoReveal, "randao_reveal"); err != nil {
+		return err
+	}
+	b.Eth1Data = dec.Eth1Data
+	if err := copyFixedBytes(b.Graffiti[:], dec.Graffiti, "graffiti"); err != nil {
+		return err
+	}
+	b.ProposerSlashings = dec.ProposerSlashings
+	b.AttesterSlashings = dec.AttesterSlashings
+	b.Attestations = dec.Attestations
+	b.Deposits = dec.Deposits
+	b.VoluntaryExits = dec.VoluntaryExits
+	b.SyncAggregate = dec.SyncAggregate
+	b.ExecutionPayloadHeader = dec.ExecutionPayloadHeader
+	b.BlsToExecutionChanges = dec.BlsToExecutionChanges
+	b.BlobKzgCommitments = make([][48]byte, len(dec.BlobKzgCommitments))
+	for indx := range dec.BlobKzgCommitments {
+		if err := copyFixedBytes(b.BlobKzgCommitments[indx][:], dec.BlobKzgCommitments[indx], "blob_kzg_commitments"); err != nil {
+			return err
+		}
+	}
+	b.ExecutionRequests = dec.ExecutionRequests
+	return nil
+}
+
+type jsonLightClientBootstrapElectra struct {
+	Header                     *LightClientHeaderDeneb `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee          `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch jsonBytesList           `json:"current_sync_committee_branch"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientBootstrapElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientBootstrapElectra{}
+	enc.Header = jsonObj(l.Header)
+	enc.CurrentSyncCommittee = jsonObj(l.CurrentSyncCommittee)
+	enc.CurrentSyncCommitteeBranch = make(jsonBytesList, len(l.CurrentSyncCommitteeBranch))
+	for indx := range l.CurrentSyncCommitteeBranch {
+		enc.CurrentSyncCommitteeBranch[indx] = l.CurrentSyncCommitteeBranch[indx][:]
+	}
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientBootstrapElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientBootstrapElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.Header = dec.Header
+	l.CurrentSyncCommittee = dec.CurrentSyncCommittee
+	l.CurrentSyncCommitteeBranch = make([][32]byte, len(dec.CurrentSyncCommitteeBranch))
+	for indx := range dec.CurrentSyncCommitteeBranch {
+		if err := copyFixedBytes(l.CurrentSyncCommitteeBranch[indx][:], dec.CurrentSyncCommitteeBranch[indx], "current_sync_committee_branch"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonLightClientFinalityUpdateElectra struct {
+	AttestedHeader  *LightClientHeaderDeneb `json:"attested_header"`
+	FinalizedHeader *LightClientHeaderDeneb `json:"finalized_header"`
+	FinalityBranch  jsonBytesList           `json:"finality_branch"`
+	SyncAggregate   *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot   jsonUint64              `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientFinalityUpdateElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientFinalityUpdateElectra{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.FinalizedHeader = jsonObj(l.FinalizedHeader)
+	enc.FinalityBranch = make(jsonBytesList, len(l.FinalityBranch))
+	for indx := range l.FinalityBranch {
+		enc.FinalityBranch[indx] = l.FinalityBranch[indx][:]
+	}
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientFinalityUpdateElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientFinalityUpdateElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.FinalizedHeader = dec.FinalizedHeader
+	l.FinalityBranch = make([][32]byte, len(dec.FinalityBranch))
+	for indx := range dec.FinalityBranch {
+		if err := copyFixedBytes(l.FinalityBranch[indx][:], dec.FinalityBranch[indx], "finality_branch"); err != nil {
+			return err
+		}
+	}
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
+
+type jsonLightClientUpdateElectra struct {
+	AttestedHeader          *LightClientHeaderDeneb `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee          `json:"next_sync_committee"`
+	NextSyncCommitteeBranch jsonBytesList           `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeaderDeneb `json:"finalized_header"`
+	FinalityBranch          jsonBytesList           `json:"finality_branch"`
+	SyncAggregate           *SyncAggregate          `json:"sync_aggregate"`
+	SignatureSlot           jsonUint64              `json:"signature_slot"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *LightClientUpdateElectra) MarshalJSON() ([]byte, error) {
+	enc := &jsonLightClientUpdateElectra{}
+	enc.AttestedHeader = jsonObj(l.AttestedHeader)
+	enc.NextSyncCommittee = jsonObj(l.NextSyncCommittee)
+	enc.NextSyncCommitteeBranch = make(jsonBytesList, len(l.NextSyncCommitteeBranch))
+	for indx := range l.NextSyncCommitteeBranch {
+		enc.NextSyncCommitteeBranch[indx] = l.NextSyncCommitteeBranch[indx][:]
+	}
+	enc.FinalizedHeader = jsonObj(l.FinalizedHeader)
+	enc.FinalityBranch = make(jsonBytesList, len(l.FinalityBranch))
+	for indx := range l.FinalityBranch {
+		enc.FinalityBranch[indx] = l.FinalityBranch[indx][:]
+	}
+	enc.SyncAggregate = jsonObj(l.SyncAggregate)
+	enc.SignatureSlot = jsonUint64(l.SignatureSlot)
+	return json.Marshal(enc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (l *LightClientUpdateElectra) UnmarshalJSON(data []byte) error {
+	var dec jsonLightClientUpdateElectra
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return err
+	}
+	l.AttestedHeader = dec.AttestedHeader
+	l.NextSyncCommittee = dec.NextSyncCommittee
+	l.NextSyncCommitteeBranch = make([][32]byte, len(dec.NextSyncCommitteeBranch))
+	for indx := range dec.NextSyncCommitteeBranch {
+		if err := copyFixedBytes(l.NextSyncCommitteeBranch[indx][:], dec.NextSyncCommitteeBranch[indx], "next_sync_committee_branch"); err != nil {
+			return err
+		}
+	}
+	l.FinalizedHeader = dec.FinalizedHeader
+	l.FinalityBranch = make([][32]byte, len(dec.FinalityBranch))
+	for indx := range dec.FinalityBranch {
+		if err := copyFixedBytes(l.FinalityBranch[indx][:], dec.FinalityBranch[indx], "finality_branch"); err != nil {
+			return err
+		}
+	}
+	l.SyncAggregate = dec.SyncAggregate
+	l.SignatureSlot = uint64(dec.SignatureSlot)
+	return nil
+}
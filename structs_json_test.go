@@ -0,0 +1,238 @@
+package consensus
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// yamlToJSON converts a yaml value of the spec tests to its json
+// encoding in the beacon api (i.e. integers are quoted strings).
+func yamlToJSON(raw []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return nil, err
+	}
+	obj, err := yamlNodeValue(&node)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+func yamlNodeValue(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		return yamlNodeValue(node.Content[0])
+
+	case yaml.MappingNode:
+		obj := map[string]interface{}{}
+		for i := 0; i < len(node.Content); i += 2 {
+			val, err := yamlNodeValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			obj[node.Content[i].Value] = val
+		}
+		return obj, nil
+
+	case yaml.SequenceNode:
+		list := []interface{}{}
+		for _, elem := range node.Content {
+			val, err := yamlNodeValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, val)
+		}
+		return list, nil
+
+	case yaml.ScalarNode:
+		if node.Tag == "!!bool" {
+			return node.Value == "true", nil
+		}
+		// integers are quoted and bytes are already hex strings
+		return node.Value, nil
+
+	default:
+		return nil, fmt.Errorf("yaml node %d not supported", node.Kind)
+	}
+}
+
+func jsonEqual(a, b []byte) (bool, error) {
+	var objA, objB interface{}
+	if err := json.Unmarshal(a, &objA); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(b, &objB); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(objA, objB), nil
+}
+
+// checkJSONEncoding checks the json encoding of the object against its yaml value
+// in the spec tests. The empty object is used to decode the json value.
+func checkJSONEncoding(obj, empty interface{}, value []byte) error {
+	expected, err := yamlToJSON(value)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(expected, empty); err != nil {
+		return fmt.Errorf("failed to unmarshal: %v", err)
+	}
+	if !equalObj(obj, empty) {
+		return fmt.Errorf("bad json unmarshalling")
+	}
+
+	found, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %v", err)
+	}
+	equal, err := jsonEqual(expected, found)
+	if err != nil {
+		return err
+	}
+	if !equal {
+		return fmt.Errorf("bad json marshalling: %s", string(found))
+	}
+	return nil
+}
+
+func hexStr(b byte, size int) string {
+	return "0x" + strings.Repeat(fmt.Sprintf("%02x", b), size)
+}
+
+func TestJSON_Attestation(t *testing.T) {
+	raw := `{
+		"aggregation_bits": "0x0103",
+		"data": {
+			"slot": "10",
+			"index": "1",
+			"beacon_block_root": "` + hexStr(1, 32) + `",
+			"source": {"epoch": "1", "root": "` + hexStr(2, 32) + `"},
+			"target": {"epoch": "2", "root": "` + hexStr(3, 32) + `"}
+		},
+		"signature": "` + hexStr(4, 96) + `"
+	}`
+
+	var obj Attestation
+	require.NoError(t, json.Unmarshal([]byte(raw), &obj))
+
+	assert.Equal(t, []byte{0x1, 0x3}, obj.AggregationBits)
+	assert.Equal(t, uint64(10), obj.Data.Slot)
+	assert.Equal(t, byte(0x1), obj.Data.BeaconBlockHash[31])
+	assert.Equal(t, uint64(2), obj.Data.Target.Epoch)
+	assert.Equal(t, byte(0x4), obj.Signature[95])
+
+	data, err := json.Marshal(&obj)
+	require.NoError(t, err)
+	assert.JSONEq(t, raw, string(data))
+}
+
+func TestJSON_SpecValue(t *testing.T) {
+	// yaml value as in the spec tests
+	value := `
+parent_hash: '` + hexStr(1, 32) + `'
+fee_recipient: '` + hexStr(2, 20) + `'
+state_root: '` + hexStr(3, 32) + `'
+receipts_root: '` + hexStr(4, 32) + `'
+logs_bloom: '` + hexStr(5, 256) + `'
+prev_randao: '` + hexStr(6, 32) + `'
+block_number: 1
+gas_limit: 18446744073709551615
+gas_used: 3
+timestamp: 4
+extra_data: '0x0102'
+base_fee_per_gas: 115792089237316195423570985008687907853269984665640564039457584007913129639935
+block_hash: '` + hexStr(7, 32) + `'
+transactions: ['0x01', '0x', '0x020304']
+withdrawals:
+- {index: 1, validator_index: 2, address: '` + hexStr(8, 20) + `', amount: 3}
+`
+
+	obj := &ExecutionPayloadCapella{
+		BlockNumber:  1,
+		GasLimit:     ^uint64(0),
+		GasUsed:      3,
+		Timestamp:    4,
+		ExtraData:    []byte{0x1, 0x2},
+		Transactions: [][]byte{{0x1}, {}, {0x2, 0x3, 0x4}},
+		Withdrawals:  []*Withdrawal{{Index: 1, ValidatorIndex: 2, Amount: 3}},
+	}
+	fill := func(b []byte, val byte) {
+		for i := range b {
+			b[i] = val
+		}
+	}
+	fill(obj.ParentHash[:], 1)
+	fill(obj.FeeRecipient[:], 2)
+	fill(obj.StateRoot[:], 3)
+	fill(obj.ReceiptsRoot[:], 4)
+	fill(obj.LogsBloom[:], 5)
+	fill(obj.PrevRandao[:], 6)
+	fill(obj.BlockHash[:], 7)
+	fill(obj.Withdrawals[0].Address[:], 8)
+	fill(obj.BaseFeePerGas[:], 0xff)
+
+	require.NoError(t, checkJSONEncoding(obj, new(ExecutionPayloadCapella), []byte(value)))
+}
+
+func TestJSON_EmptyValues(t *testing.T) {
+	// nil containers and lists are encoded as empty ones
+	data, err := json.Marshal(&BeaconBlockBodyAltair{})
+	require.NoError(t, err)
+
+	var obj map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &obj))
+
+	assert.Equal(t, []interface{}{}, obj["attestations"])
+	assert.Equal(t, "0", obj["eth1_data"].(map[string]interface{})["deposit_count"])
+	assert.Equal(t, hexStr(0, 64), obj["sync_aggregate"].(map[string]interface{})["sync_committee_bits"])
+
+	// the participation flags are encoded as a list of integers
+	state := &BeaconStateAltair{PreviousEpochParticipation: []byte{0x1, 0x7}}
+	data, err = json.Marshal(state)
+	require.NoError(t, err)
+
+	var stateObj struct {
+		Participation []string `json:"previous_epoch_participation"`
+		Current       []string `json:"current_epoch_participation"`
+		BlockRoots    []string `json:"block_roots"`
+	}
+	require.NoError(t, json.Unmarshal(data, &stateObj))
+	assert.Equal(t, []string{"1", "7"}, stateObj.Participation)
+	assert.Equal(t, []string{}, stateObj.Current)
+	assert.Len(t, stateObj.BlockRoots, 8192)
+
+	state2 := &BeaconStateAltair{}
+	require.NoError(t, json.Unmarshal(data, state2))
+	assert.True(t, state.Equal(state2))
+}
+
+func TestJSON_DecodeErrors(t *testing.T) {
+	cases := []string{
+		// integers must be quoted
+		`{"epoch": 1, "root": "` + hexStr(0, 32) + `"}`,
+		// bytes must be 0x prefixed
+		`{"epoch": "1", "root": "` + strings.Repeat("00", 32) + `"}`,
+		// incorrect length of the byte array
+		`{"epoch": "1", "root": "` + hexStr(0, 31) + `"}`,
+		// negative integer
+		`{"epoch": "-1", "root": "` + hexStr(0, 32) + `"}`,
+	}
+	for _, c := range cases {
+		var obj Checkpoint
+		assert.Error(t, json.Unmarshal([]byte(c), &obj), c)
+	}
+
+	// vectors must have all the elements
+	var obj HistoricalBatch
+	assert.Error(t, json.Unmarshal([]byte(`{"block_roots": ["`+hexStr(0, 32)+`"], "state_roots": []}`), &obj))
+}
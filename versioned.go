@@ -0,0 +1,516 @@
+package consensus
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldNotFoundError is returned when an object of a given fork
+// does not include the requested field
+type FieldNotFoundError struct {
+	Fork  ForkName
+	Field string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return fmt.Sprintf("field '%s' not found in fork '%s'", e.Field, e.Fork)
+}
+
+func errFieldNotFound(fork ForkName, field string) error {
+	return &FieldNotFoundError{Fork: fork, Field: field}
+}
+
+func errUnknownType(obj interface{}) error {
+	return fmt.Errorf("unknown type %T", obj)
+}
+
+func isNil(obj interface{}) bool {
+	if obj == nil {
+		return true
+	}
+	v := reflect.ValueOf(obj)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// VersionedSignedBeaconBlock wraps a signed beacon block of any fork
+// and exposes fork agnostic accessors
+type VersionedSignedBeaconBlock struct {
+	SignedBeaconBlock
+}
+
+// Version returns the fork of the block
+func (v *VersionedSignedBeaconBlock) Version() (ForkName, error) {
+	switch v.SignedBeaconBlock.(type) {
+	case *SignedBeaconBlockPhase0:
+		return ForkPhase0, nil
+	case *SignedBeaconBlockAltair:
+		return ForkAltair, nil
+	case *SignedBeaconBlockBellatrix:
+		return ForkBellatrix, nil
+	case *SignedBeaconBlockCapella:
+		return ForkCapella, nil
+	case *SignedBeaconBlockDeneb:
+		return ForkDeneb, nil
+	case *SignedBeaconBlockElectra:
+		return ForkElectra, nil
+	default:
+		return "", errUnknownType(v.SignedBeaconBlock)
+	}
+}
+
+// Message returns the unsigned beacon block
+func (v *VersionedSignedBeaconBlock) Message() (*VersionedBeaconBlock, error) {
+	var block BeaconBlock
+
+	switch obj := v.SignedBeaconBlock.(type) {
+	case *SignedBeaconBlockPhase0:
+		block = obj.Block
+	case *SignedBeaconBlockAltair:
+		block = obj.Block
+	case *SignedBeaconBlockBellatrix:
+		block = obj.Block
+	case *SignedBeaconBlockCapella:
+		block = obj.Block
+	case *SignedBeaconBlockDeneb:
+		block = obj.Block
+	case *SignedBeaconBlockElectra:
+		block = obj.Block
+	default:
+		return nil, errUnknownType(v.SignedBeaconBlock)
+	}
+	if isNil(block) {
+		return nil, fmt.Errorf("block message not set")
+	}
+	return &VersionedBeaconBlock{BeaconBlock: block}, nil
+}
+
+// Signature returns the signature of the block
+func (v *VersionedSignedBeaconBlock) Signature() (Signature, error) {
+	switch obj := v.SignedBeaconBlock.(type) {
+	case *SignedBeaconBlockPhase0:
+		return obj.Signature, nil
+	case *SignedBeaconBlockAltair:
+		return obj.Signature, nil
+	case *SignedBeaconBlockBellatrix:
+		return obj.Signature, nil
+	case *SignedBeaconBlockCapella:
+		return obj.Signature, nil
+	case *SignedBeaconBlockDeneb:
+		return obj.Signature, nil
+	case *SignedBeaconBlockElectra:
+		return obj.Signature, nil
+	default:
+		return Signature{}, errUnknownType(v.SignedBeaconBlock)
+	}
+}
+
+// Root returns the hash tree root of the unsigned block
+func (v *VersionedSignedBeaconBlock) Root() (Root, error) {
+	block, err := v.Message()
+	if err != nil {
+		return Root{}, err
+	}
+	root, err := block.HashTreeRoot()
+	if err != nil {
+		return Root{}, err
+	}
+	return root, nil
+}
+
+// Slot returns the slot of the block
+func (v *VersionedSignedBeaconBlock) Slot() (uint64, error) {
+	block, err := v.Message()
+	if err != nil {
+		return 0, err
+	}
+	return block.Slot()
+}
+
+// ProposerIndex returns the proposer index of the block
+func (v *VersionedSignedBeaconBlock) ProposerIndex() (uint64, error) {
+	block, err := v.Message()
+	if err != nil {
+		return 0, err
+	}
+	return block.ProposerIndex()
+}
+
+// ParentRoot returns the parent root of the block
+func (v *VersionedSignedBeaconBlock) ParentRoot() (Root, error) {
+	block, err := v.Message()
+	if err != nil {
+		return Root{}, err
+	}
+	return block.ParentRoot()
+}
+
+// StateRoot returns the state root of the block
+func (v *VersionedSignedBeaconBlock) StateRoot() (Root, error) {
+	block, err := v.Message()
+	if err != nil {
+		return Root{}, err
+	}
+	return block.StateRoot()
+}
+
+// BodyRoot returns the hash tree root of the block body
+func (v *VersionedSignedBeaconBlock) BodyRoot() (Root, error) {
+	block, err := v.Message()
+	if err != nil {
+		return Root{}, err
+	}
+	return block.BodyRoot()
+}
+
+// Attestations returns the attestations of a block before Electra
+func (v *VersionedSignedBeaconBlock) Attestations() ([]*Attestation, error) {
+	block, err := v.Message()
+	if err != nil {
+		return nil, err
+	}
+	return block.Attestations()
+}
+
+// AttestationsElectra returns the attestations of a block since Electra
+func (v *VersionedSignedBeaconBlock) AttestationsElectra() ([]*AttestationElectra, error) {
+	block, err := v.Message()
+	if err != nil {
+		return nil, err
+	}
+	return block.AttestationsElectra()
+}
+
+// ExecutionPayload returns the execution payload of the block
+func (v *VersionedSignedBeaconBlock) ExecutionPayload() (Payload, error) {
+	block, err := v.Message()
+	if err != nil {
+		return nil, err
+	}
+	return block.ExecutionPayload()
+}
+
+// ExecutionPayloadHeader returns the header of the execution payload of the block
+func (v *VersionedSignedBeaconBlock) ExecutionPayloadHeader() (PayloadHeader, error) {
+	block, err := v.Message()
+	if err != nil {
+		return nil, err
+	}
+	return block.ExecutionPayloadHeader()
+}
+
+// VersionedBeaconBlock wraps a beacon block of any fork
+// and exposes fork agnostic accessors
+type VersionedBeaconBlock struct {
+	BeaconBlock
+}
+
+type blockFields struct {
+	version       ForkName
+	slot          uint64
+	proposerIndex uint64
+	parentRoot    Root
+	stateRoot     Root
+	body          sszObject
+}
+
+func (v *VersionedBeaconBlock) fields() (*blockFields, error) {
+	switch obj := v.BeaconBlock.(type) {
+	case *BeaconBlockPhase0:
+		return &blockFields{ForkPhase0, obj.Slot, obj.ProposerIndex, obj.ParentRoot, obj.StateRoot, obj.Body}, nil
+	case *BeaconBlockAltair:
+		return &blockFields{ForkAltair, obj.Slot, obj.ProposerIndex, obj.ParentRoot, obj.StateRoot, obj.Body}, nil
+	case *BeaconBlockBellatrix:
+		return &blockFields{ForkBellatrix, obj.Slot, obj.ProposerIndex, obj.ParentRoot, obj.StateRoot, obj.Body}, nil
+	case *BeaconBlockCapella:
+		return &blockFields{ForkCapella, obj.Slot, obj.ProposerIndex, obj.ParentRoot, obj.StateRoot, obj.Body}, nil
+	case *BeaconBlockDeneb:
+		return &blockFields{ForkDeneb, obj.Slot, obj.ProposerIndex, obj.ParentRoot, obj.StateRoot, obj.Body}, nil
+	case *BeaconBlockElectra:
+		return &blockFields{ForkElectra, obj.Slot, obj.ProposerIndex, obj.ParentRoot, obj.StateRoot, obj.Body}, nil
+	default:
+		return nil, errUnknownType(v.BeaconBlock)
+	}
+}
+
+// Version returns the fork of the block
+func (v *VersionedBeaconBlock) Version() (ForkName, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return "", err
+	}
+	return fields.version, nil
+}
+
+// Slot returns the slot of the block
+func (v *VersionedBeaconBlock) Slot() (uint64, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return 0, err
+	}
+	return fields.slot, nil
+}
+
+// ProposerIndex returns the proposer index of the block
+func (v *VersionedBeaconBlock) ProposerIndex() (uint64, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return 0, err
+	}
+	return fields.proposerIndex, nil
+}
+
+// ParentRoot returns the parent root of the block
+func (v *VersionedBeaconBlock) ParentRoot() (Root, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return Root{}, err
+	}
+	return fields.parentRoot, nil
+}
+
+// StateRoot returns the state root of the block
+func (v *VersionedBeaconBlock) StateRoot() (Root, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return Root{}, err
+	}
+	return fields.stateRoot, nil
+}
+
+// BodyRoot returns the hash tree root of the block body
+func (v *VersionedBeaconBlock) BodyRoot() (Root, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return Root{}, err
+	}
+	if isNil(fields.body) {
+		return Root{}, fmt.Errorf("block body not set")
+	}
+	return fields.body.HashTreeRoot()
+}
+
+// Header returns the beacon block header of the block
+func (v *VersionedBeaconBlock) Header() (*BeaconBlockHeader, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return nil, err
+	}
+	bodyRoot, err := v.BodyRoot()
+	if err != nil {
+		return nil, err
+	}
+	header := &BeaconBlockHeader{
+		Slot:          fields.slot,
+		ProposerIndex: fields.proposerIndex,
+		ParentRoot:    fields.parentRoot,
+		StateRoot:     fields.stateRoot,
+		BodyRoot:      bodyRoot,
+	}
+	return header, nil
+}
+
+// Attestations returns the attestations of a block before Electra
+func (v *VersionedBeaconBlock) Attestations() ([]*Attestation, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return nil, err
+	}
+	if isNil(fields.body) {
+		return nil, fmt.Errorf("block body not set")
+	}
+
+	switch obj := fields.body.(type) {
+	case *BeaconBlockBodyPhase0:
+		return obj.Attestations, nil
+	case *BeaconBlockBodyAltair:
+		return obj.Attestations, nil
+	case *BeaconBlockBodyBellatrix:
+		return obj.Attestations, nil
+	case *BeaconBlockBodyCapella:
+		return obj.Attestations, nil
+	case *BeaconBlockBodyDeneb:
+		return obj.Attestations, nil
+	default:
+		return nil, errFieldNotFound(fields.version, "attestations")
+	}
+}
+
+// AttestationsElectra returns the attestations of a block since Electra
+func (v *VersionedBeaconBlock) AttestationsElectra() ([]*AttestationElectra, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return nil, err
+	}
+	if isNil(fields.body) {
+		return nil, fmt.Errorf("block body not set")
+	}
+
+	switch obj := fields.body.(type) {
+	case *BeaconBlockBodyElectra:
+		return obj.Attestations, nil
+	default:
+		return nil, errFieldNotFound(fields.version, "attestations")
+	}
+}
+
+// ExecutionPayload returns the execution payload of the block
+func (v *VersionedBeaconBlock) ExecutionPayload() (Payload, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return nil, err
+	}
+	if isNil(fields.body) {
+		return nil, fmt.Errorf("block body not set")
+	}
+
+	var payload Payload
+	switch obj := fields.body.(type) {
+	case *BeaconBlockBodyBellatrix:
+		payload = obj.ExecutionPayload
+	case *BeaconBlockBodyCapella:
+		payload = obj.ExecutionPayload
+	case *BeaconBlockBodyDeneb:
+		payload = obj.ExecutionPayload
+	case *BeaconBlockBodyElectra:
+		payload = obj.ExecutionPayload
+	default:
+		return nil, errFieldNotFound(fields.version, "execution_payload")
+	}
+	if isNil(payload) {
+		return nil, fmt.Errorf("execution payload not set")
+	}
+	return payload, nil
+}
+
+// ExecutionPayloadHeader returns the header of the execution payload of the block
+func (v *VersionedBeaconBlock) ExecutionPayloadHeader() (PayloadHeader, error) {
+	payload, err := v.ExecutionPayload()
+	if err != nil {
+		return nil, err
+	}
+	return PayloadToHeader(payload)
+}
+
+// VersionedBeaconState wraps a beacon state of any fork
+// and exposes fork agnostic accessors
+type VersionedBeaconState struct {
+	BeaconState
+}
+
+type stateFields struct {
+	version               ForkName
+	genesisTime           uint64
+	genesisValidatorsRoot [32]byte
+	slot                  uint64
+	fork                  *Fork
+	validators            []*Validator
+	balances              []uint64
+}
+
+func (v *VersionedBeaconState) fields() (*stateFields, error) {
+	switch obj := v.BeaconState.(type) {
+	case *BeaconStatePhase0:
+		return &stateFields{ForkPhase0, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.Validators, obj.Balances}, nil
+	case *BeaconStateAltair:
+		return &stateFields{ForkAltair, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.Validators, obj.Balances}, nil
+	case *BeaconStateBellatrix:
+		return &stateFields{ForkBellatrix, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.Validators, obj.Balances}, nil
+	case *BeaconStateCapella:
+		return &stateFields{ForkCapella, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.Validators, obj.Balances}, nil
+	case *BeaconStateDeneb:
+		return &stateFields{ForkDeneb, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.Validators, obj.Balances}, nil
+	case *BeaconStateElectra:
+		return &stateFields{ForkElectra, obj.GenesisTime, obj.GenesisValidatorsRoot, obj.Slot, obj.Fork, obj.Validators, obj.Balances}, nil
+	default:
+		return nil, errUnknownType(v.BeaconState)
+	}
+}
+
+// Version returns the fork of the state
+func (v *VersionedBeaconState) Version() (ForkName, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return "", err
+	}
+	return fields.version, nil
+}
+
+// GenesisTime returns the genesis time of the chain
+func (v *VersionedBeaconState) GenesisTime() (uint64, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return 0, err
+	}
+	return fields.genesisTime, nil
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the chain
+func (v *VersionedBeaconState) GenesisValidatorsRoot() ([32]byte, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return fields.genesisValidatorsRoot, nil
+}
+
+// Slot returns the slot of the state
+func (v *VersionedBeaconState) Slot() (uint64, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return 0, err
+	}
+	return fields.slot, nil
+}
+
+// Fork returns the fork data of the state
+func (v *VersionedBeaconState) Fork() (*Fork, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return nil, err
+	}
+	return fields.fork, nil
+}
+
+// Validators returns the validator registry of the state
+func (v *VersionedBeaconState) Validators() ([]*Validator, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return nil, err
+	}
+	return fields.validators, nil
+}
+
+// Balances returns the validator balances of the state
+func (v *VersionedBeaconState) Balances() ([]uint64, error) {
+	fields, err := v.fields()
+	if err != nil {
+		return nil, err
+	}
+	return fields.balances, nil
+}
+
+// ExecutionPayloadHeader returns the latest execution payload header of the state
+func (v *VersionedBeaconState) ExecutionPayloadHeader() (PayloadHeader, error) {
+	var header PayloadHeader
+
+	switch obj := v.BeaconState.(type) {
+	case *BeaconStatePhase0:
+		return nil, errFieldNotFound(ForkPhase0, "latest_execution_payload_header")
+	case *BeaconStateAltair:
+		return nil, errFieldNotFound(ForkAltair, "latest_execution_payload_header")
+	case *BeaconStateBellatrix:
+		header = obj.LatestExecutionPayloadHeader
+	case *BeaconStateCapella:
+		header = obj.LatestExecutionPayloadHeader
+	case *BeaconStateDeneb:
+		header = obj.LatestExecutionPayloadHeader
+	case *BeaconStateElectra:
+		header = obj.LatestExecutionPayloadHeader
+	default:
+		return nil, errUnknownType(v.BeaconState)
+	}
+	if isNil(header) {
+		return nil, fmt.Errorf("latest execution payload header not set")
+	}
+	return header, nil
+}
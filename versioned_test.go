@@ -0,0 +1,131 @@
+package consensus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayload_Header(t *testing.T) {
+	payload := &ExecutionPayloadDeneb{
+		BlockNumber:  10,
+		ExtraData:    []byte{0x1, 0x2},
+		Transactions: [][]byte{{0x1}, {0x2, 0x3}, make([]byte, 100)},
+		Withdrawals: []*Withdrawal{
+			{Index: 1, ValidatorIndex: 2, Amount: 3},
+			{Index: 2, ValidatorIndex: 3, Amount: 4},
+		},
+		BlobGasUsed:   5,
+		ExcessBlobGas: 6,
+	}
+	header, err := payload.Header()
+	require.NoError(t, err)
+
+	// the hash tree root of the header and the payload must match
+	payloadRoot, err := payload.HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, payloadRoot, headerRoot)
+
+	bellatrix := &ExecutionPayload{
+		Transactions: [][]byte{{0x1}},
+	}
+	bellatrixHeader, err := bellatrix.Header()
+	require.NoError(t, err)
+
+	payloadRoot, err = bellatrix.HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err = bellatrixHeader.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, payloadRoot, headerRoot)
+}
+
+func TestVersioned_SignedBeaconBlock(t *testing.T) {
+	block := &VersionedSignedBeaconBlock{
+		SignedBeaconBlock: &SignedBeaconBlockCapella{
+			Block: &BeaconBlockCapella{
+				Slot:          10,
+				ProposerIndex: 5,
+				ParentRoot:    Root{0x1},
+				Body: &BeaconBlockBodyCapella{
+					Attestations:     []*Attestation{{AggregationBits: []byte{0x1}}},
+					ExecutionPayload: &ExecutionPayloadCapella{BlockNumber: 1},
+				},
+			},
+		},
+	}
+
+	version, err := block.Version()
+	require.NoError(t, err)
+	assert.Equal(t, ForkCapella, version)
+
+	slot, err := block.Slot()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), slot)
+
+	parentRoot, err := block.ParentRoot()
+	require.NoError(t, err)
+	assert.Equal(t, Root{0x1}, parentRoot)
+
+	attestations, err := block.Attestations()
+	require.NoError(t, err)
+	assert.Len(t, attestations, 1)
+
+	_, err = block.AttestationsElectra()
+	var fieldErr *FieldNotFoundError
+	assert.True(t, errors.As(err, &fieldErr))
+
+	header, err := block.ExecutionPayloadHeader()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), header.(*ExecutionPayloadHeaderCapella).BlockNumber)
+
+	// the header of the block has the same root as the block
+	message, err := block.Message()
+	require.NoError(t, err)
+	blockHeader, err := message.Header()
+	require.NoError(t, err)
+
+	root, err := block.Root()
+	require.NoError(t, err)
+	headerRoot, err := blockHeader.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, Root(headerRoot))
+
+	// phase0 blocks do not have an execution payload
+	phase0 := &VersionedSignedBeaconBlock{
+		SignedBeaconBlock: &SignedBeaconBlockPhase0{
+			Block: &BeaconBlockPhase0{Body: &BeaconBlockBodyPhase0{}},
+		},
+	}
+	_, err = phase0.ExecutionPayloadHeader()
+	assert.True(t, errors.As(err, &fieldErr))
+}
+
+func TestVersioned_BeaconState(t *testing.T) {
+	state := &VersionedBeaconState{
+		BeaconState: &BeaconStateAltair{
+			Slot:       5,
+			Validators: []*Validator{{}, {}},
+			Balances:   []uint64{1, 2},
+		},
+	}
+
+	version, err := state.Version()
+	require.NoError(t, err)
+	assert.Equal(t, ForkAltair, version)
+
+	validators, err := state.Validators()
+	require.NoError(t, err)
+	assert.Len(t, validators, 2)
+
+	balances, err := state.Balances()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, balances)
+
+	_, err = state.ExecutionPayloadHeader()
+	var fieldErr *FieldNotFoundError
+	assert.True(t, errors.As(err, &fieldErr))
+}